@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
+	"strconv"
 	"strings"
 	"syscall"
 	"time"
@@ -73,18 +74,44 @@ func main() {
 		},
 	}
 
-	setConfig(&cfg)
+	if err := setConfig(log, &cfg); err != nil {
+		log.WithError(err).Error("Failed to load configuration")
+		os.Exit(1)
+	}
 
 	if err := rootCmd.Execute(); err != nil {
 		os.Exit(1)
 	}
 }
 
-func setConfig(cfg *service.Config) {
-	cfg.GrafanaToken = os.Getenv("GRAFANA_SERVICE_TOKEN")
+func setConfig(log logrus.FieldLogger, cfg *service.Config) error {
+	// Secrets can also be read from files, eg mounted Kubernetes secrets, via their _FILE variants.
+	for _, secret := range []struct {
+		key  string
+		dest *string
+	}{
+		{"GRAFANA_SERVICE_TOKEN", &cfg.GrafanaToken},
+		{"DISCORD_BOT_TOKEN", &cfg.DiscordToken},
+		{"AWS_ACCESS_KEY_ID", &cfg.AccessKeyID},
+		{"AWS_SECRET_ACCESS_KEY", &cfg.SecretAccessKey},
+		{"GITHUB_TOKEN", &cfg.GithubToken},
+		{"SLACK_TOKEN", &cfg.SlackToken},
+		{"SLACK_WEBHOOK_URL", &cfg.SlackWebhookURL},
+	} {
+		value, err := envSecret(secret.key)
+		if err != nil {
+			return err
+		}
+
+		*secret.dest = value
+	}
+
 	cfg.GrafanaBaseURL = os.Getenv("GRAFANA_BASE_URL")
 	cfg.PromDatasourceID = os.Getenv("PROMETHEUS_DATASOURCE_ID")
-	cfg.DiscordToken = os.Getenv("DISCORD_BOT_TOKEN")
+	cfg.GrafanaQueryCacheTTL = envDuration(log, "GRAFANA_QUERY_CACHE_TTL")
+	cfg.GrafanaDashboardUID = os.Getenv("GRAFANA_DASHBOARD_UID")
+	cfg.GrafanaLogsDashboard = os.Getenv("GRAFANA_LOGS_DASHBOARD_UID")
+	cfg.GrafanaNetDashboards = os.Getenv("GRAFANA_NETWORK_DASHBOARDS")
 	// Support comma-separated DISCORD_GUILD_IDS, with fallback to singular DISCORD_GUILD_ID.
 	if guildIDs := os.Getenv("DISCORD_GUILD_IDS"); guildIDs != "" {
 		cfg.DiscordGuildIDs = strings.Split(guildIDs, ",")
@@ -92,15 +119,82 @@ func setConfig(cfg *service.Config) {
 		cfg.DiscordGuildIDs = []string{guildID}
 	}
 
-	cfg.AccessKeyID = os.Getenv("AWS_ACCESS_KEY_ID")
-	cfg.SecretAccessKey = os.Getenv("AWS_SECRET_ACCESS_KEY")
-	cfg.GithubToken = os.Getenv("GITHUB_TOKEN")
+	if intents := os.Getenv("DISCORD_INTENTS"); intents != "" {
+		cfg.DiscordIntents = strings.Split(intents, ",")
+	}
+
+	// Check names have spaces, so only the commas separate them.
+	for name := range strings.SplitSeq(os.Getenv("CHECKS_CRITICAL"), ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			cfg.ChecksCriticalChecks = append(cfg.ChecksCriticalChecks, name)
+		}
+	}
+
+	for network := range strings.SplitSeq(os.Getenv("HIVE_FALLBACK_NETWORKS"), ",") {
+		if network = strings.TrimSpace(network); network != "" {
+			cfg.HiveFallbackNetworks = append(cfg.HiveFallbackNetworks, network)
+		}
+	}
+
+	cfg.CommandPermissions = os.Getenv("COMMAND_PERMISSIONS")
 	cfg.S3Bucket = os.Getenv("S3_BUCKET")
 	cfg.S3BucketPrefix = os.Getenv("S3_BUCKET_PREFIX")
 	cfg.S3Region = os.Getenv("AWS_REGION")
 	cfg.S3EndpointURL = os.Getenv("AWS_ENDPOINT_URL")
+	cfg.S3RewriteMigrated = envBool("S3_REWRITE_MIGRATED")
+	cfg.StoreBackend = os.Getenv("STORE_BACKEND")
+	cfg.StoreDirectory = os.Getenv("STORE_DIRECTORY")
+	cfg.ClientsDataAttempts = envInt("CLIENTS_DATA_STARTUP_ATTEMPTS")
+	cfg.ClientsDataDegraded = envBool("CLIENTS_DATA_ALLOW_DEGRADED")
 	cfg.HealthCheckAddress = os.Getenv("HEALTH_CHECK_ADDRESS")
 	cfg.MetricsAddress = os.Getenv("METRICS_ADDRESS")
+	cfg.ChecksRunTimeout = envDuration(log, "CHECKS_RUN_TIMEOUT")
+	cfg.FlappingWindow = envDuration(log, "CHECKS_FLAPPING_WINDOW")
+	cfg.FlappingThreshold = envInt("CHECKS_FLAPPING_THRESHOLD")
+	cfg.ChecksCooldown = envDuration(log, "CHECKS_NOTIFICATION_COOLDOWN")
+	cfg.ChecksMaxThreadMsgs = envInt("CHECKS_MAX_THREAD_MESSAGES")
+	cfg.ChecksAlertsPerMinute = envInt("CHECKS_ALERTS_PER_MINUTE")
+	cfg.ChecksStaleData = envDuration(log, "CHECKS_STALE_DATA_THRESHOLD")
+	cfg.ChecksNetworkMinNodes = envInt("CHECKS_NETWORK_MIN_HEALTHY_NODES")
+	cfg.ChecksErrorGrace = envInt("CHECKS_ERROR_GRACE_RUNS")
+	cfg.ChecksErrorChannelID = os.Getenv("CHECKS_ERROR_CHANNEL_ID")
+	cfg.ChecksRemediationCmd = os.Getenv("CHECKS_REMEDIATION_COMMAND")
+	cfg.CrossNetworkChannelID = os.Getenv("CHECKS_CROSS_NETWORK_CHANNEL_ID")
+	cfg.CrossNetworkMin = envInt("CHECKS_CROSS_NETWORK_MIN_NETWORKS")
+	cfg.CrossNetworkWindow = envDuration(log, "CHECKS_CROSS_NETWORK_WINDOW")
+	cfg.EscalationChannelID = os.Getenv("CHECKS_ESCALATION_CHANNEL_ID")
+	cfg.EscalationAfter = os.Getenv("CHECKS_ESCALATION_AFTER")
+	cfg.ChecksFlatInstances = envBool("CHECKS_FLAT_INSTANCE_LIST")
+	cfg.ChecksNoHiveScreenshot = envBool("CHECKS_DISABLE_HIVE_SCREENSHOTS")
+	cfg.ChecksScreenshotHours = os.Getenv("CHECKS_HIVE_SCREENSHOT_HOURS")
+	cfg.ChecksPersistQueries = envBool("CHECKS_PERSIST_QUERIES")
+	cfg.ChecksEnforceClients = envBool("CHECKS_ENFORCE_NETWORK_CLIENTS")
+	cfg.ChecksRunDetails = envBool("CHECKS_MANUAL_RUN_DETAILS")
+	cfg.ChecksWeightedFailures = envBool("CHECKS_WEIGHTED_FAILURES")
+	cfg.InstanceNamePatterns = os.Getenv("INSTANCE_NAME_PATTERNS")
+	cfg.InstanceRegionPattern = os.Getenv("INSTANCE_REGION_PATTERN")
+	cfg.ChecksClientPresets = os.Getenv("CHECKS_CLIENT_PRESETS")
+	cfg.ChecksNameAliases = os.Getenv("CHECKS_NAME_ALIASES")
+	cfg.AlertTemplatesFile = os.Getenv("ALERT_TEMPLATES_FILE")
+	cfg.AlertEmbedFields = os.Getenv("ALERT_EMBED_FIELDS")
+	cfg.MaintenanceMode = envBool("MAINTENANCE_MODE")
+	cfg.DiscordAdminChannelID = os.Getenv("DISCORD_ADMIN_CHANNEL_ID")
+	cfg.OrphanedAlertsSchedule = os.Getenv("ORPHANED_ALERTS_SCHEDULE")
+	cfg.DisableOrphanedAlerts = envBool("DISABLE_ORPHANED_ALERTS")
+	cfg.CatchUpMissedRuns = envBool("CATCH_UP_MISSED_RUNS")
+	cfg.StartupSpread = envDuration(log, "SCHEDULE_STARTUP_SPREAD")
+	cfg.ChecksThreadName = os.Getenv("CHECKS_THREAD_NAME_TEMPLATE")
+	cfg.HiveThreadName = os.Getenv("HIVE_THREAD_NAME_TEMPLATE")
+	cfg.HiveConcurrency = envInt("HIVE_CONCURRENCY")
+	cfg.HiveStaleThreshold = envDuration(log, "HIVE_STALE_THRESHOLD")
+	cfg.HivePassRateGood = envFloat("HIVE_PASS_RATE_GOOD")
+	cfg.HivePassRateWarning = envFloat("HIVE_PASS_RATE_WARNING")
+	cfg.HiveSummaryDateFormat = os.Getenv("HIVE_SUMMARY_DATE_FORMAT")
+	cfg.HiveSummaryTimezone = os.Getenv("HIVE_SUMMARY_TIMEZONE")
+	cfg.HiveRetentionDaily = envDuration(log, "HIVE_SUMMARY_RETENTION_DAILY")
+	cfg.HiveRetentionWeekly = envDuration(log, "HIVE_SUMMARY_RETENTION_WEEKLY")
+	cfg.SlackChannels = os.Getenv("SLACK_CHANNELS")
+	cfg.DiscordAlertsDisabled = envBool("DISCORD_ALERTS_DISABLED")
 
 	if cfg.GrafanaBaseURL == "" {
 		cfg.GrafanaBaseURL = grafana.DefaultGrafanaBaseURL
@@ -117,4 +211,81 @@ func setConfig(cfg *service.Config) {
 	if cfg.S3BucketPrefix == "" {
 		cfg.S3BucketPrefix = store.DefaultBucketPrefix
 	}
+
+	return nil
+}
+
+// envSecret returns the given environment variable or, if it's unset, the contents of the file named
+// by its _FILE variant with surrounding whitespace trimmed. Returns an empty string if neither is set.
+func envSecret(key string) (string, error) {
+	if value := os.Getenv(key); value != "" {
+		return value, nil
+	}
+
+	path := os.Getenv(key + "_FILE")
+	if path == "" {
+		return "", nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s_FILE: %w", key, err)
+	}
+
+	return strings.TrimSpace(string(data)), nil
+}
+
+// envDuration parses a duration from the given environment variable, returning zero
+// if it's unset or invalid so the consuming component falls back to its default. An
+// invalid value is logged, so it isn't silently ignored.
+func envDuration(log logrus.FieldLogger, key string) time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		return 0
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		log.WithFields(logrus.Fields{
+			"variable": key,
+			"value":    value,
+		}).Warn("Invalid duration, eg 30s or 5m, using the default")
+
+		return 0
+	}
+
+	return d
+}
+
+// envInt parses an integer from the given environment variable, returning zero
+// if it's unset or invalid so the consuming component falls back to its default.
+func envInt(key string) int {
+	i, err := strconv.Atoi(os.Getenv(key))
+	if err != nil {
+		return 0
+	}
+
+	return i
+}
+
+// envFloat parses a float from the given environment variable, returning zero
+// if it's unset or invalid so the consuming component falls back to its default.
+func envFloat(key string) float64 {
+	f, err := strconv.ParseFloat(os.Getenv(key), 64)
+	if err != nil {
+		return 0
+	}
+
+	return f
+}
+
+// envBool parses a boolean from the given environment variable, returning false
+// if it's unset or invalid.
+func envBool(key string) bool {
+	b, err := strconv.ParseBool(os.Getenv(key))
+	if err != nil {
+		return false
+	}
+
+	return b
 }
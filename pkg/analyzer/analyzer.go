@@ -11,12 +11,20 @@ import (
 
 const (
 	MinFailuresForRootCause = 2
+
+	// FullWeightFailingFraction is the fraction of a pair's instances that need to be failing for
+	// it to count as a full failure when failures are weighted. Fewer count proportionally less.
+	FullWeightFailingFraction = 0.5
+
+	// weightEpsilon absorbs float rounding when comparing weighted failures to the threshold.
+	weightEpsilon = 1e-9
 )
 
 type ClientFailure struct {
 	Client     string
 	Type       ClientType
 	FailedWith []string
+	Weights    map[string]float64 // key: peer client, value: weight of the failing pair.
 }
 
 type ClientPairWithNodes struct {
@@ -37,6 +45,20 @@ type Analyzer struct {
 	clientType    ClientType
 	log           *logger.CheckLogger
 	cartographoor *cartographoor.Service
+	weighted      bool
+}
+
+// Option configures the analyzer.
+type Option func(*Analyzer)
+
+// WithWeightedFailures sets whether failing pairs are weighted by how many of their instances are
+// failing. When disabled (the default), a pair is failing if any of its instances are, so one of
+// ten failing counts the same as ten of ten. When enabled, a pair counts fully towards a root cause
+// once FullWeightFailingFraction of its instances are failing, and proportionally less below that.
+func WithWeightedFailures(enabled bool) Option {
+	return func(a *Analyzer) {
+		a.weighted = enabled
+	}
 }
 
 type Config struct {
@@ -50,14 +72,26 @@ type Config struct {
 	PromDatasourceID string
 }
 
-func NewAnalyzer(log *logger.CheckLogger, targetClient string, clientType ClientType, cartographoor *cartographoor.Service) *Analyzer {
-	return &Analyzer{
+func NewAnalyzer(
+	log *logger.CheckLogger,
+	targetClient string,
+	clientType ClientType,
+	cartographoor *cartographoor.Service,
+	opts ...Option,
+) *Analyzer {
+	a := &Analyzer{
 		nodeStatusMap: make(NodeStatusMap),
 		targetClient:  targetClient,
 		clientType:    clientType,
 		log:           log,
 		cartographoor: cartographoor,
 	}
+
+	for _, opt := range opts {
+		opt(a)
+	}
+
+	return a
 }
 
 func (a *Analyzer) Analyze() *AnalysisResult {
@@ -88,8 +122,9 @@ func (a *Analyzer) Analyze() *AnalysisResult {
 	result := &AnalysisResult{
 		RootCause:         make([]string, 0),
 		UnexplainedIssues: make([]string, 0),
-		AffectedNodes:     make(map[string][]string),
+		AffectedNodes:     a.collectAffectedNodes(state),
 		RootCauseEvidence: state.RootCauses,
+		PeerHealth:        a.collectPeerHealth(state),
 	}
 
 	// Add root causes to result.
@@ -123,30 +158,30 @@ func (a *Analyzer) AddNodeStatus(nodeName string, isHealthy bool) {
 func (a *Analyzer) collectFailures(state *AnalysisState) {
 	// For each client pair and their statuses.
 	for pair, statuses := range a.nodeStatusMap {
-		// Skip if no failures.
-		hasFailure := false
-
-		for _, s := range statuses {
-			if !s.IsHealthy {
-				hasFailure = true
-
-				break
-			}
-		}
+		// Skip if no failures. Nodes are counted once, however many checks they failed.
+		var (
+			failing   = len(failingNodeNames(statuses))
+			instances = countNodes(statuses)
+		)
 
-		if !hasFailure {
+		if failing == 0 {
 			continue
 		}
 
+		weight := min(1, float64(failing)/float64(instances)/FullWeightFailingFraction)
+
 		// Add to CL failures.
 		if _, exists := state.CLFailures[pair.CLClient]; !exists {
 			state.CLFailures[pair.CLClient] = &ClientFailure{
 				Client:     pair.CLClient,
 				Type:       ClientTypeCL,
 				FailedWith: make([]string, 0),
+				Weights:    make(map[string]float64),
 			}
 		}
 
+		state.CLFailures[pair.CLClient].Weights[pair.ELClient] = weight
+
 		if !contains(state.CLFailures[pair.CLClient].FailedWith, pair.ELClient) {
 			state.CLFailures[pair.CLClient].FailedWith = append(
 				state.CLFailures[pair.CLClient].FailedWith,
@@ -160,9 +195,12 @@ func (a *Analyzer) collectFailures(state *AnalysisState) {
 				Client:     pair.ELClient,
 				Type:       ClientTypeEL,
 				FailedWith: make([]string, 0),
+				Weights:    make(map[string]float64),
 			}
 		}
 
+		state.ELFailures[pair.ELClient].Weights[pair.CLClient] = weight
+
 		if !contains(state.ELFailures[pair.ELClient].FailedWith, pair.CLClient) {
 			state.ELFailures[pair.ELClient].FailedWith = append(
 				state.ELFailures[pair.ELClient].FailedWith,
@@ -170,14 +208,36 @@ func (a *Analyzer) collectFailures(state *AnalysisState) {
 			)
 		}
 
-		a.log.Printf("  - %s is failing with %s", pair.CLClient, pair.ELClient)
+		a.log.Printf("  - %s is failing with %s (%d/%d instances)", pair.CLClient, pair.ELClient, failing, instances)
+	}
+}
+
+// isRootCauseFailures returns true if failing with the given peers is enough to be a root cause.
+// Without weighting every failing peer counts as one, otherwise each counts for its pair's weight.
+func (a *Analyzer) isRootCauseFailures(failure *ClientFailure, peers []string) bool {
+	if !a.weighted {
+		return len(peers) >= MinFailuresForRootCause
+	}
+
+	var weight float64
+
+	for _, peer := range peers {
+		weight += failure.Weights[peer]
 	}
+
+	if weight+weightEpsilon < MinFailuresForRootCause {
+		a.log.Printf("  - %s failing with %d peers only weighs %.2f", failure.Client, len(peers), weight)
+
+		return false
+	}
+
+	return true
 }
 
 func (a *Analyzer) findPrimaryRootCauses(state *AnalysisState) {
 	// Find CL clients failing with many EL clients.
 	for client, failure := range state.CLFailures {
-		if len(failure.FailedWith) >= MinFailuresForRootCause {
+		if a.isRootCauseFailures(failure, failure.FailedWith) {
 			state.RootCauses[client] = fmt.Sprintf(
 				"CL client failing with %d EL clients: %s",
 				len(failure.FailedWith),
@@ -190,7 +250,7 @@ func (a *Analyzer) findPrimaryRootCauses(state *AnalysisState) {
 
 	// Find EL clients failing with many CL clients.
 	for client, failure := range state.ELFailures {
-		if len(failure.FailedWith) >= MinFailuresForRootCause {
+		if a.isRootCauseFailures(failure, failure.FailedWith) {
 			state.RootCauses[client] = fmt.Sprintf(
 				"EL client failing with %d CL clients: %s",
 				len(failure.FailedWith),
@@ -222,7 +282,7 @@ func (a *Analyzer) findSecondaryRootCauses(state *AnalysisState) {
 			}
 		}
 
-		if nonRootCauseFailures >= MinFailuresForRootCause {
+		if a.isRootCauseFailures(failure, nonRootCauseList) {
 			state.RootCauses[client] = fmt.Sprintf(
 				"CL client failing with %d non-root-cause EL clients: %s",
 				nonRootCauseFailures,
@@ -252,7 +312,7 @@ func (a *Analyzer) findSecondaryRootCauses(state *AnalysisState) {
 			}
 		}
 
-		if nonRootCauseFailures >= MinFailuresForRootCause {
+		if a.isRootCauseFailures(failure, nonRootCauseList) {
 			state.RootCauses[client] = fmt.Sprintf(
 				"EL client failing with %d non-root-cause CL clients: %s",
 				nonRootCauseFailures,
@@ -350,13 +410,7 @@ func (a *Analyzer) findUnexplainedIssues(state *AnalysisState) {
 		}
 
 		// Find failing nodes.
-		failingNodes := make([]string, 0)
-
-		for _, s := range statuses {
-			if !s.IsHealthy {
-				failingNodes = append(failingNodes, s.Name)
-			}
-		}
+		failingNodes := failingNodeNames(statuses)
 
 		if len(failingNodes) == 0 {
 			continue
@@ -383,6 +437,107 @@ func (a *Analyzer) findUnexplainedIssues(state *AnalysisState) {
 	}
 }
 
+// collectPeerHealth works out, for each counterpart of the target client's failing pairs, which
+// other clients the counterpart is also failing with. A counterpart that only fails alongside the
+// target client is healthy elsewhere, implicating the target client.
+func (a *Analyzer) collectPeerHealth(state *AnalysisState) []PeerHealth {
+	var (
+		targetFailures map[string]*ClientFailure
+		peerFailures   map[string]*ClientFailure
+		peerType       ClientType
+	)
+
+	switch a.clientType {
+	case ClientTypeCL:
+		targetFailures, peerFailures, peerType = state.CLFailures, state.ELFailures, ClientTypeEL
+	case ClientTypeEL:
+		targetFailures, peerFailures, peerType = state.ELFailures, state.CLFailures, ClientTypeCL
+	default:
+		return nil
+	}
+
+	target, ok := targetFailures[a.targetClient]
+	if !ok {
+		return nil
+	}
+
+	peers := slices.Clone(target.FailedWith)
+	slices.Sort(peers)
+
+	health := make([]PeerHealth, 0, len(peers))
+
+	for _, peer := range peers {
+		failingWith := make([]string, 0)
+
+		if failure, exists := peerFailures[peer]; exists {
+			for _, client := range failure.FailedWith {
+				if client != a.targetClient {
+					failingWith = append(failingWith, client)
+				}
+			}
+		}
+
+		slices.Sort(failingWith)
+
+		health = append(health, PeerHealth{
+			Peer:        peer,
+			Type:        peerType,
+			FailingWith: failingWith,
+		})
+	}
+
+	return health
+}
+
+// collectAffectedNodes attributes the failing nodes of each client pair to the root causes in it.
+// A pair with both clients as root causes is attributed to both.
+func (a *Analyzer) collectAffectedNodes(state *AnalysisState) map[string][]string {
+	affected := make(map[string][]string, len(state.RootCauses))
+
+	for pair, statuses := range a.nodeStatusMap {
+		for _, client := range []string{pair.CLClient, pair.ELClient} {
+			if _, isRootCause := state.RootCauses[client]; !isRootCause {
+				continue
+			}
+
+			affected[client] = append(affected[client], failingNodeNames(statuses)...)
+		}
+	}
+
+	for client := range affected {
+		slices.Sort(affected[client])
+	}
+
+	return affected
+}
+
+// failingNodeNames returns the distinct names of the unhealthy nodes, sorted. A node failing several
+// checks has a status for each, so would otherwise be listed once per check.
+func failingNodeNames(statuses []NodeStatus) []string {
+	names := make([]string, 0)
+
+	for _, s := range statuses {
+		if !s.IsHealthy {
+			names = append(names, s.Name)
+		}
+	}
+
+	slices.Sort(names)
+
+	return slices.Compact(names)
+}
+
+// countNodes returns the number of distinct nodes with a status.
+func countNodes(statuses []NodeStatus) int {
+	names := make(map[string]struct{}, len(statuses))
+
+	for _, s := range statuses {
+		names[s.Name] = struct{}{}
+	}
+
+	return len(names)
+}
+
 func (a *Analyzer) isTargetClientIssue(pair ClientPair) bool {
 	switch a.clientType {
 	case ClientTypeCL:
@@ -2,24 +2,27 @@ package analyzer
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/ethpandaops/panda-pulse/pkg/cartographoor"
 	"github.com/ethpandaops/panda-pulse/pkg/logger"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestAnalyzer_RootCauseDetection(t *testing.T) {
 	cs, _ := cartographoor.NewService(context.Background(), cartographoor.ServiceConfig{})
 
 	tests := []struct {
-		name            string
-		targetClient    string
-		clientType      ClientType
-		cartographoor   *cartographoor.Service
-		nodes           map[string]bool // map[nodeName]isHealthy
-		wantRootCause   []string
-		wantUnexplained []string
+		name              string
+		targetClient      string
+		clientType        ClientType
+		cartographoor     *cartographoor.Service
+		nodes             map[string]bool // map[nodeName]isHealthy
+		wantRootCause     []string
+		wantUnexplained   []string
+		wantAffectedNodes map[string][]string // map[rootCause]failingNodes, sorted
 	}{
 		{
 			name:          "all healthy nodes",
@@ -66,6 +69,15 @@ func TestAnalyzer_RootCauseDetection(t *testing.T) {
 			},
 			wantRootCause:   []string{"ethereumjs"},
 			wantUnexplained: []string{},
+			wantAffectedNodes: map[string][]string{
+				"ethereumjs": {
+					"grandine-ethereumjs-1",
+					"lighthouse-ethereumjs-1",
+					"lodestar-ethereumjs-1",
+					"nimbus-ethereumjs-1",
+					"teku-ethereumjs-1",
+				},
+			},
 		},
 		// This tests when we have multiple instances of the same client pair (prysm-geth-N).
 		// Some failing, some healthy, each failing instance should be listed as unexplained.
@@ -107,6 +119,15 @@ func TestAnalyzer_RootCauseDetection(t *testing.T) {
 			},
 			wantRootCause:   []string{"prysm"},
 			wantUnexplained: []string{},
+			wantAffectedNodes: map[string][]string{
+				"prysm": {
+					"prysm-besu-1",
+					"prysm-erigon-1",
+					"prysm-ethereumjs-1",
+					"prysm-geth-1",
+					"prysm-reth-1",
+				},
+			},
 		},
 		{
 			name:          "false positive - client only failing with known root causes",
@@ -129,6 +150,20 @@ func TestAnalyzer_RootCauseDetection(t *testing.T) {
 			},
 			wantRootCause:   []string{"ethereumjs", "nethermind"},
 			wantUnexplained: []string{},
+			wantAffectedNodes: map[string][]string{
+				"ethereumjs": {
+					"grandine-ethereumjs-1",
+					"lighthouse-ethereumjs-1",
+					"lodestar-ethereumjs-1",
+					"nimbus-ethereumjs-1",
+					"teku-ethereumjs-1",
+				},
+				"nethermind": {
+					"grandine-nethermind-1",
+					"lighthouse-nethermind-1",
+					"teku-nethermind-1",
+				},
+			},
 		},
 		{
 			name:          "mixed health status - some nodes healthy, some failing",
@@ -147,6 +182,15 @@ func TestAnalyzer_RootCauseDetection(t *testing.T) {
 			},
 			wantRootCause:   []string{"ethereumjs"},
 			wantUnexplained: []string{},
+			wantAffectedNodes: map[string][]string{
+				"ethereumjs": {
+					"grandine-ethereumjs-1",
+					"lighthouse-ethereumjs-1",
+					"lodestar-ethereumjs-1",
+					"nimbus-ethereumjs-1",
+					"teku-ethereumjs-1",
+				},
+			},
 		},
 		{
 			name:          "borderline case - client failing with exactly MinFailuresForRootCause peers",
@@ -163,6 +207,12 @@ func TestAnalyzer_RootCauseDetection(t *testing.T) {
 			},
 			wantRootCause:   []string{"reth"},
 			wantUnexplained: []string{},
+			wantAffectedNodes: map[string][]string{
+				"reth": {
+					"lighthouse-reth-1",
+					"teku-reth-1",
+				},
+			},
 		},
 		{
 			name:          "below threshold - client failing with less than MinFailuresForRootCause peers",
@@ -200,6 +250,15 @@ func TestAnalyzer_RootCauseDetection(t *testing.T) {
 			},
 			wantRootCause:   []string{"lighthouse"},
 			wantUnexplained: []string{},
+			wantAffectedNodes: map[string][]string{
+				"lighthouse": {
+					"lighthouse-besu-1",
+					"lighthouse-erigon-1",
+					"lighthouse-ethereumjs-1",
+					"lighthouse-geth-1",
+					"lighthouse-nethermind-1",
+				},
+			},
 		},
 		{
 			name:          "major root cause overrides - client failing with many peers including root causes",
@@ -221,6 +280,21 @@ func TestAnalyzer_RootCauseDetection(t *testing.T) {
 			},
 			wantRootCause:   []string{"lighthouse", "ethereumjs"},
 			wantUnexplained: []string{},
+			wantAffectedNodes: map[string][]string{
+				"lighthouse": {
+					"lighthouse-besu-1",
+					"lighthouse-erigon-1",
+					"lighthouse-ethereumjs-1",
+					"lighthouse-geth-1",
+					"lighthouse-nethermind-1",
+				},
+				"ethereumjs": {
+					"lighthouse-ethereumjs-1",
+					"nimbus-ethereumjs-1",
+					"prysm-ethereumjs-1",
+					"teku-ethereumjs-1",
+				},
+			},
 		},
 		{
 			name:          "secondary root cause - EL client failing with non-root-cause peers",
@@ -242,6 +316,15 @@ func TestAnalyzer_RootCauseDetection(t *testing.T) {
 			},
 			wantRootCause:   []string{"besu"},
 			wantUnexplained: []string{}, // These won't show up as unexplained from besu's perspective.
+			wantAffectedNodes: map[string][]string{
+				"besu": {
+					"grandine-besu-1",
+					"lighthouse-besu-1",
+					"lodestar-besu-1",
+					"prysm-besu-1",
+					"teku-besu-1",
+				},
+			},
 		},
 		{
 			name:          "secondary root cause - CL client failing with non-root-cause peers",
@@ -263,6 +346,15 @@ func TestAnalyzer_RootCauseDetection(t *testing.T) {
 			},
 			wantRootCause:   []string{"teku"}, // Only teku is a root cause.
 			wantUnexplained: []string{},
+			wantAffectedNodes: map[string][]string{
+				"teku": {
+					"teku-besu-1",
+					"teku-ethereumjs-1",
+					"teku-geth-1",
+					"teku-nethermind-1",
+					"teku-reth-1",
+				},
+			},
 		},
 		{
 			name:          "unexplained issues - CL clients with single failures",
@@ -306,6 +398,26 @@ func TestAnalyzer_RootCauseDetection(t *testing.T) {
 			// but it should be kept due to being a pre-production client with ≥ MinFailuresForRootCause failures
 			wantRootCause:   []string{"ethereumjs", "lighthouse", "prysm"},
 			wantUnexplained: []string{},
+			wantAffectedNodes: map[string][]string{
+				"ethereumjs": {
+					"lighthouse-ethereumjs-1",
+					"prysm-ethereumjs-1",
+				},
+				"lighthouse": {
+					"lighthouse-besu-1",
+					"lighthouse-erigon-1",
+					"lighthouse-ethereumjs-1",
+					"lighthouse-geth-1",
+					"lighthouse-nethermind-1",
+				},
+				"prysm": {
+					"prysm-besu-1",
+					"prysm-erigon-1",
+					"prysm-ethereumjs-1",
+					"prysm-geth-1",
+					"prysm-nethermind-1",
+				},
+			},
 		},
 		{
 			name:          "pre-production client skipped in unexplained issues",
@@ -340,6 +452,134 @@ func TestAnalyzer_RootCauseDetection(t *testing.T) {
 
 			assert.ElementsMatch(t, tt.wantRootCause, result.RootCause, "root causes don't match")
 			assert.ElementsMatch(t, tt.wantUnexplained, result.UnexplainedIssues, "unexplained issues don't match")
+
+			if len(tt.wantRootCause) == 0 {
+				assert.Empty(t, result.AffectedNodes, "affected nodes should be empty without root causes")
+			} else {
+				assert.Equal(t, tt.wantAffectedNodes, result.AffectedNodes, "affected nodes don't match")
+			}
+		})
+	}
+}
+
+func TestAnalyzer_AffectedNodesDeduplicated(t *testing.T) {
+	cs, _ := cartographoor.NewService(context.Background(), cartographoor.ServiceConfig{})
+
+	a := NewAnalyzer(logger.NewCheckLogger("id"), "lighthouse", ClientTypeCL, cs)
+
+	// lighthouse-geth-1 fails two checks, so is reported twice.
+	for _, nodeName := range []string{
+		"lighthouse-geth-1",
+		"lighthouse-geth-1",
+		"lighthouse-besu-1",
+		"lighthouse-nethermind-1",
+		"lighthouse-erigon-1",
+	} {
+		a.AddNodeStatus(nodeName, false)
+	}
+
+	result := a.Analyze()
+
+	require.Equal(t, []string{"lighthouse"}, result.RootCause)
+	assert.Equal(t, []string{
+		"lighthouse-besu-1",
+		"lighthouse-erigon-1",
+		"lighthouse-geth-1",
+		"lighthouse-nethermind-1",
+	}, result.AffectedNodes["lighthouse"])
+}
+
+func TestAnalyzer_PeerHealth(t *testing.T) {
+	cs, _ := cartographoor.NewService(context.Background(), cartographoor.ServiceConfig{})
+
+	log := logger.NewCheckLogger("id")
+	a := NewAnalyzer(log, "lighthouse", ClientTypeCL, cs)
+
+	for nodeName, isHealthy := range map[string]bool{
+		"lighthouse-geth-1":   false, // geth is fine with everyone else.
+		"lighthouse-erigon-1": false, // erigon is also failing with teku.
+		"teku-erigon-1":       false,
+		"prysm-geth-1":        true,
+		"lighthouse-besu-1":   true,
+	} {
+		a.AddNodeStatus(nodeName, isHealthy)
+	}
+
+	result := a.Analyze()
+
+	assert.Equal(t, []PeerHealth{
+		{Peer: "erigon", Type: ClientTypeEL, FailingWith: []string{"teku"}},
+		{Peer: "geth", Type: ClientTypeEL, FailingWith: []string{}},
+	}, result.PeerHealth)
+	assert.False(t, result.PeerHealth[0].HealthyElsewhere())
+	assert.True(t, result.PeerHealth[1].HealthyElsewhere())
+}
+
+func TestAnalyzer_WeightedFailures(t *testing.T) {
+	cs, _ := cartographoor.NewService(context.Background(), cartographoor.ServiceConfig{})
+
+	// build returns ten lighthouse instances of each pair, failing the given number of them.
+	build := func(failing map[string]int) map[string]bool {
+		nodes := make(map[string]bool)
+
+		for pair, count := range failing {
+			for n := range 10 {
+				nodes[fmt.Sprintf("%s-%d", pair, n+1)] = n >= count
+			}
+		}
+
+		return nodes
+	}
+
+	tests := []struct {
+		name            string
+		nodes           map[string]bool
+		wantBoolean     []string
+		wantWeighted    []string
+		wantUnexplained int // Unexplained nodes in weighted mode.
+	}{
+		{
+			name:            "few instances failing per pair",
+			nodes:           build(map[string]int{"lighthouse-geth": 1, "lighthouse-besu": 1}),
+			wantBoolean:     []string{"lighthouse"},
+			wantWeighted:    []string{},
+			wantUnexplained: 2,
+		},
+		{
+			name:            "most instances failing per pair",
+			nodes:           build(map[string]int{"lighthouse-geth": 9, "lighthouse-besu": 5}),
+			wantBoolean:     []string{"lighthouse"},
+			wantWeighted:    []string{"lighthouse"},
+			wantUnexplained: 0,
+		},
+		{
+			name:            "one pair mostly failing, one barely",
+			nodes:           build(map[string]int{"lighthouse-geth": 10, "lighthouse-besu": 2}),
+			wantBoolean:     []string{"lighthouse"},
+			wantWeighted:    []string{},
+			wantUnexplained: 12,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			analyze := func(opts ...Option) *AnalysisResult {
+				a := NewAnalyzer(logger.NewCheckLogger("id"), "lighthouse", ClientTypeCL, cs, opts...)
+
+				for nodeName, isHealthy := range tt.nodes {
+					a.AddNodeStatus(nodeName, isHealthy)
+				}
+
+				return a.Analyze()
+			}
+
+			boolean := analyze()
+			assert.ElementsMatch(t, tt.wantBoolean, boolean.RootCause, "boolean root causes don't match")
+			assert.Empty(t, boolean.UnexplainedIssues)
+
+			weighted := analyze(WithWeightedFailures(true))
+			assert.ElementsMatch(t, tt.wantWeighted, weighted.RootCause, "weighted root causes don't match")
+			assert.Len(t, weighted.UnexplainedIssues, tt.wantUnexplained)
 		})
 	}
 }
@@ -23,8 +23,22 @@ type NodeStatus struct {
 type AnalysisResult struct {
 	RootCause         []string            // List of clients determined to be root cause.
 	UnexplainedIssues []string            // List of issues that can't be explained by root cause.
-	AffectedNodes     map[string][]string // Map of issue type to affected nodes.
+	AffectedNodes     map[string][]string // Map of root cause client to the failing nodes attributed to it.
 	RootCauseEvidence map[string]string   // Evidence for why each root cause was determined.
+	PeerHealth        []PeerHealth        // Health of the counterpart clients in the target client's failing pairs.
+}
+
+// PeerHealth describes how the counterpart client in one of the target client's failing pairs
+// is doing with other clients.
+type PeerHealth struct {
+	Peer        string     // Counterpart client in the failing pair.
+	Type        ClientType // Type of the counterpart client.
+	FailingWith []string   // Clients other than the target the counterpart is also failing with.
+}
+
+// HealthyElsewhere returns true if the counterpart is only failing alongside the target client.
+func (p PeerHealth) HealthyElsewhere() bool {
+	return len(p.FailingWith) == 0
 }
 
 // ClientPair represents a CL-EL client combination.
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"slices"
 	"sort"
 	"strings"
 	"sync"
@@ -20,6 +21,8 @@ const (
 	devnet                 = "devnet"
 	defaultRefreshInterval = 1 * time.Hour
 	defaultRequestTimeout  = 30 * time.Second
+	defaultStartupAttempts = 3
+	defaultStartupBackoff  = 2 * time.Second
 )
 
 // Service provides access to cartographoor data with automatic updates from a
@@ -35,6 +38,11 @@ type Service struct {
 	dataMu   sync.RWMutex
 	networks map[string]discovery.Network
 	clients  map[string]discovery.ClientInfo
+
+	// degraded is set when the initial fetch failed and the service started without data, the
+	// provider is then started in the background, retrying every retryInterval until it succeeds.
+	degraded      bool
+	retryInterval time.Duration
 }
 
 // ServiceConfig contains the configuration for the cartographoor service.
@@ -43,20 +51,39 @@ type ServiceConfig struct {
 	RefreshInterval time.Duration
 	Logger          *logrus.Logger
 	HTTPClient      *http.Client
+
+	// StartupAttempts is how many times the initial fetch is tried before giving up, defaults to
+	// defaultStartupAttempts.
+	StartupAttempts int
+	// StartupBackoff is how long to wait after the first failed attempt, doubling after each one.
+	// Defaults to defaultStartupBackoff.
+	StartupBackoff time.Duration
+	// AllowDegradedStart starts the service without any data when the initial fetch keeps failing,
+	// rather than returning an error. The fetch is then retried in the background once started.
+	AllowDegradedStart bool
 }
 
 // NewService creates a new cartographoor service and performs the initial
-// (blocking) data fetch. It returns an error if the initial fetch fails so the
-// caller can fail fast at startup.
+// (blocking) data fetch, retrying it with backoff. If it still fails, an error
+// is returned so the caller can fail fast at startup, unless AllowDegradedStart
+// is set.
 func NewService(ctx context.Context, config ServiceConfig) (*Service, error) {
 	if config.Logger == nil {
 		config.Logger = logrus.New()
 	}
 
-	if config.RefreshInterval == 0 {
+	if config.RefreshInterval <= 0 {
 		config.RefreshInterval = defaultRefreshInterval
 	}
 
+	if config.StartupAttempts <= 0 {
+		config.StartupAttempts = defaultStartupAttempts
+	}
+
+	if config.StartupBackoff <= 0 {
+		config.StartupBackoff = defaultStartupBackoff
+	}
+
 	// An empty SourceURL falls back to the client's default production endpoint,
 	// which matches the URL panda-pulse used previously.
 	provider, err := client.NewMemoryProvider(client.Config{
@@ -70,45 +97,159 @@ func NewService(ctx context.Context, config ServiceConfig) (*Service, error) {
 	}
 
 	// Initial (blocking) fetch plus the provider's own background refresh loop.
-	if err := provider.Start(ctx); err != nil {
-		return nil, fmt.Errorf("failed to start cartographoor provider: %w", err)
+	if err := startProvider(ctx, config.Logger, provider, config.StartupAttempts, config.StartupBackoff, config.RefreshInterval); err != nil {
+		if !config.AllowDegradedStart {
+			return nil, fmt.Errorf("failed to start cartographoor provider: %w", err)
+		}
+
+		config.Logger.WithError(err).Error(
+			"Cartographoor data is unavailable, starting degraded without any networks or clients until it can be fetched",
+		)
+
+		s := newEmptyService(config.Logger, provider)
+		s.degraded = true
+		s.retryInterval = retryBackoff(config.StartupBackoff, config.RefreshInterval, config.StartupAttempts)
+
+		return s, nil
 	}
 
 	return newService(ctx, config.Logger, provider)
 }
 
+// startProvider starts the provider, trying up to attempts times and doubling the wait between
+// attempts from backoff, up to limit.
+func startProvider(
+	ctx context.Context,
+	log *logrus.Logger,
+	provider client.Provider,
+	attempts int,
+	backoff, limit time.Duration,
+) error {
+	var err error
+
+	for attempt := 1; ; attempt++ {
+		if err = provider.Start(ctx); err == nil {
+			return nil
+		}
+
+		if attempt >= attempts {
+			return err
+		}
+
+		log.WithError(err).WithFields(logrus.Fields{
+			"attempt": attempt,
+			"retryIn": backoff,
+		}).Warn("Failed to fetch cartographoor data, retrying")
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%w (gave up: %w)", err, ctx.Err())
+		case <-time.After(backoff):
+		}
+
+		backoff = retryBackoff(backoff, limit, 1)
+	}
+}
+
+// retryBackoff returns backoff doubled once per attempt, capped at limit. It stops doubling once
+// the limit is reached, so however many attempts there are it can't overflow.
+func retryBackoff(backoff, limit time.Duration, attempts int) time.Duration {
+	for range attempts {
+		if backoff >= limit/2 {
+			return limit
+		}
+
+		backoff *= 2
+	}
+
+	return min(backoff, limit)
+}
+
 // newService wraps an already-started provider and loads the initial snapshot.
 // It is the injection seam used by tests to supply a controllable provider.
 func newService(ctx context.Context, log *logrus.Logger, provider client.Provider) (*Service, error) {
+	s := newEmptyService(log, provider)
+
+	if err := s.rebuild(ctx); err != nil {
+		return nil, fmt.Errorf("failed to load initial cartographoor data: %w", err)
+	}
+
+	return s, nil
+}
+
+// newEmptyService creates a service over the provider without any data loaded.
+func newEmptyService(log *logrus.Logger, provider client.Provider) *Service {
 	if log == nil {
 		log = logrus.New()
 	}
 
-	s := &Service{
+	return &Service{
 		log:      log,
 		provider: provider,
 		done:     make(chan struct{}),
 		networks: make(map[string]discovery.Network),
 		clients:  make(map[string]discovery.ClientInfo),
 	}
-
-	if err := s.rebuild(ctx); err != nil {
-		return nil, fmt.Errorf("failed to load initial cartographoor data: %w", err)
-	}
-
-	return s, nil
 }
 
 // Start begins watching the provider for updates, refreshing the local snapshot
-// whenever new data is fetched.
+// whenever new data is fetched. A degraded service keeps trying to start the
+// provider first.
 func (s *Service) Start(ctx context.Context) {
+	degraded := s.degraded
+
 	s.wg.Go(func() {
+		if degraded && !s.recover(ctx) {
+			return
+		}
+
 		s.watch(ctx)
 	})
 
 	s.log.Info("Cartographoor service started")
 }
 
+// recover retries starting the provider of a degraded service and loading its data until both
+// succeed, or the service is stopped. It returns whether the data was loaded.
+func (s *Service) recover(ctx context.Context) bool {
+	var (
+		ticker  = time.NewTicker(s.retryInterval)
+		started bool
+	)
+
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return false
+		case <-s.done:
+			return false
+		case <-ticker.C:
+		}
+
+		if !started {
+			if err := s.provider.Start(ctx); err != nil {
+				s.log.WithError(err).Error("Cartographoor data is still unavailable, running degraded")
+
+				continue
+			}
+
+			started = true
+		}
+
+		if err := s.rebuild(ctx); err != nil {
+			s.log.WithError(err).Error("Failed to load cartographoor data, running degraded")
+
+			continue
+		}
+
+		s.log.Info("Cartographoor data fetched, no longer degraded")
+
+		return true
+	}
+}
+
 // Stop halts the update watcher and the underlying provider.
 func (s *Service) Stop() {
 	close(s.done)
@@ -311,6 +452,43 @@ func (s *Service) GetNetworkStatus(networkName string) string {
 	return ""
 }
 
+// GetNetworkClients returns the clients a devnet runs, going by its client images, sorted
+// alphabetically. Returns nil if the network is unknown, is not a devnet or lists no images.
+func (s *Service) GetNetworkClients(networkName string) []string {
+	s.dataMu.RLock()
+	defer s.dataMu.RUnlock()
+
+	network, ok := s.networks[networkName]
+	if !ok || !strings.Contains(networkName, devnet) || network.Images == nil {
+		return nil
+	}
+
+	var names []string
+
+	for _, image := range network.Images.Clients {
+		if image.Name != "" && !slices.Contains(names, image.Name) {
+			names = append(names, image.Name)
+		}
+	}
+
+	slices.Sort(names)
+
+	return names
+}
+
+// GetServiceURLs returns the service URLs (Dora, Explorer, Forky, etc.) of a devnet, or an
+// empty ServiceURLs if the network is unknown, is not a devnet or has none configured.
+func (s *Service) GetServiceURLs(networkName string) discovery.ServiceURLs {
+	s.dataMu.RLock()
+	defer s.dataMu.RUnlock()
+
+	if network, ok := s.networks[networkName]; ok && strings.Contains(networkName, devnet) && network.ServiceURLs != nil {
+		return *network.ServiceURLs
+	}
+
+	return discovery.ServiceURLs{}
+}
+
 // GetTeamRoles returns the team roles for a client.
 func (s *Service) GetTeamRoles(clientName string) []string {
 	return clients.TeamRoles[clientName]
@@ -34,7 +34,17 @@ func TestCartographoorService(t *testing.T) {
 					"name": "devnet-0",
 					"repository": "ethpandaops/eof-devnets",
 					"status": "active",
-					"chainId": 7023642286
+					"chainId": 7023642286,
+					"serviceUrls": {
+						"dora": "https://dora.eof-devnet-0.ethpandaops.io",
+						"explorer": "https://explorer.eof-devnet-0.ethpandaops.io"
+					},
+					"images": {
+						"clients": [
+							{"name": "lighthouse", "version": "unstable"},
+							{"name": "geth", "version": "master"}
+						]
+					}
 				},
 				"pectra-devnet-1": {
 					"name": "devnet-1",
@@ -155,6 +165,18 @@ func TestCartographoorService(t *testing.T) {
 		assert.Equal(t, "", service.GetNetworkStatus("mainnet"))
 		assert.Equal(t, "active", service.GetNetworkStatus("eof-devnet-0"))
 		assert.Equal(t, "inactive", service.GetNetworkStatus("pectra-devnet-1"))
+
+		// Service URLs should only be returned for devnet networks that have them.
+		urls := service.GetServiceURLs("eof-devnet-0")
+		assert.Equal(t, "https://dora.eof-devnet-0.ethpandaops.io", urls.Dora)
+		assert.Equal(t, "https://explorer.eof-devnet-0.ethpandaops.io", urls.Explorer)
+		assert.Empty(t, service.GetServiceURLs("pectra-devnet-1").Dora)
+		assert.Empty(t, service.GetServiceURLs("mainnet").Explorer)
+
+		// Network clients come from the client images of devnets that list them.
+		assert.Equal(t, []string{"geth", "lighthouse"}, service.GetNetworkClients("eof-devnet-0"))
+		assert.Nil(t, service.GetNetworkClients("pectra-devnet-1"))
+		assert.Nil(t, service.GetNetworkClients("mainnet"))
 	})
 
 	// Test the layer-type aliases and the clients-package delegators.
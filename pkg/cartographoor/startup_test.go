@@ -0,0 +1,110 @@
+package cartographoor
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newFlakyServer serves cartographoor data, failing every fetch until failures reaches zero. A
+// negative failures fails until the returned flag is cleared.
+func newFlakyServer(t *testing.T, failures int32) (*httptest.Server, *atomic.Int32, *atomic.Bool) {
+	t.Helper()
+
+	var (
+		fetches atomic.Int32
+		failing atomic.Bool
+	)
+
+	failing.Store(failures < 0)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if n := fetches.Add(1); failing.Load() || n <= failures {
+			w.WriteHeader(http.StatusServiceUnavailable)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, `{"networks":{"foo-devnet-0":{"name":"devnet-0","status":"active"}},"clients":{}}`)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &fetches, &failing
+}
+
+func TestNewServiceStartup(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("retries the initial fetch", func(t *testing.T) {
+		server, fetches, _ := newFlakyServer(t, 2)
+
+		svc, err := NewService(ctx, ServiceConfig{
+			SourceURL:       server.URL,
+			RefreshInterval: time.Minute,
+			Logger:          logrus.New(),
+			StartupAttempts: 3,
+			StartupBackoff:  time.Millisecond,
+		})
+		require.NoError(t, err)
+
+		defer svc.Stop()
+
+		assert.Equal(t, []string{"foo-devnet-0"}, svc.GetActiveNetworks())
+		assert.Equal(t, int32(3), fetches.Load())
+	})
+
+	t.Run("fails fast once out of attempts", func(t *testing.T) {
+		server, fetches, _ := newFlakyServer(t, -1)
+
+		_, err := NewService(ctx, ServiceConfig{
+			SourceURL:       server.URL,
+			RefreshInterval: time.Minute,
+			Logger:          logrus.New(),
+			StartupAttempts: 2,
+			StartupBackoff:  time.Millisecond,
+		})
+		require.Error(t, err)
+		assert.Equal(t, int32(2), fetches.Load())
+	})
+
+	t.Run("retry interval can't overflow", func(t *testing.T) {
+		assert.Equal(t, 8*time.Second, retryBackoff(2*time.Second, time.Hour, 2))
+		assert.Equal(t, time.Hour, retryBackoff(2*time.Second, time.Hour, 33))
+		assert.Equal(t, time.Hour, retryBackoff(2*time.Second, time.Hour, 1000))
+		assert.Equal(t, time.Minute, retryBackoff(time.Hour, time.Minute, 0))
+	})
+
+	t.Run("starts degraded and recovers", func(t *testing.T) {
+		server, _, failing := newFlakyServer(t, -1)
+
+		svc, err := NewService(ctx, ServiceConfig{
+			SourceURL:          server.URL,
+			RefreshInterval:    time.Minute,
+			Logger:             logrus.New(),
+			StartupAttempts:    1,
+			StartupBackoff:     time.Millisecond,
+			AllowDegradedStart: true,
+		})
+		require.NoError(t, err)
+
+		svc.Start(ctx)
+		defer svc.Stop()
+
+		assert.Empty(t, svc.GetActiveNetworks())
+
+		failing.Store(false)
+
+		require.Eventually(t, func() bool {
+			return len(svc.GetActiveNetworks()) == 1
+		}, 5*time.Second, 10*time.Millisecond, "service should load the data once it can be fetched")
+	})
+}
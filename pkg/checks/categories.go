@@ -5,8 +5,9 @@ type Category string
 
 // Define the categories.
 const (
-	CategoryGeneral Category = "general"
-	CategorySync    Category = "sync"
+	CategoryGeneral   Category = "general"
+	CategorySync      Category = "sync"
+	CategoryConsensus Category = "consensus"
 )
 
 // String returns the string representation of a category.
@@ -16,6 +17,8 @@ func (c Category) String() string {
 		return "General"
 	case CategorySync:
 		return "Sync"
+	case CategoryConsensus:
+		return "Consensus"
 	default:
 		return "Unknown"
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/ethpandaops/panda-pulse/pkg/analyzer"
 	"github.com/ethpandaops/panda-pulse/pkg/cartographoor"
 	"github.com/ethpandaops/panda-pulse/pkg/clients"
+	"github.com/ethpandaops/panda-pulse/pkg/grafana"
 	"github.com/ethpandaops/panda-pulse/pkg/logger"
 )
 
@@ -22,8 +23,40 @@ type Result struct {
 	Status        Status
 	Description   string
 	Timestamp     time.Time
+	DataTimestamp time.Time // Time of the latest data point the check evaluated, zero if it had none.
 	Details       map[string]any
 	AffectedNodes []string
+	// InstanceDetailKeys are the Details keys listing the affected instances, one per line, so alerts
+	// can pick them out without knowing about every check.
+	InstanceDetailKeys []string
+}
+
+// Details keys the checks list affected instances under.
+const (
+	NotSyncedNodesDetailKey        = "notSyncedNodes"
+	StuckNodesDetailKey            = "stuckNodes"
+	BehindNodesDetailKey           = "behindNodes"
+	LowParticipationNodesDetailKey = "lowParticipationNodes"
+)
+
+// LegacyInstanceDetailKeys are the Details keys affected instances were listed under by results
+// stored before checks declared their InstanceDetailKeys, some from checks that have since been retired.
+var LegacyInstanceDetailKeys = []string{
+	"lowPeerNodes",
+	NotSyncedNodesDetailKey,
+	StuckNodesDetailKey,
+	BehindNodesDetailKey,
+	LowParticipationNodesDetailKey,
+}
+
+// InstanceKeys returns the Details keys listing the result's affected instances, falling back to
+// LegacyInstanceDetailKeys for results that don't declare them.
+func (r *Result) InstanceKeys() []string {
+	if len(r.InstanceDetailKeys) > 0 {
+		return r.InstanceDetailKeys
+	}
+
+	return LegacyInstanceDetailKeys
 }
 
 // Status represents the status of a check.
@@ -31,8 +64,9 @@ type Status string
 
 // Define the statuses.
 const (
-	StatusOK   Status = "OK"
-	StatusFail Status = "FAIL"
+	StatusOK    Status = "OK"
+	StatusFail  Status = "FAIL"
+	StatusError Status = "ERROR" // The check itself failed to run.
 )
 
 // Check represents a single health check.
@@ -52,6 +86,8 @@ type Config struct {
 	Network       string
 	ConsensusNode string
 	ExecutionNode string
+	// Thresholds tunes how strict the checks are, unset values fall back to the defaults.
+	Thresholds Thresholds
 }
 
 // Runner executes health checks.
@@ -68,21 +104,56 @@ type Runner interface {
 	GetResults() []*Result
 	// GetAnalysis returns the analysis of the runner.
 	GetAnalysis() *analyzer.AnalysisResult
+	// GetQueries returns the Grafana queries the checks made and their raw responses, nil unless
+	// they're being recorded.
+	GetQueries() []grafana.RecordedQuery
 }
 
 // defaultRunner is a default implementation of the Runner interface.
 type defaultRunner struct {
-	id            string
-	log           *logger.CheckLogger
-	cfg           Config
-	checks        []Check
-	results       []*Result
-	analysis      *analyzer.AnalysisResult
-	cartographoor *cartographoor.Service
+	id              string
+	log             *logger.CheckLogger
+	cfg             Config
+	checks          []Check
+	results         []*Result
+	analysis        *analyzer.AnalysisResult
+	cartographoor   *cartographoor.Service
+	continueOnError bool
+	recorder        *grafana.Recorder
+	instances       InstanceLister // Nil unless failures are weighted.
+}
+
+// RunnerOption configures the default runner.
+type RunnerOption func(*defaultRunner)
+
+// WithContinueOnError sets whether the runner keeps going when an individual check errors or
+// panics. When enabled (the default), the failure is recorded as a StatusError result and the
+// remaining checks still run. When disabled, the first failure aborts the run.
+func WithContinueOnError(enabled bool) RunnerOption {
+	return func(r *defaultRunner) {
+		r.continueOnError = enabled
+	}
+}
+
+// WithQueryRecorder records the raw response to every Grafana query made through the recorder, which
+// should be the client the runner's checks were created with.
+func WithQueryRecorder(recorder *grafana.Recorder) RunnerOption {
+	return func(r *defaultRunner) {
+		r.recorder = recorder
+	}
+}
+
+// WithWeightedFailures weights the analyzer's failing client pairs by how many of their instances are
+// failing, see analyzer.WithWeightedFailures. The lister lists every instance on the network, so the
+// healthy ones are counted too. A nil lister leaves failures unweighted.
+func WithWeightedFailures(lister InstanceLister) RunnerOption {
+	return func(r *defaultRunner) {
+		r.instances = lister
+	}
 }
 
 // NewDefaultRunner creates a new default check runner.
-func NewDefaultRunner(cfg Config, cartographoor *cartographoor.Service) Runner {
+func NewDefaultRunner(cfg Config, cartographoor *cartographoor.Service, opts ...RunnerOption) Runner {
 	// Give the runner a unique ID, so we can identify things easily.
 	id := generateCheckID()
 
@@ -91,13 +162,20 @@ func NewDefaultRunner(cfg Config, cartographoor *cartographoor.Service) Runner {
 	// how panda-pulse got to the conclusion it did as to whether we should notify or not.
 	log := logger.NewCheckLogger(id)
 
-	return &defaultRunner{
-		id:            id,
-		log:           log,
-		cfg:           cfg,
-		checks:        make([]Check, 0),
-		cartographoor: cartographoor,
+	r := &defaultRunner{
+		id:              id,
+		log:             log,
+		cfg:             cfg,
+		checks:          make([]Check, 0),
+		cartographoor:   cartographoor,
+		continueOnError: true,
+	}
+
+	for _, opt := range opts {
+		opt(r)
 	}
+
+	return r
 }
 
 // GetID returns the ID of the runner.
@@ -120,6 +198,16 @@ func (r *defaultRunner) GetAnalysis() *analyzer.AnalysisResult {
 	return r.analysis
 }
 
+// GetQueries returns the Grafana queries the checks made and their raw responses, nil unless
+// they're being recorded.
+func (r *defaultRunner) GetQueries() []grafana.RecordedQuery {
+	if r.recorder == nil {
+		return nil
+	}
+
+	return r.recorder.Queries()
+}
+
 // RegisterCheck adds a check to the runner.
 func (r *defaultRunner) RegisterCheck(check Check) {
 	r.checks = append(r.checks, check)
@@ -132,15 +220,16 @@ func (r *defaultRunner) RunChecks(ctx context.Context) error {
 		results = make([]*Result, 0)
 		a       *analyzer.Analyzer
 		client  string
+		weight  = analyzer.WithWeightedFailures(r.instances != nil)
 	)
 
 	if r.cfg.ConsensusNode != "" {
-		a = analyzer.NewAnalyzer(r.log, r.cfg.ConsensusNode, analyzer.ClientTypeCL, r.cartographoor)
+		a = analyzer.NewAnalyzer(r.log, r.cfg.ConsensusNode, analyzer.ClientTypeCL, r.cartographoor, weight)
 		client = r.cfg.ConsensusNode
 	}
 
 	if r.cfg.ExecutionNode != "" {
-		a = analyzer.NewAnalyzer(r.log, r.cfg.ExecutionNode, analyzer.ClientTypeEL, r.cartographoor)
+		a = analyzer.NewAnalyzer(r.log, r.cfg.ExecutionNode, analyzer.ClientTypeEL, r.cartographoor, weight)
 		client = r.cfg.ExecutionNode
 	}
 
@@ -157,9 +246,31 @@ func (r *defaultRunner) RunChecks(ctx context.Context) error {
 	allResults := make([]*Result, 0)
 
 	for _, check := range r.checks {
-		result, err := check.Run(ctx, r.log, r.cfg)
+		if err := ctx.Err(); err != nil {
+			r.log.Printf("  - Run cancelled before %s: %v", check.Name(), err)
+
+			return fmt.Errorf("check run cancelled: %w", err)
+		}
+
+		result, err := r.runCheck(ctx, check)
 		if err != nil {
-			return fmt.Errorf("failed to run check %s: %w", check.Name(), err)
+			if !r.continueOnError {
+				return fmt.Errorf("failed to run check %s: %w", check.Name(), err)
+			}
+
+			// Record the failure and carry on, so one broken check doesn't hide the others.
+			r.log.Printf("  - Check %s errored, continuing: %v", check.Name(), err)
+
+			allResults = append(allResults, &Result{
+				Name:        check.Name(),
+				Category:    check.Category(),
+				Status:      StatusError,
+				Description: fmt.Sprintf("Check failed to run: %v", err),
+				Timestamp:   time.Now(),
+				Details:     map[string]any{"error": err.Error()},
+			})
+
+			continue
 		}
 
 		// Add all affected nodes to analyzer for complete analysis.
@@ -172,11 +283,23 @@ func (r *defaultRunner) RunChecks(ctx context.Context) error {
 		allResults = append(allResults, result)
 	}
 
+	// Weighting needs the healthy instances of each pair too, the checks only list the failing ones.
+	if r.instances != nil {
+		r.addHealthyInstances(ctx, a)
+	}
+
 	// Run analysis with complete data.
 	analysisResult := a.Analyze()
 
 	// As a second pass, filter results to only include target client data.
 	for _, result := range allResults {
+		// Errored checks have no node data to filter, keep them so the run is transparent.
+		if result.Status == StatusError {
+			results = append(results, result)
+
+			continue
+		}
+
 		if result.Status == StatusFail {
 			// Create a filtered copy of the result.
 			filteredResult := &Result{
@@ -185,8 +308,11 @@ func (r *defaultRunner) RunChecks(ctx context.Context) error {
 				Status:        result.Status,
 				Description:   result.Description,
 				Timestamp:     result.Timestamp,
+				DataTimestamp: result.DataTimestamp,
 				Details:       make(map[string]any),
 				AffectedNodes: make([]string, 0),
+				// The filtered details are listed under the same keys.
+				InstanceDetailKeys: result.InstanceDetailKeys,
 			}
 
 			// Filter affected nodes..
@@ -242,6 +368,33 @@ func (r *defaultRunner) RunChecks(ctx context.Context) error {
 	return nil
 }
 
+// addHealthyInstances adds every instance on the network to the analyzer as healthy, alongside the
+// failing statuses from the checks. If they can't be listed, failing pairs weigh as fully failing.
+func (r *defaultRunner) addHealthyInstances(ctx context.Context, a *analyzer.Analyzer) {
+	instances, err := r.instances.ListInstances(ctx, r.cfg.Network)
+	if err != nil {
+		r.log.Printf("  - Failed to list instances, failing pairs weigh as fully failing: %v", err)
+
+		return
+	}
+
+	for _, instance := range instances {
+		a.AddNodeStatus(instance, true)
+	}
+}
+
+// runCheck runs a single check, converting any panic into an error.
+func (r *defaultRunner) runCheck(ctx context.Context, check Check) (result *Result, err error) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			result = nil
+			err = fmt.Errorf("check panicked: %v", rec)
+		}
+	}()
+
+	return check.Run(ctx, r.log, r.cfg)
+}
+
 // logAnalysisSummary logs a summary of the analysis results.
 func logAnalysisSummary(log *logger.CheckLogger, analysisResult *analyzer.AnalysisResult) {
 	log.Printf("\n=== Analysis summary")
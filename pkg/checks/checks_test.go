@@ -0,0 +1,219 @@
+package checks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ethpandaops/panda-pulse/pkg/clients"
+	"github.com/ethpandaops/panda-pulse/pkg/grafana"
+	"github.com/ethpandaops/panda-pulse/pkg/grafana/mock"
+	"github.com/ethpandaops/panda-pulse/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// stubCheck is a check whose behaviour is driven by the run function.
+type stubCheck struct {
+	name string
+	run  func() (*Result, error)
+}
+
+func (c *stubCheck) Name() string                   { return c.name }
+func (c *stubCheck) Category() Category             { return CategorySync }
+func (c *stubCheck) ClientType() clients.ClientType { return clients.ClientTypeCL }
+
+func (c *stubCheck) Run(_ context.Context, _ *logger.CheckLogger, _ Config) (*Result, error) {
+	return c.run()
+}
+
+func TestDefaultRunner_ContinueOnError(t *testing.T) {
+	var ran bool
+
+	newChecks := func() []Check {
+		ran = false
+
+		return []Check{
+			&stubCheck{name: "panicking", run: func() (*Result, error) {
+				panic("boom")
+			}},
+			&stubCheck{name: "erroring", run: func() (*Result, error) {
+				return nil, errors.New("bad query")
+			}},
+			&stubCheck{name: "healthy", run: func() (*Result, error) {
+				ran = true
+
+				return &Result{Name: "healthy", Category: CategorySync, Status: StatusOK, Timestamp: time.Now()}, nil
+			}},
+		}
+	}
+
+	t.Run("continues past panics and errors by default", func(t *testing.T) {
+		runner := NewDefaultRunner(Config{Network: "test-net", ConsensusNode: "lighthouse"}, nil)
+		for _, check := range newChecks() {
+			runner.RegisterCheck(check)
+		}
+
+		require.NoError(t, runner.RunChecks(context.Background()))
+		assert.True(t, ran, "checks after the failing ones should still run")
+		require.NotNil(t, runner.GetAnalysis())
+
+		results := runner.GetResults()
+		require.Len(t, results, 2)
+
+		for _, result := range results {
+			assert.Equal(t, StatusError, result.Status)
+		}
+
+		assert.Contains(t, results[0].Description, "boom")
+		assert.Contains(t, results[1].Description, "bad query")
+		assert.Contains(t, runner.GetLog().GetBuffer().String(), "Check panicking errored")
+	})
+
+	t.Run("aborts on first failure when disabled", func(t *testing.T) {
+		runner := NewDefaultRunner(Config{Network: "test-net", ConsensusNode: "lighthouse"}, nil, WithContinueOnError(false))
+		for _, check := range newChecks() {
+			runner.RegisterCheck(check)
+		}
+
+		err := runner.RunChecks(context.Background())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "check panicked: boom")
+		assert.False(t, ran)
+	})
+}
+
+func TestDefaultRunner_QueryRecorder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := mock.NewMockClient(ctrl)
+	mockClient.EXPECT().Query(gomock.Any(), gomock.Any()).Return(&grafana.QueryResponse{
+		Raw: json.RawMessage(`{"results":{}}`),
+	}, nil)
+
+	t.Run("not recorded by default", func(t *testing.T) {
+		runner := NewDefaultRunner(Config{Network: "test-net", ConsensusNode: "lighthouse"}, nil)
+		assert.Nil(t, runner.GetQueries())
+	})
+
+	t.Run("records the checks' queries", func(t *testing.T) {
+		recorder := grafana.NewRecorder(mockClient)
+
+		runner := NewDefaultRunner(Config{Network: "test-net", ConsensusNode: "lighthouse"}, nil, WithQueryRecorder(recorder))
+		runner.RegisterCheck(NewHeadSlotCheck(recorder))
+
+		require.NoError(t, runner.RunChecks(context.Background()))
+
+		queries := runner.GetQueries()
+		require.Len(t, queries, 1)
+		assert.Contains(t, queries[0].Query, "beacon_head_slot")
+		assert.JSONEq(t, `{"results":{}}`, string(queries[0].Response))
+	})
+}
+
+// stubInstanceLister lists a fixed set of instances.
+type stubInstanceLister struct {
+	instances []string
+	err       error
+}
+
+func (l *stubInstanceLister) ListInstances(_ context.Context, _ string) ([]string, error) {
+	return l.instances, l.err
+}
+
+func TestDefaultRunner_WeightedFailures(t *testing.T) {
+	// Ten instances of each lighthouse pair run on the network.
+	var instances []string
+
+	for _, el := range []string{"geth", "besu"} {
+		for n := range 10 {
+			instances = append(instances, fmt.Sprintf("lighthouse-%s-%d", el, n+1))
+		}
+	}
+
+	// failing returns a check failing the first count instances of each lighthouse pair.
+	failing := func(name string, count int) Check {
+		return &stubCheck{name: name, run: func() (*Result, error) {
+			var nodes []string
+
+			for _, el := range []string{"geth", "besu"} {
+				for n := range count {
+					nodes = append(nodes, fmt.Sprintf("lighthouse-%s-%d", el, n+1))
+				}
+			}
+
+			return &Result{Name: name, Category: CategorySync, Status: StatusFail, Timestamp: time.Now(), AffectedNodes: nodes}, nil
+		}}
+	}
+
+	tests := []struct {
+		name          string
+		failing       int
+		opts          []RunnerOption
+		wantRootCause []string
+	}{
+		{
+			name:          "one of ten failing unweighted",
+			failing:       1,
+			wantRootCause: []string{"lighthouse"},
+		},
+		{
+			name:          "one of ten failing weighted",
+			failing:       1,
+			opts:          []RunnerOption{WithWeightedFailures(&stubInstanceLister{instances: instances})},
+			wantRootCause: []string{},
+		},
+		{
+			name:          "half failing weighted",
+			failing:       5,
+			opts:          []RunnerOption{WithWeightedFailures(&stubInstanceLister{instances: instances})},
+			wantRootCause: []string{"lighthouse"},
+		},
+		{
+			name:          "instances not listed weighted",
+			failing:       1,
+			opts:          []RunnerOption{WithWeightedFailures(&stubInstanceLister{err: errors.New("grafana down")})},
+			wantRootCause: []string{"lighthouse"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner := NewDefaultRunner(Config{Network: "test-net", ConsensusNode: "lighthouse"}, nil, tt.opts...)
+
+			// Both checks fail the same nodes, which still only count once each.
+			runner.RegisterCheck(failing("sync", tt.failing))
+			runner.RegisterCheck(failing("head slot", tt.failing))
+
+			require.NoError(t, runner.RunChecks(context.Background()))
+			assert.ElementsMatch(t, tt.wantRootCause, runner.GetAnalysis().RootCause)
+		})
+	}
+}
+
+func TestDefaultRunner_KeepsInstanceDetailKeys(t *testing.T) {
+	runner := NewDefaultRunner(Config{Network: "test-net", ConsensusNode: "lighthouse"}, nil)
+	runner.RegisterCheck(&stubCheck{name: "sync", run: func() (*Result, error) {
+		return &Result{
+			Name:               "sync",
+			Category:           CategorySync,
+			Status:             StatusFail,
+			Timestamp:          time.Now(),
+			Details:            map[string]any{"laggingNodes": "lighthouse-geth-1\nprysm-geth-1"},
+			AffectedNodes:      []string{"lighthouse-geth-1", "prysm-geth-1"},
+			InstanceDetailKeys: []string{"laggingNodes"},
+		}, nil
+	}})
+
+	require.NoError(t, runner.RunChecks(context.Background()))
+
+	results := runner.GetResults()
+	require.Len(t, results, 1)
+	assert.Equal(t, []string{"laggingNodes"}, results[0].InstanceKeys())
+	assert.Equal(t, "lighthouse-geth-1", results[0].Details["laggingNodes"])
+}
@@ -0,0 +1,158 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ethpandaops/panda-pulse/pkg/clients"
+	"github.com/ethpandaops/panda-pulse/pkg/grafana"
+	"github.com/ethpandaops/panda-pulse/pkg/logger"
+)
+
+// attestationParticipationWindow is how far back attestation hits and misses are counted, long
+// enough to cover a couple of epochs so a single missed attestation doesn't flap the check.
+const attestationParticipationWindow = 15 * time.Minute
+
+const queryCLAttestationParticipation = `
+	(
+		sum by (instance, network) (increase(validator_monitor_prev_epoch_on_chain_attester_hit_total%[1]s[%[2]ds]))
+		/
+		(
+			sum by (instance, network) (increase(validator_monitor_prev_epoch_on_chain_attester_hit_total%[1]s[%[2]ds]))
+			+
+			sum by (instance, network) (increase(validator_monitor_prev_epoch_on_chain_attester_miss_total%[1]s[%[2]ds]))
+		)
+	) * 100 < %[3]d
+`
+
+const attestationParticipationSelector = `{network=~"%s", consensus_client=~"%s", execution_client=~"%s", ingress_user!~"synctest.*"}`
+
+// AttestationParticipationCheck is a check that verifies the CL's validators are getting their
+// attestations included.
+type AttestationParticipationCheck struct {
+	grafanaClient grafana.Client
+}
+
+// NewAttestationParticipationCheck creates a new AttestationParticipationCheck.
+func NewAttestationParticipationCheck(grafanaClient grafana.Client) *AttestationParticipationCheck {
+	return &AttestationParticipationCheck{
+		grafanaClient: grafanaClient,
+	}
+}
+
+// Name returns the name of the check.
+func (c *AttestationParticipationCheck) Name() string {
+	return "Attestation participation low"
+}
+
+// Category returns the category of the check.
+func (c *AttestationParticipationCheck) Category() Category {
+	return CategoryConsensus
+}
+
+// ClientType returns the client type of the check.
+func (c *AttestationParticipationCheck) ClientType() clients.ClientType {
+	return clients.ClientTypeCL
+}
+
+// Run executes the check.
+func (c *AttestationParticipationCheck) Run(ctx context.Context, log *logger.CheckLogger, cfg Config) (*Result, error) {
+	var (
+		threshold = cfg.Thresholds.withDefaults().ParticipationRate
+		selector  = fmt.Sprintf(attestationParticipationSelector, cfg.Network, cfg.ConsensusNode, cfg.ExecutionNode)
+		query     = fmt.Sprintf(
+			queryCLAttestationParticipation,
+			selector,
+			int(attestationParticipationWindow.Seconds()),
+			threshold,
+		)
+	)
+
+	log.Print("\n=== Running CL attestation participation check")
+
+	response, err := c.grafanaClient.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	// Pull out nodes below the threshold by their labels, alongside their rate where it's known.
+	var (
+		lowNodes     []string
+		lowNodeLines []string
+	)
+
+	for _, frame := range response.Results.PandaPulse.Frames {
+		for _, field := range frame.Schema.Fields {
+			if labels := field.Labels; labels != nil {
+				if labels["instance"] != "" {
+					nodeName := strings.ReplaceAll(labels["instance"], labels["network"]+"-", "")
+					line := nodeName
+
+					if rate, ok := latestValue(frame.Data); ok {
+						line = fmt.Sprintf("%s (%.1f%%)", nodeName, rate)
+					}
+
+					lowNodes = append(lowNodes, nodeName)
+					lowNodeLines = append(lowNodeLines, line)
+					log.Printf("  - Low attestation participation: %s", line)
+				}
+			}
+		}
+	}
+
+	if len(lowNodes) == 0 {
+		log.Printf("  - All nodes are at or above %d%% participation", threshold)
+
+		return &Result{
+			Name:          c.Name(),
+			Category:      c.Category(),
+			Status:        StatusOK,
+			Description:   "All CL nodes have their attestations included",
+			Timestamp:     time.Now(),
+			DataTimestamp: response.LatestTimestamp(),
+			Details: map[string]any{
+				"query": query,
+			},
+			AffectedNodes: []string{},
+		}, nil
+	}
+
+	return &Result{
+		Name:          c.Name(),
+		Category:      c.Category(),
+		Status:        StatusFail,
+		Description:   fmt.Sprintf("The following CL nodes have an attestation participation rate below %d%%", threshold),
+		Timestamp:     time.Now(),
+		DataTimestamp: response.LatestTimestamp(),
+		Details: map[string]any{
+			"query":                        query,
+			LowParticipationNodesDetailKey: strings.Join(lowNodeLines, "\n"),
+		},
+		AffectedNodes:      lowNodes,
+		InstanceDetailKeys: []string{LowParticipationNodesDetailKey},
+	}, nil
+}
+
+// latestValue returns the latest numeric value of a frame, if it has one. Values are either a flat
+// list, or a list of columns with the values last.
+func latestValue(data grafana.QueryData) (float64, bool) {
+	if len(data.Values) == 0 {
+		return 0, false
+	}
+
+	last := data.Values[len(data.Values)-1]
+
+	if column, ok := last.([]any); ok {
+		if len(column) == 0 {
+			return 0, false
+		}
+
+		last = column[len(column)-1]
+	}
+
+	value, ok := last.(float64)
+
+	return value, ok
+}
@@ -0,0 +1,128 @@
+package checks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethpandaops/panda-pulse/pkg/clients"
+	"github.com/ethpandaops/panda-pulse/pkg/grafana"
+	"github.com/ethpandaops/panda-pulse/pkg/grafana/mock"
+	"github.com/ethpandaops/panda-pulse/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAttestationParticipationCheck_Run(t *testing.T) {
+	failingResponse := &grafana.QueryResponse{
+		Results: grafana.QueryResults{
+			PandaPulse: grafana.QueryPandaPulse{
+				Frames: []grafana.QueryFrame{
+					{
+						Schema: grafana.QuerySchema{
+							Fields: []grafana.QueryField{
+								{
+									Labels: map[string]string{
+										"instance": "mainnet-lighthouse-geth-1",
+										"network":  "mainnet",
+									},
+								},
+							},
+						},
+						Data: grafana.QueryData{
+							Values: []any{[]any{1.0, 2.0}, []any{75.0, 72.5}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name            string
+		config          Config
+		mockResponse    *grafana.QueryResponse
+		mockError       error
+		expectedStatus  Status
+		expectedDetails string
+		expectError     bool
+	}{
+		{
+			name: "all nodes participating",
+			config: Config{
+				Network:       "mainnet",
+				ConsensusNode: "lighthouse",
+				ExecutionNode: "geth",
+			},
+			mockResponse:   &grafana.QueryResponse{},
+			expectedStatus: StatusOK,
+		},
+		{
+			name: "nodes below threshold",
+			config: Config{
+				Network:       "mainnet",
+				ConsensusNode: "lighthouse",
+				ExecutionNode: "geth",
+			},
+			mockResponse:    failingResponse,
+			expectedStatus:  StatusFail,
+			expectedDetails: "lighthouse-geth-1 (72.5%)",
+		},
+		{
+			name: "grafana error",
+			config: Config{
+				Network:       "mainnet",
+				ConsensusNode: "lighthouse",
+				ExecutionNode: "geth",
+			},
+			mockError:   assert.AnError,
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mock.NewMockClient(ctrl)
+			mockClient.EXPECT().Query(gomock.Any(), gomock.Any()).Return(tt.mockResponse, tt.mockError)
+
+			log := logger.NewCheckLogger("id")
+			check := NewAttestationParticipationCheck(mockClient)
+			result, err := check.Run(context.Background(), log, tt.config)
+
+			if tt.expectError {
+				require.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, result.Status)
+			assert.NotEmpty(t, result.Description)
+			assert.Contains(t, result.Details, "query")
+			assert.Contains(t, result.Details["query"], "< 80")
+
+			if tt.expectedDetails != "" {
+				assert.Equal(t, tt.expectedDetails, result.Details["lowParticipationNodes"])
+				assert.Equal(t, []string{"lighthouse-geth-1"}, result.AffectedNodes)
+			}
+		})
+	}
+}
+
+func TestAttestationParticipationCheck_Name(t *testing.T) {
+	check := NewAttestationParticipationCheck(nil)
+	assert.Equal(t, "Attestation participation low", check.Name())
+}
+
+func TestAttestationParticipationCheck_Category(t *testing.T) {
+	check := NewAttestationParticipationCheck(nil)
+	assert.Equal(t, CategoryConsensus, check.Category())
+}
+
+func TestAttestationParticipationCheck_ClientType(t *testing.T) {
+	check := NewAttestationParticipationCheck(nil)
+	assert.Equal(t, clients.ClientTypeCL, check.ClientType())
+}
@@ -15,7 +15,7 @@ const queryCLFinalizedEpoch = `
 	beacon_finalized_epoch{network=~"%s", consensus_client=~"%s", execution_client=~"%s", ingress_user!~"synctest.*"}
 	- on (network) 
 	group_right(instance, consensus_client, execution_client, ingress_user)
-	max(beacon_finalized_epoch{network=~"%s", consensus_client=~"%s", execution_client=~"%s", ingress_user!~"synctest.*"}) by (network) < -4
+	max(beacon_finalized_epoch{network=~"%s", consensus_client=~"%s", execution_client=~"%s", ingress_user!~"synctest.*"}) by (network) < -%d
 `
 
 // CLFinalizedEpochCheck is a check that verifies if the CL finalized epoch is advancing.
@@ -55,6 +55,7 @@ func (c *CLFinalizedEpochCheck) Run(ctx context.Context, log *logger.CheckLogger
 		cfg.Network,
 		cfg.ConsensusNode,
 		cfg.ExecutionNode,
+		cfg.Thresholds.withDefaults().FinalizedEpochLag,
 	)
 
 	log.Print("\n=== Running CL finalized epoch check")
@@ -83,11 +84,12 @@ func (c *CLFinalizedEpochCheck) Run(ctx context.Context, log *logger.CheckLogger
 		log.Printf("  - All nodes are finalizing properly")
 
 		return &Result{
-			Name:        c.Name(),
-			Category:    c.Category(),
-			Status:      StatusOK,
-			Description: "All CL nodes are finalizing properly",
-			Timestamp:   time.Now(),
+			Name:          c.Name(),
+			Category:      c.Category(),
+			Status:        StatusOK,
+			Description:   "All CL nodes are finalizing properly",
+			Timestamp:     time.Now(),
+			DataTimestamp: response.LatestTimestamp(),
 			Details: map[string]any{
 				"query": query,
 			},
@@ -96,15 +98,17 @@ func (c *CLFinalizedEpochCheck) Run(ctx context.Context, log *logger.CheckLogger
 	}
 
 	return &Result{
-		Name:        c.Name(),
-		Category:    c.Category(),
-		Status:      StatusFail,
-		Description: "The following CL nodes are not finalizing",
-		Timestamp:   time.Now(),
+		Name:          c.Name(),
+		Category:      c.Category(),
+		Status:        StatusFail,
+		Description:   "The following CL nodes are not finalizing",
+		Timestamp:     time.Now(),
+		DataTimestamp: response.LatestTimestamp(),
 		Details: map[string]any{
-			"query":      query,
-			"stuckNodes": strings.Join(stuckNodes, "\n"),
+			"query":             query,
+			StuckNodesDetailKey: strings.Join(stuckNodes, "\n"),
 		},
-		AffectedNodes: stuckNodes,
+		AffectedNodes:      stuckNodes,
+		InstanceDetailKeys: []string{StuckNodesDetailKey},
 	}, nil
 }
@@ -13,7 +13,7 @@ import (
 
 const queryCLHeadSlot = `
 	(increase(
-		beacon_head_slot{network=~"%s", consensus_client=~"%s", execution_client=~"%s", ingress_user!~"synctest.*"}[5m]
+		beacon_head_slot{network=~"%s", consensus_client=~"%s", execution_client=~"%s", ingress_user!~"synctest.*"}[%ds]
 	) == 0) + 1
 `
 
@@ -46,7 +46,13 @@ func (c *HeadSlotCheck) ClientType() clients.ClientType {
 
 // Run executes the check.
 func (c *HeadSlotCheck) Run(ctx context.Context, log *logger.CheckLogger, cfg Config) (*Result, error) {
-	query := fmt.Sprintf(queryCLHeadSlot, cfg.Network, cfg.ConsensusNode, cfg.ExecutionNode)
+	query := fmt.Sprintf(
+		queryCLHeadSlot,
+		cfg.Network,
+		cfg.ConsensusNode,
+		cfg.ExecutionNode,
+		int(cfg.Thresholds.withDefaults().HeadSlotWindow.Seconds()),
+	)
 
 	log.Print("\n=== Running CL head slot check")
 
@@ -74,11 +80,12 @@ func (c *HeadSlotCheck) Run(ctx context.Context, log *logger.CheckLogger, cfg Co
 		log.Printf("  - All nodes are advancing properly")
 
 		return &Result{
-			Name:        c.Name(),
-			Category:    c.Category(),
-			Status:      StatusOK,
-			Description: "All CL nodes are advancing properly",
-			Timestamp:   time.Now(),
+			Name:          c.Name(),
+			Category:      c.Category(),
+			Status:        StatusOK,
+			Description:   "All CL nodes are advancing properly",
+			Timestamp:     time.Now(),
+			DataTimestamp: response.LatestTimestamp(),
 			Details: map[string]any{
 				"query": query,
 			},
@@ -87,15 +94,17 @@ func (c *HeadSlotCheck) Run(ctx context.Context, log *logger.CheckLogger, cfg Co
 	}
 
 	return &Result{
-		Name:        c.Name(),
-		Category:    c.Category(),
-		Status:      StatusFail,
-		Description: "The following CL nodes are not advancing their head slot",
-		Timestamp:   time.Now(),
+		Name:          c.Name(),
+		Category:      c.Category(),
+		Status:        StatusFail,
+		Description:   "The following CL nodes are not advancing their head slot",
+		Timestamp:     time.Now(),
+		DataTimestamp: response.LatestTimestamp(),
 		Details: map[string]any{
-			"query":      query,
-			"stuckNodes": strings.Join(stuckNodes, "\n"),
+			"query":             query,
+			StuckNodesDetailKey: strings.Join(stuckNodes, "\n"),
 		},
-		AffectedNodes: stuckNodes,
+		AffectedNodes:      stuckNodes,
+		InstanceDetailKeys: []string{StuckNodesDetailKey},
 	}, nil
 }
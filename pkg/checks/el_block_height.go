@@ -15,7 +15,7 @@ const queryELBlockHeight = `
 	eth_exe_block_most_recent_number{network=~"%s", consensus_client=~"%s", execution_client=~"%s", ingress_user!~"synctest.*"}
 	- on (network) 
 	group_right(instance, consensus_client, execution_client, ingress_user)
-	max(eth_exe_block_most_recent_number{network=~"%s", consensus_client=~"%s", execution_client=~"%s", ingress_user!~"synctest.*"}) by (network) < -5
+	max(eth_exe_block_most_recent_number{network=~"%s", consensus_client=~"%s", execution_client=~"%s", ingress_user!~"synctest.*"}) by (network) < -%d
 `
 
 // ELBlockHeightCheck is a check that verifies if the EL nodes are advancing.
@@ -55,6 +55,7 @@ func (c *ELBlockHeightCheck) Run(ctx context.Context, log *logger.CheckLogger, c
 		cfg.Network,
 		cfg.ConsensusNode,
 		cfg.ExecutionNode,
+		cfg.Thresholds.withDefaults().BlockLag,
 	)
 
 	log.Print("\n=== Running EL block height check")
@@ -83,11 +84,12 @@ func (c *ELBlockHeightCheck) Run(ctx context.Context, log *logger.CheckLogger, c
 		log.Printf("  - All nodes are advancing properly")
 
 		return &Result{
-			Name:        c.Name(),
-			Category:    c.Category(),
-			Status:      StatusOK,
-			Description: "All EL nodes are advancing properly",
-			Timestamp:   time.Now(),
+			Name:          c.Name(),
+			Category:      c.Category(),
+			Status:        StatusOK,
+			Description:   "All EL nodes are advancing properly",
+			Timestamp:     time.Now(),
+			DataTimestamp: response.LatestTimestamp(),
 			Details: map[string]any{
 				"query": query,
 			},
@@ -96,15 +98,17 @@ func (c *ELBlockHeightCheck) Run(ctx context.Context, log *logger.CheckLogger, c
 	}
 
 	return &Result{
-		Name:        c.Name(),
-		Category:    c.Category(),
-		Status:      StatusFail,
-		Description: "The following EL nodes are not advancing",
-		Timestamp:   time.Now(),
+		Name:          c.Name(),
+		Category:      c.Category(),
+		Status:        StatusFail,
+		Description:   "The following EL nodes are not advancing",
+		Timestamp:     time.Now(),
+		DataTimestamp: response.LatestTimestamp(),
 		Details: map[string]any{
-			"query":      query,
-			"stuckNodes": strings.Join(stuckNodes, "\n"),
+			"query":             query,
+			StuckNodesDetailKey: strings.Join(stuckNodes, "\n"),
 		},
-		AffectedNodes: stuckNodes,
+		AffectedNodes:      stuckNodes,
+		InstanceDetailKeys: []string{StuckNodesDetailKey},
 	}, nil
 }
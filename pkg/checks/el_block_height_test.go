@@ -3,6 +3,7 @@ package checks
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/ethpandaops/panda-pulse/pkg/clients"
 	"github.com/ethpandaops/panda-pulse/pkg/grafana"
@@ -119,3 +120,69 @@ func TestELBlockHeightCheck_ClientType(t *testing.T) {
 	check := NewELBlockHeightCheck(nil)
 	assert.Equal(t, clients.ClientTypeEL, check.ClientType())
 }
+
+func TestELBlockHeightCheck_BlockLag(t *testing.T) {
+	tests := []struct {
+		name       string
+		thresholds Thresholds
+		expected   string
+	}{
+		{name: "default", expected: "< -5"},
+		{name: "override", thresholds: Thresholds{BlockLag: 20}, expected: "< -20"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mock.NewMockClient(ctrl)
+			mockClient.EXPECT().Query(gomock.Any(), gomock.Any()).Return(&grafana.QueryResponse{}, nil)
+
+			check := NewELBlockHeightCheck(mockClient)
+			result, err := check.Run(context.Background(), logger.NewCheckLogger("id"), Config{
+				Network:    "mainnet",
+				Thresholds: tt.thresholds,
+			})
+			require.NoError(t, err)
+			assert.Contains(t, result.Details["query"], tt.expected)
+		})
+	}
+}
+
+func TestELBlockHeightCheck_DataTimestamp(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	latest := time.Date(2025, 1, 2, 7, 1, 0, 0, time.UTC)
+
+	mockClient := mock.NewMockClient(ctrl)
+	mockClient.EXPECT().Query(gomock.Any(), gomock.Any()).Return(&grafana.QueryResponse{
+		Results: grafana.QueryResults{
+			PandaPulse: grafana.QueryPandaPulse{
+				Frames: []grafana.QueryFrame{
+					{
+						Schema: grafana.QuerySchema{
+							Fields: []grafana.QueryField{
+								{Name: "Time", Type: "time"},
+								{Name: "Value", Type: "number", Labels: map[string]string{
+									"instance":     "user1-lighthouse-geth-1",
+									"ingress_user": "user1",
+								}},
+							},
+						},
+						Data: grafana.QueryData{
+							Values: []any{[]any{float64(latest.UnixMilli())}, []any{-10.0}},
+						},
+					},
+				},
+			},
+		},
+	}, nil)
+
+	check := NewELBlockHeightCheck(mockClient)
+	result, err := check.Run(context.Background(), logger.NewCheckLogger("id"), Config{Network: "mainnet"})
+	require.NoError(t, err)
+	assert.Equal(t, StatusFail, result.Status)
+	assert.Equal(t, latest, result.DataTimestamp)
+}
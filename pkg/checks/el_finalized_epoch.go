@@ -0,0 +1,115 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ethpandaops/panda-pulse/pkg/clients"
+	"github.com/ethpandaops/panda-pulse/pkg/grafana"
+	"github.com/ethpandaops/panda-pulse/pkg/logger"
+)
+
+const queryELFinalizedEpoch = `
+	eth_exe_block_finalized_number{network=~"%s", consensus_client=~"%s", execution_client=~"%s", ingress_user!~"synctest.*"}
+	- on (network)
+	group_right(instance, consensus_client, execution_client, ingress_user)
+	quantile by (network) (0.5, eth_exe_block_finalized_number{network=~"%s", ingress_user!~"synctest.*"}) < -%d
+`
+
+// ELFinalizedEpochCheck is a check that verifies if the EL nodes are keeping up with the network's
+// finalized block. Unlike the CL check, the EL nodes are compared with the network median, so a few
+// nodes ahead of the rest don't fail everyone else.
+type ELFinalizedEpochCheck struct {
+	grafanaClient grafana.Client
+}
+
+// NewELFinalizedEpochCheck creates a new ELFinalizedEpochCheck.
+func NewELFinalizedEpochCheck(grafanaClient grafana.Client) *ELFinalizedEpochCheck {
+	return &ELFinalizedEpochCheck{
+		grafanaClient: grafanaClient,
+	}
+}
+
+// Name returns the name of the check.
+func (c *ELFinalizedEpochCheck) Name() string {
+	return "Finalized block behind"
+}
+
+// Category returns the category of the check.
+func (c *ELFinalizedEpochCheck) Category() Category {
+	return CategorySync
+}
+
+// ClientType returns the client type of the check.
+func (c *ELFinalizedEpochCheck) ClientType() clients.ClientType {
+	return clients.ClientTypeEL
+}
+
+// Run executes the check.
+func (c *ELFinalizedEpochCheck) Run(ctx context.Context, log *logger.CheckLogger, cfg Config) (*Result, error) {
+	// The median is taken over the whole network, rather than just the client's nodes.
+	query := fmt.Sprintf(
+		queryELFinalizedEpoch,
+		cfg.Network,
+		cfg.ConsensusNode,
+		cfg.ExecutionNode,
+		cfg.Network,
+		cfg.Thresholds.withDefaults().FinalizedBlockLag,
+	)
+
+	log.Print("\n=== Running EL finalized block check")
+
+	response, err := c.grafanaClient.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	// Pull out nodes behind on finalization by their labels.
+	var behindNodes []string
+
+	for _, frame := range response.Results.PandaPulse.Frames {
+		for _, field := range frame.Schema.Fields {
+			if labels := field.Labels; labels != nil {
+				if labels["instance"] != "" {
+					nodeName := strings.ReplaceAll(labels["instance"], labels["ingress_user"]+"-", "")
+					behindNodes = append(behindNodes, nodeName)
+					log.Printf("  - Finalized block behind: %s", nodeName)
+				}
+			}
+		}
+	}
+
+	if len(behindNodes) == 0 {
+		log.Printf("  - All nodes are keeping up with finalization")
+
+		return &Result{
+			Name:          c.Name(),
+			Category:      c.Category(),
+			Status:        StatusOK,
+			Description:   "All EL nodes are keeping up with finalization",
+			Timestamp:     time.Now(),
+			DataTimestamp: response.LatestTimestamp(),
+			Details: map[string]any{
+				"query": query,
+			},
+			AffectedNodes: []string{},
+		}, nil
+	}
+
+	return &Result{
+		Name:          c.Name(),
+		Category:      c.Category(),
+		Status:        StatusFail,
+		Description:   "The following EL nodes' finalized block is behind the network",
+		Timestamp:     time.Now(),
+		DataTimestamp: response.LatestTimestamp(),
+		Details: map[string]any{
+			"query":              query,
+			BehindNodesDetailKey: strings.Join(behindNodes, "\n"),
+		},
+		AffectedNodes:      behindNodes,
+		InstanceDetailKeys: []string{BehindNodesDetailKey},
+	}, nil
+}
@@ -0,0 +1,136 @@
+package checks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethpandaops/panda-pulse/pkg/clients"
+	"github.com/ethpandaops/panda-pulse/pkg/grafana"
+	"github.com/ethpandaops/panda-pulse/pkg/grafana/mock"
+	"github.com/ethpandaops/panda-pulse/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestELFinalizedEpochCheck_Run(t *testing.T) {
+	failingResponse := &grafana.QueryResponse{
+		Results: grafana.QueryResults{
+			PandaPulse: grafana.QueryPandaPulse{
+				Frames: []grafana.QueryFrame{
+					{
+						Schema: grafana.QuerySchema{
+							Fields: []grafana.QueryField{
+								{
+									Labels: map[string]string{
+										"instance":     "user1-lighthouse-geth-1",
+										"ingress_user": "user1",
+									},
+								},
+							},
+						},
+						Data: grafana.QueryData{
+							Values: []any{-100.0},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		mockResponse   *grafana.QueryResponse
+		mockError      error
+		expectedStatus Status
+		expectedNodes  []string
+		expectError    bool
+	}{
+		{
+			name:           "all nodes keeping up",
+			mockResponse:   &grafana.QueryResponse{},
+			expectedStatus: StatusOK,
+			expectedNodes:  []string{},
+		},
+		{
+			name:           "nodes behind",
+			mockResponse:   failingResponse,
+			expectedStatus: StatusFail,
+			expectedNodes:  []string{"lighthouse-geth-1"},
+		},
+		{
+			name:        "grafana error",
+			mockError:   assert.AnError,
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mock.NewMockClient(ctrl)
+			mockClient.EXPECT().Query(gomock.Any(), gomock.Any()).Return(tt.mockResponse, tt.mockError)
+
+			check := NewELFinalizedEpochCheck(mockClient)
+			result, err := check.Run(context.Background(), logger.NewCheckLogger("id"), Config{
+				Network:       "mainnet",
+				ConsensusNode: "lighthouse",
+				ExecutionNode: "geth",
+			})
+
+			if tt.expectError {
+				require.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, result.Status)
+			assert.Equal(t, tt.expectedNodes, result.AffectedNodes)
+			assert.Contains(t, result.Details, "query")
+
+			if tt.expectedStatus == StatusFail {
+				assert.Equal(t, "lighthouse-geth-1", result.Details[BehindNodesDetailKey])
+				assert.Equal(t, []string{BehindNodesDetailKey}, result.InstanceKeys())
+			}
+		})
+	}
+}
+
+func TestELFinalizedEpochCheck_FinalizedBlockLag(t *testing.T) {
+	tests := []struct {
+		name       string
+		thresholds Thresholds
+		expected   string
+	}{
+		{name: "default", expected: "< -64"},
+		{name: "override", thresholds: Thresholds{FinalizedBlockLag: 96}, expected: "< -96"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mock.NewMockClient(ctrl)
+			mockClient.EXPECT().Query(gomock.Any(), gomock.Any()).Return(&grafana.QueryResponse{}, nil)
+
+			check := NewELFinalizedEpochCheck(mockClient)
+			result, err := check.Run(context.Background(), logger.NewCheckLogger("id"), Config{
+				Network:    "mainnet",
+				Thresholds: tt.thresholds,
+			})
+			require.NoError(t, err)
+			assert.Contains(t, result.Details["query"], tt.expected)
+		})
+	}
+}
+
+func TestELFinalizedEpochCheck_Metadata(t *testing.T) {
+	check := NewELFinalizedEpochCheck(nil)
+	assert.Equal(t, "Finalized block behind", check.Name())
+	assert.Equal(t, CategorySync, check.Category())
+	assert.Equal(t, clients.ClientTypeEL, check.ClientType())
+}
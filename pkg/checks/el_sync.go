@@ -74,11 +74,12 @@ func (c *ELSyncCheck) Run(ctx context.Context, log *logger.CheckLogger, cfg Conf
 		log.Printf("  - All nodes are synced")
 
 		return &Result{
-			Name:        c.Name(),
-			Category:    c.Category(),
-			Status:      StatusOK,
-			Description: "All EL nodes are synced",
-			Timestamp:   time.Now(),
+			Name:          c.Name(),
+			Category:      c.Category(),
+			Status:        StatusOK,
+			Description:   "All EL nodes are synced",
+			Timestamp:     time.Now(),
+			DataTimestamp: response.LatestTimestamp(),
 			Details: map[string]any{
 				"query": query,
 			},
@@ -87,15 +88,17 @@ func (c *ELSyncCheck) Run(ctx context.Context, log *logger.CheckLogger, cfg Conf
 	}
 
 	return &Result{
-		Name:        c.Name(),
-		Category:    c.Category(),
-		Status:      StatusFail,
-		Description: "The following EL nodes are not synced",
-		Timestamp:   time.Now(),
+		Name:          c.Name(),
+		Category:      c.Category(),
+		Status:        StatusFail,
+		Description:   "The following EL nodes are not synced",
+		Timestamp:     time.Now(),
+		DataTimestamp: response.LatestTimestamp(),
 		Details: map[string]any{
-			"query":          query,
-			"notSyncedNodes": strings.Join(notSyncedNodes, "\n"),
+			"query":                 query,
+			NotSyncedNodesDetailKey: strings.Join(notSyncedNodes, "\n"),
 		},
-		AffectedNodes: notSyncedNodes,
+		AffectedNodes:      notSyncedNodes,
+		InstanceDetailKeys: []string{NotSyncedNodesDetailKey},
 	}, nil
 }
@@ -0,0 +1,55 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/ethpandaops/panda-pulse/pkg/grafana"
+)
+
+// Every node runs a CL client, so its head slot lists every instance on the network.
+const queryNetworkInstances = `
+	max by (instance, ingress_user) (beacon_head_slot{network=~"%s", ingress_user!~"synctest.*"})
+`
+
+// InstanceLister lists the instances running on a network, healthy or not.
+type InstanceLister interface {
+	// ListInstances returns the names of the network's instances.
+	ListInstances(ctx context.Context, network string) ([]string, error)
+}
+
+// grafanaInstanceLister lists a network's instances from the metrics they report to Grafana.
+type grafanaInstanceLister struct {
+	grafanaClient grafana.Client
+}
+
+// NewGrafanaInstanceLister creates an InstanceLister listing the instances reporting to Grafana.
+func NewGrafanaInstanceLister(grafanaClient grafana.Client) InstanceLister {
+	return &grafanaInstanceLister{
+		grafanaClient: grafanaClient,
+	}
+}
+
+// ListInstances returns the names of the network's instances, named as the checks name them.
+func (l *grafanaInstanceLister) ListInstances(ctx context.Context, network string) ([]string, error) {
+	response, err := l.grafanaClient.Query(ctx, fmt.Sprintf(queryNetworkInstances, network))
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	instances := make([]string, 0)
+
+	for _, frame := range response.Results.PandaPulse.Frames {
+		for _, field := range frame.Schema.Fields {
+			if labels := field.Labels; labels != nil && labels["instance"] != "" {
+				instances = append(instances, strings.ReplaceAll(labels["instance"], labels["ingress_user"]+"-", ""))
+			}
+		}
+	}
+
+	slices.Sort(instances)
+
+	return slices.Compact(instances), nil
+}
@@ -0,0 +1,67 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethpandaops/panda-pulse/pkg/grafana"
+)
+
+const (
+	queryNetworkNodes = `
+	count by (instance, ingress_user)(
+		eth_con_sync_is_syncing{network=~"%s", ingress_user!~"synctest.*"}
+	)
+`
+	queryNetworkHealthyNodes = `
+	count by (instance, ingress_user)(
+		eth_con_sync_is_syncing{network=~"%s", ingress_user!~"synctest.*"} == 0
+	)
+`
+)
+
+// NetworkHealth is the sync status of every node on a network, regardless of client.
+type NetworkHealth struct {
+	Nodes   int // Nodes reporting their sync status.
+	Healthy int // Nodes reporting they're synced.
+}
+
+// IsDown returns true if fewer than minHealthy nodes are synced. A network with no nodes reporting
+// isn't down, there's just no data to go on.
+func (h *NetworkHealth) IsDown(minHealthy int) bool {
+	return h.Nodes > 0 && h.Healthy < minHealthy
+}
+
+// QueryNetworkHealth queries the sync status of every node on the network, so a network-wide
+// outage can be told apart from a problem with individual clients.
+func QueryNetworkHealth(ctx context.Context, grafanaClient grafana.Client, network string) (*NetworkHealth, error) {
+	nodes, err := grafanaClient.Query(ctx, fmt.Sprintf(queryNetworkNodes, network))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query network nodes: %w", err)
+	}
+
+	healthy, err := grafanaClient.Query(ctx, fmt.Sprintf(queryNetworkHealthyNodes, network))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query healthy network nodes: %w", err)
+	}
+
+	return &NetworkHealth{
+		Nodes:   countInstances(nodes),
+		Healthy: countInstances(healthy),
+	}, nil
+}
+
+// countInstances returns the number of distinct instances in a query response.
+func countInstances(response *grafana.QueryResponse) int {
+	instances := make(map[string]bool)
+
+	for _, frame := range response.Results.PandaPulse.Frames {
+		for _, field := range frame.Schema.Fields {
+			if instance := field.Labels["instance"]; instance != "" {
+				instances[instance] = true
+			}
+		}
+	}
+
+	return len(instances)
+}
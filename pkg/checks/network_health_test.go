@@ -0,0 +1,70 @@
+package checks
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/ethpandaops/panda-pulse/pkg/grafana"
+	"github.com/ethpandaops/panda-pulse/pkg/grafana/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// instancesResponse returns a query response with a frame per instance.
+func instancesResponse(instances ...string) *grafana.QueryResponse {
+	frames := make([]grafana.QueryFrame, 0, len(instances))
+
+	for _, instance := range instances {
+		frames = append(frames, grafana.QueryFrame{
+			Schema: grafana.QuerySchema{
+				Fields: []grafana.QueryField{
+					{Labels: map[string]string{"instance": instance, "ingress_user": "user1"}},
+				},
+			},
+		})
+	}
+
+	return &grafana.QueryResponse{
+		Results: grafana.QueryResults{PandaPulse: grafana.QueryPandaPulse{Frames: frames}},
+	}
+}
+
+func TestQueryNetworkHealth(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockClient := mock.NewMockClient(ctrl)
+
+	mockClient.EXPECT().Query(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, query string) (*grafana.QueryResponse, error) {
+			assert.Contains(t, query, `network=~"devnet-0"`)
+
+			if strings.Contains(query, "== 0") {
+				return instancesResponse("lighthouse-geth-1"), nil
+			}
+
+			return instancesResponse("lighthouse-geth-1", "prysm-geth-1", "teku-besu-1"), nil
+		},
+	).Times(2)
+
+	health, err := QueryNetworkHealth(context.Background(), mockClient, "devnet-0")
+	require.NoError(t, err)
+	assert.Equal(t, &NetworkHealth{Nodes: 3, Healthy: 1}, health)
+	assert.False(t, health.IsDown(1))
+	assert.True(t, health.IsDown(2))
+
+	t.Run("grafana error", func(t *testing.T) {
+		mockClient.EXPECT().Query(gomock.Any(), gomock.Any()).Return(nil, assert.AnError)
+
+		_, err := QueryNetworkHealth(context.Background(), mockClient, "devnet-0")
+		require.Error(t, err)
+	})
+}
+
+func TestNetworkHealth_IsDown(t *testing.T) {
+	assert.True(t, (&NetworkHealth{Nodes: 10}).IsDown(1))
+	assert.False(t, (&NetworkHealth{Nodes: 10, Healthy: 1}).IsDown(1))
+
+	// No data isn't an outage.
+	assert.False(t, (&NetworkHealth{}).IsDown(1))
+}
@@ -0,0 +1,167 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ethpandaops/panda-pulse/pkg/clients"
+	"github.com/ethpandaops/panda-pulse/pkg/grafana"
+	"github.com/ethpandaops/panda-pulse/pkg/logger"
+)
+
+const (
+	// DefaultFlappingWindow is how far back sync status changes are counted.
+	DefaultFlappingWindow = time.Hour
+	// DefaultFlappingThreshold is how many sync status changes within the window mark a node as flapping.
+	DefaultFlappingThreshold = 4
+	// FlappingNodesDetailKey is the result detail key flapping nodes are reported under, so they can be
+	// surfaced separately from nodes that are cleanly stuck.
+	FlappingNodesDetailKey = "flappingNodes"
+)
+
+const querySyncFlapping = `
+	count by (instance, ingress_user, consensus_client, execution_client)(
+		changes(%s{network=~"%s", consensus_client=~"%s", execution_client=~"%s", ingress_user!~"synctest.*"}[%ds]) > %d
+	)
+`
+
+// FlappingConfig configures the sync flapping checks.
+type FlappingConfig struct {
+	// Window is how far back sync status changes are counted.
+	Window time.Duration
+	// Threshold is the number of sync status changes within the window above which a node is flapping.
+	Threshold int
+}
+
+// withDefaults returns a copy of the config with any unset values defaulted.
+func (c FlappingConfig) withDefaults() FlappingConfig {
+	if c.Window <= 0 {
+		c.Window = DefaultFlappingWindow
+	}
+
+	if c.Threshold <= 0 {
+		c.Threshold = DefaultFlappingThreshold
+	}
+
+	return c
+}
+
+// SyncFlappingCheck is a check that finds nodes whose sync status keeps toggling between synced and
+// syncing. A point in time sync check can miss these, or report them as stuck when they aren't.
+type SyncFlappingCheck struct {
+	grafanaClient grafana.Client
+	clientType    clients.ClientType
+	layer         string
+	metric        string
+	config        FlappingConfig
+}
+
+// NewCLSyncFlappingCheck creates a new SyncFlappingCheck for CL nodes.
+func NewCLSyncFlappingCheck(grafanaClient grafana.Client, cfg FlappingConfig) *SyncFlappingCheck {
+	return &SyncFlappingCheck{
+		grafanaClient: grafanaClient,
+		clientType:    clients.ClientTypeCL,
+		layer:         "CL",
+		metric:        "eth_con_sync_is_syncing",
+		config:        cfg.withDefaults(),
+	}
+}
+
+// NewELSyncFlappingCheck creates a new SyncFlappingCheck for EL nodes.
+func NewELSyncFlappingCheck(grafanaClient grafana.Client, cfg FlappingConfig) *SyncFlappingCheck {
+	return &SyncFlappingCheck{
+		grafanaClient: grafanaClient,
+		clientType:    clients.ClientTypeEL,
+		layer:         "EL",
+		metric:        "eth_exe_sync_is_syncing",
+		config:        cfg.withDefaults(),
+	}
+}
+
+// Name returns the name of the check.
+func (c *SyncFlappingCheck) Name() string {
+	return "Node sync status flapping"
+}
+
+// Category returns the category of the check.
+func (c *SyncFlappingCheck) Category() Category {
+	return CategorySync
+}
+
+// ClientType returns the client type of the check.
+func (c *SyncFlappingCheck) ClientType() clients.ClientType {
+	return c.clientType
+}
+
+// Run executes the check.
+func (c *SyncFlappingCheck) Run(ctx context.Context, log *logger.CheckLogger, cfg Config) (*Result, error) {
+	query := fmt.Sprintf(
+		querySyncFlapping,
+		c.metric,
+		cfg.Network,
+		cfg.ConsensusNode,
+		cfg.ExecutionNode,
+		int(c.config.Window.Seconds()),
+		c.config.Threshold,
+	)
+
+	log.Printf("\n=== Running %s sync flapping check", c.layer)
+
+	response, err := c.grafanaClient.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	// Pull out flapping nodes by their labels.
+	var flappingNodes []string
+
+	for _, frame := range response.Results.PandaPulse.Frames {
+		for _, field := range frame.Schema.Fields {
+			if labels := field.Labels; labels != nil {
+				if labels["instance"] != "" {
+					nodeName := strings.ReplaceAll(labels["instance"], labels["ingress_user"]+"-", "")
+					flappingNodes = append(flappingNodes, nodeName)
+					log.Printf("  - Flapping node: %s", nodeName)
+				}
+			}
+		}
+	}
+
+	if len(flappingNodes) == 0 {
+		log.Printf("  - No nodes are flapping")
+
+		return &Result{
+			Name:          c.Name(),
+			Category:      c.Category(),
+			Status:        StatusOK,
+			Description:   fmt.Sprintf("No %s nodes are flapping", c.layer),
+			Timestamp:     time.Now(),
+			DataTimestamp: response.LatestTimestamp(),
+			Details: map[string]any{
+				"query": query,
+			},
+			AffectedNodes: []string{},
+		}, nil
+	}
+
+	return &Result{
+		Name:     c.Name(),
+		Category: c.Category(),
+		Status:   StatusFail,
+		Description: fmt.Sprintf(
+			"The following %s nodes changed sync status more than %d times in the last %s",
+			c.layer,
+			c.config.Threshold,
+			c.config.Window,
+		),
+		Timestamp:     time.Now(),
+		DataTimestamp: response.LatestTimestamp(),
+		Details: map[string]any{
+			"query":                query,
+			FlappingNodesDetailKey: strings.Join(flappingNodes, "\n"),
+		},
+		AffectedNodes: flappingNodes,
+	}, nil
+}
@@ -0,0 +1,154 @@
+package checks
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethpandaops/panda-pulse/pkg/clients"
+	"github.com/ethpandaops/panda-pulse/pkg/grafana"
+	"github.com/ethpandaops/panda-pulse/pkg/grafana/mock"
+	"github.com/ethpandaops/panda-pulse/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSyncFlappingCheck_Run(t *testing.T) {
+	flappingResponse := &grafana.QueryResponse{
+		Results: grafana.QueryResults{
+			PandaPulse: grafana.QueryPandaPulse{
+				Frames: []grafana.QueryFrame{
+					{
+						Schema: grafana.QuerySchema{
+							Fields: []grafana.QueryField{
+								{
+									Labels: map[string]string{
+										"instance":     "user1-lighthouse-geth-1",
+										"ingress_user": "user1",
+									},
+								},
+							},
+						},
+						Data: grafana.QueryData{
+							Values: []any{1.0},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		check          func(grafana.Client) *SyncFlappingCheck
+		mockResponse   *grafana.QueryResponse
+		mockError      error
+		expectedStatus Status
+		expectedNodes  []string
+		expectError    bool
+	}{
+		{
+			name: "no CL nodes flapping",
+			check: func(c grafana.Client) *SyncFlappingCheck {
+				return NewCLSyncFlappingCheck(c, FlappingConfig{})
+			},
+			mockResponse:   &grafana.QueryResponse{},
+			expectedStatus: StatusOK,
+			expectedNodes:  []string{},
+		},
+		{
+			name: "CL nodes flapping",
+			check: func(c grafana.Client) *SyncFlappingCheck {
+				return NewCLSyncFlappingCheck(c, FlappingConfig{})
+			},
+			mockResponse:   flappingResponse,
+			expectedStatus: StatusFail,
+			expectedNodes:  []string{"lighthouse-geth-1"},
+		},
+		{
+			name: "EL nodes flapping",
+			check: func(c grafana.Client) *SyncFlappingCheck {
+				return NewELSyncFlappingCheck(c, FlappingConfig{})
+			},
+			mockResponse:   flappingResponse,
+			expectedStatus: StatusFail,
+			expectedNodes:  []string{"lighthouse-geth-1"},
+		},
+		{
+			name: "grafana error",
+			check: func(c grafana.Client) *SyncFlappingCheck {
+				return NewCLSyncFlappingCheck(c, FlappingConfig{})
+			},
+			mockError:   assert.AnError,
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mock.NewMockClient(ctrl)
+			mockClient.EXPECT().Query(gomock.Any(), gomock.Any()).Return(tt.mockResponse, tt.mockError)
+
+			log := logger.NewCheckLogger("id")
+			result, err := tt.check(mockClient).Run(context.Background(), log, Config{
+				Network:       "mainnet",
+				ConsensusNode: "lighthouse",
+				ExecutionNode: "geth",
+			})
+
+			if tt.expectError {
+				require.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, result.Status)
+			assert.Equal(t, tt.expectedNodes, result.AffectedNodes)
+			assert.Contains(t, result.Details, "query")
+
+			if tt.expectedStatus == StatusFail {
+				assert.Equal(t, "lighthouse-geth-1", result.Details[FlappingNodesDetailKey])
+			}
+		})
+	}
+}
+
+func TestSyncFlappingCheck_Query(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var query string
+
+	mockClient := mock.NewMockClient(ctrl)
+	mockClient.EXPECT().Query(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, q string) (*grafana.QueryResponse, error) {
+			query = q
+
+			return &grafana.QueryResponse{}, nil
+		},
+	)
+
+	check := NewELSyncFlappingCheck(mockClient, FlappingConfig{Window: 30 * time.Minute, Threshold: 6})
+	_, err := check.Run(context.Background(), logger.NewCheckLogger("id"), Config{Network: "mainnet"})
+	require.NoError(t, err)
+
+	assert.Contains(t, query, "changes(eth_exe_sync_is_syncing{")
+	assert.Contains(t, query, "[1800s]) > 6")
+}
+
+func TestSyncFlappingCheck_Defaults(t *testing.T) {
+	check := NewCLSyncFlappingCheck(nil, FlappingConfig{})
+	assert.Equal(t, DefaultFlappingWindow, check.config.Window)
+	assert.Equal(t, DefaultFlappingThreshold, check.config.Threshold)
+}
+
+func TestSyncFlappingCheck_ClientType(t *testing.T) {
+	assert.Equal(t, clients.ClientTypeCL, NewCLSyncFlappingCheck(nil, FlappingConfig{}).ClientType())
+	assert.Equal(t, clients.ClientTypeEL, NewELSyncFlappingCheck(nil, FlappingConfig{}).ClientType())
+	assert.Equal(t, CategorySync, NewCLSyncFlappingCheck(nil, FlappingConfig{}).Category())
+}
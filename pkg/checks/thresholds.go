@@ -0,0 +1,211 @@
+package checks
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+const (
+	// DefaultBlockLag is how many blocks an EL node can trail the network's highest block by.
+	DefaultBlockLag = 5
+	// DefaultFinalizedEpochLag is how many epochs a CL node's finalized epoch can trail the network's by.
+	DefaultFinalizedEpochLag = 4
+	// DefaultFinalizedBlockLag is how many blocks an EL node's finalized block can trail the network median by.
+	DefaultFinalizedBlockLag = 64
+	// DefaultHeadSlotWindow is how long a CL node's head slot can go without advancing.
+	DefaultHeadSlotWindow = 5 * time.Minute
+	// DefaultParticipationRate is the attestation participation percentage a CL node can't fall below.
+	DefaultParticipationRate = 80
+)
+
+// Threshold names, as used for per-network overrides.
+const (
+	ThresholdBlockLag          = "block-lag"
+	ThresholdFinalizedEpochLag = "finalized-epoch-lag"
+	ThresholdFinalizedBlockLag = "finalized-block-lag"
+	ThresholdHeadSlotWindow    = "head-slot-window"
+	ThresholdFlappingWindow    = "flapping-window"
+	ThresholdFlappingThreshold = "flapping-threshold"
+	ThresholdParticipationRate = "participation-rate"
+)
+
+// thresholdNames is the order thresholds are listed in.
+var thresholdNames = []string{
+	ThresholdBlockLag,
+	ThresholdFinalizedEpochLag,
+	ThresholdFinalizedBlockLag,
+	ThresholdHeadSlotWindow,
+	ThresholdFlappingWindow,
+	ThresholdFlappingThreshold,
+	ThresholdParticipationRate,
+}
+
+// Thresholds tunes how far behind a node can fall before the checks fail it. Unset values fall
+// back to the defaults.
+type Thresholds struct {
+	// BlockLag is how many blocks an EL node can trail the network's highest block by.
+	BlockLag int
+	// FinalizedEpochLag is how many epochs a CL node's finalized epoch can trail the network's by.
+	FinalizedEpochLag int
+	// FinalizedBlockLag is how many blocks an EL node's finalized block can trail the network median by.
+	FinalizedBlockLag int
+	// HeadSlotWindow is how long a CL node's head slot can go without advancing.
+	HeadSlotWindow time.Duration
+	// Flapping configures the sync flapping checks.
+	Flapping FlappingConfig
+	// ParticipationRate is the attestation participation percentage a CL node can't fall below.
+	ParticipationRate int
+}
+
+// ThresholdNames returns the names of the thresholds that can be overridden.
+func ThresholdNames() []string {
+	return append([]string(nil), thresholdNames...)
+}
+
+// withDefaults returns a copy of the thresholds with any unset values defaulted.
+func (t Thresholds) withDefaults() Thresholds {
+	if t.BlockLag <= 0 {
+		t.BlockLag = DefaultBlockLag
+	}
+
+	if t.FinalizedEpochLag <= 0 {
+		t.FinalizedEpochLag = DefaultFinalizedEpochLag
+	}
+
+	if t.FinalizedBlockLag <= 0 {
+		t.FinalizedBlockLag = DefaultFinalizedBlockLag
+	}
+
+	if t.HeadSlotWindow <= 0 {
+		t.HeadSlotWindow = DefaultHeadSlotWindow
+	}
+
+	if t.ParticipationRate <= 0 {
+		t.ParticipationRate = DefaultParticipationRate
+	}
+
+	t.Flapping = t.Flapping.withDefaults()
+
+	return t
+}
+
+// WithOverride returns a copy of the thresholds with the named threshold set to value. Counts are
+// whole numbers and windows are durations such as "10m", both must be positive. Rates are whole
+// percentages from 1 to 100.
+func (t Thresholds) WithOverride(name, value string) (Thresholds, error) {
+	switch name {
+	case ThresholdBlockLag:
+		n, err := parsePositiveInt(value)
+		if err != nil {
+			return t, fmt.Errorf("invalid %s: %w", name, err)
+		}
+
+		t.BlockLag = n
+	case ThresholdFinalizedEpochLag:
+		n, err := parsePositiveInt(value)
+		if err != nil {
+			return t, fmt.Errorf("invalid %s: %w", name, err)
+		}
+
+		t.FinalizedEpochLag = n
+	case ThresholdFinalizedBlockLag:
+		n, err := parsePositiveInt(value)
+		if err != nil {
+			return t, fmt.Errorf("invalid %s: %w", name, err)
+		}
+
+		t.FinalizedBlockLag = n
+	case ThresholdHeadSlotWindow:
+		d, err := parsePositiveDuration(value)
+		if err != nil {
+			return t, fmt.Errorf("invalid %s: %w", name, err)
+		}
+
+		// The window is queried as a range in whole seconds, anything shorter isn't a valid range.
+		if d < time.Second {
+			return t, fmt.Errorf("invalid %s: %s must be at least 1s", name, d)
+		}
+
+		t.HeadSlotWindow = d
+	case ThresholdFlappingWindow:
+		d, err := parsePositiveDuration(value)
+		if err != nil {
+			return t, fmt.Errorf("invalid %s: %w", name, err)
+		}
+
+		t.Flapping.Window = d
+	case ThresholdFlappingThreshold:
+		n, err := parsePositiveInt(value)
+		if err != nil {
+			return t, fmt.Errorf("invalid %s: %w", name, err)
+		}
+
+		t.Flapping.Threshold = n
+	case ThresholdParticipationRate:
+		n, err := parsePositiveInt(value)
+		if err != nil {
+			return t, fmt.Errorf("invalid %s: %w", name, err)
+		}
+
+		if n > 100 {
+			return t, fmt.Errorf("invalid %s: %d must be a percentage, at most 100", name, n)
+		}
+
+		t.ParticipationRate = n
+	default:
+		return t, fmt.Errorf("unknown threshold: %s", name)
+	}
+
+	return t, nil
+}
+
+// Value returns the effective value of the named threshold, formatted the same way overrides are.
+func (t Thresholds) Value(name string) string {
+	t = t.withDefaults()
+
+	switch name {
+	case ThresholdBlockLag:
+		return strconv.Itoa(t.BlockLag)
+	case ThresholdFinalizedEpochLag:
+		return strconv.Itoa(t.FinalizedEpochLag)
+	case ThresholdFinalizedBlockLag:
+		return strconv.Itoa(t.FinalizedBlockLag)
+	case ThresholdHeadSlotWindow:
+		return t.HeadSlotWindow.String()
+	case ThresholdFlappingWindow:
+		return t.Flapping.Window.String()
+	case ThresholdFlappingThreshold:
+		return strconv.Itoa(t.Flapping.Threshold)
+	case ThresholdParticipationRate:
+		return strconv.Itoa(t.ParticipationRate)
+	}
+
+	return ""
+}
+
+func parsePositiveInt(value string) (int, error) {
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a whole number", value)
+	}
+
+	if n <= 0 {
+		return 0, fmt.Errorf("%d must be positive", n)
+	}
+
+	return n, nil
+}
+
+func parsePositiveDuration(value string) (time.Duration, error) {
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a duration, eg 10m", value)
+	}
+
+	if d <= 0 {
+		return 0, fmt.Errorf("%s must be positive", d)
+	}
+
+	return d, nil
+}
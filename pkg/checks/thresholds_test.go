@@ -0,0 +1,102 @@
+package checks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestThresholds_WithOverride(t *testing.T) {
+	tests := []struct {
+		name     string
+		override string
+		value    string
+		check    func(t *testing.T, thresholds Thresholds)
+		wantErr  string
+	}{
+		{
+			name:     "block lag",
+			override: ThresholdBlockLag,
+			value:    "10",
+			check: func(t *testing.T, thresholds Thresholds) {
+				t.Helper()
+				assert.Equal(t, 10, thresholds.BlockLag)
+			},
+		},
+		{
+			name:     "finalized block lag",
+			override: ThresholdFinalizedBlockLag,
+			value:    "96",
+			check: func(t *testing.T, thresholds Thresholds) {
+				t.Helper()
+				assert.Equal(t, 96, thresholds.FinalizedBlockLag)
+			},
+		},
+		{
+			name:     "head slot window",
+			override: ThresholdHeadSlotWindow,
+			value:    "15m",
+			check: func(t *testing.T, thresholds Thresholds) {
+				t.Helper()
+				assert.Equal(t, 15*time.Minute, thresholds.HeadSlotWindow)
+			},
+		},
+		{
+			name:     "flapping threshold",
+			override: ThresholdFlappingThreshold,
+			value:    "8",
+			check: func(t *testing.T, thresholds Thresholds) {
+				t.Helper()
+				assert.Equal(t, 8, thresholds.Flapping.Threshold)
+			},
+		},
+		{
+			name:     "participation rate",
+			override: ThresholdParticipationRate,
+			value:    "90",
+			check: func(t *testing.T, thresholds Thresholds) {
+				t.Helper()
+				assert.Equal(t, 90, thresholds.ParticipationRate)
+			},
+		},
+		{name: "not a percentage", override: ThresholdParticipationRate, value: "120", wantErr: "must be a percentage"},
+		{name: "not a number", override: ThresholdFinalizedEpochLag, value: "lots", wantErr: "not a whole number"},
+		{name: "not positive", override: ThresholdBlockLag, value: "0", wantErr: "must be positive"},
+		{name: "head slot window under a second", override: ThresholdHeadSlotWindow, value: "500ms", wantErr: "must be at least 1s"},
+		{name: "not a duration", override: ThresholdFlappingWindow, value: "10", wantErr: "not a duration"},
+		{name: "unknown threshold", override: "peer-minimum", value: "5", wantErr: "unknown threshold"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			thresholds, err := Thresholds{}.WithOverride(tt.override, tt.value)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			tt.check(t, thresholds)
+		})
+	}
+}
+
+func TestThresholds_Value(t *testing.T) {
+	thresholds := Thresholds{BlockLag: 12, Flapping: FlappingConfig{Window: 30 * time.Minute}}
+
+	assert.Equal(t, "12", thresholds.Value(ThresholdBlockLag))
+	assert.Equal(t, "4", thresholds.Value(ThresholdFinalizedEpochLag))
+	assert.Equal(t, "64", thresholds.Value(ThresholdFinalizedBlockLag))
+	assert.Equal(t, "5m0s", thresholds.Value(ThresholdHeadSlotWindow))
+	assert.Equal(t, "30m0s", thresholds.Value(ThresholdFlappingWindow))
+	assert.Equal(t, "4", thresholds.Value(ThresholdFlappingThreshold))
+	assert.Equal(t, "80", thresholds.Value(ThresholdParticipationRate))
+
+	for _, name := range ThresholdNames() {
+		assert.NotEmpty(t, thresholds.Value(name), name)
+	}
+}
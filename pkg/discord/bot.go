@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/bwmarrin/discordgo"
@@ -15,6 +16,7 @@ import (
 	"github.com/ethpandaops/panda-pulse/pkg/hive"
 	"github.com/ethpandaops/panda-pulse/pkg/queue"
 	"github.com/ethpandaops/panda-pulse/pkg/scheduler"
+	"github.com/ethpandaops/panda-pulse/pkg/slack"
 	"github.com/ethpandaops/panda-pulse/pkg/store"
 	"github.com/sirupsen/logrus"
 )
@@ -35,8 +37,16 @@ type BotServices interface {
 	GetChecksRepo() *store.ChecksRepo
 	GetMentionsRepo() *store.MentionsRepo
 	GetHiveSummaryRepo() *store.HiveSummaryRepo
+	GetRoutesRepo() *store.RoutesRepo
+	GetVersionsRepo() *store.VersionsRepo
+	GetNotificationsRepo() *store.NotificationsRepo
+	GetJobRunsRepo() *store.JobRunsRepo
+	GetThresholdsRepo() *store.ThresholdsRepo
+	GetGuildConfigRepo() *store.GuildConfigRepo
+	GetIncidentsRepo() *store.IncidentsRepo
 	GetGrafana() grafana.Client
 	GetHive() hive.Hive
+	GetSlack() slack.Slack
 	GetCartographoor() *cartographoor.Service
 }
 
@@ -45,6 +55,8 @@ type Bot interface {
 	BotCore
 	BotServices
 	GetRoleConfig() *common.RoleConfig
+	IsMaintenance() bool
+	SetMaintenance(enabled bool)
 	SetCommands(commands []common.Command)
 	GetQueues() []queue.Queuer
 }
@@ -59,11 +71,21 @@ type DiscordBot struct {
 	checksRepo      *store.ChecksRepo
 	mentionsRepo    *store.MentionsRepo
 	hiveSummaryRepo *store.HiveSummaryRepo
+	routesRepo      *store.RoutesRepo
+	versionsRepo    *store.VersionsRepo
+	notifsRepo      *store.NotificationsRepo
+	jobRunsRepo     *store.JobRunsRepo
+	thresholdsRepo  *store.ThresholdsRepo
+	guildConfigRepo *store.GuildConfigRepo
+	incidentsRepo   *store.IncidentsRepo
 	grafana         grafana.Client
 	hive            hive.Hive
+	slack           slack.Slack
 	cartographoor   *cartographoor.Service
 	commands        []common.Command
 	metrics         *Metrics
+	maintenance     atomic.Bool
+	summaryPruned   atomic.Bool // Whether Hive summary results have been pruned since starting.
 }
 
 // NewBot creates a new Discord bot.
@@ -75,17 +97,35 @@ func NewBot(
 	checksRepo *store.ChecksRepo,
 	mentionsRepo *store.MentionsRepo,
 	hiveSummaryRepo *store.HiveSummaryRepo,
+	routesRepo *store.RoutesRepo,
+	versionsRepo *store.VersionsRepo,
+	notifsRepo *store.NotificationsRepo,
+	jobRunsRepo *store.JobRunsRepo,
+	thresholdsRepo *store.ThresholdsRepo,
+	guildConfigRepo *store.GuildConfigRepo,
+	incidentsRepo *store.IncidentsRepo,
 	grafana grafana.Client,
 	hive hive.Hive,
+	slack slack.Slack,
 	metrics *Metrics,
 	cartographoor *cartographoor.Service,
 ) (Bot, error) {
+	intents, err := ParseIntents(cfg.Intents)
+	if err != nil {
+		return nil, fmt.Errorf("invalid discord intents: %w", err)
+	}
+
 	// Create a new Discord session.
 	session, err := discordgo.New("Bot " + cfg.DiscordToken)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create discord session: %w", err)
 	}
 
+	// Request only the gateway intents we need, rather than discordgo's defaults.
+	session.Identify.Intents = intents
+
+	validateIntents(log, intents)
+
 	bot := &DiscordBot{
 		log:             log,
 		config:          cfg,
@@ -95,14 +135,24 @@ func NewBot(
 		checksRepo:      checksRepo,
 		mentionsRepo:    mentionsRepo,
 		hiveSummaryRepo: hiveSummaryRepo,
+		routesRepo:      routesRepo,
+		versionsRepo:    versionsRepo,
+		notifsRepo:      notifsRepo,
+		jobRunsRepo:     jobRunsRepo,
+		thresholdsRepo:  thresholdsRepo,
+		guildConfigRepo: guildConfigRepo,
+		incidentsRepo:   incidentsRepo,
 		grafana:         grafana,
 		hive:            hive,
+		slack:           slack,
 		//clientsService:  clientsService,
 		cartographoor: cartographoor,
 		commands:      make([]common.Command, 0),
 		metrics:       metrics,
 	}
 
+	bot.SetMaintenance(cfg.Maintenance)
+
 	// Register event handlers.
 	session.AddHandler(bot.handleInteraction)
 
@@ -118,6 +168,13 @@ func (b *DiscordBot) SetCommands(commands []common.Command) {
 func (b *DiscordBot) Start(ctx context.Context) error {
 	// Open connection with Discord.
 	if err := b.session.Open(); err != nil {
+		if b.session.Identify.Intents&PrivilegedIntents != 0 {
+			return fmt.Errorf(
+				"failed to open discord connection (check privileged intents are enabled in the developer portal): %w",
+				err,
+			)
+		}
+
 		return fmt.Errorf("failed to open discord connection: %w", err)
 	}
 
@@ -168,6 +225,16 @@ func (b *DiscordBot) Start(ctx context.Context) error {
 		return fmt.Errorf("failed to schedule choice refresh: %w", err)
 	}
 
+	// Schedule periodic checks for alerts whose channel has been deleted.
+	if err := b.scheduleOrphanedAlertsReconciliation(); err != nil {
+		return fmt.Errorf("failed to schedule orphaned alerts reconciliation: %w", err)
+	}
+
+	// Schedule periodic pruning of old Hive summary results.
+	if err := b.scheduleSummaryRetention(); err != nil {
+		return fmt.Errorf("failed to schedule Hive summary result retention: %w", err)
+	}
+
 	return nil
 }
 
@@ -211,6 +278,41 @@ func (b *DiscordBot) GetHiveSummaryRepo() *store.HiveSummaryRepo {
 	return b.hiveSummaryRepo
 }
 
+// GetRoutesRepo returns the alert routes repository.
+func (b *DiscordBot) GetRoutesRepo() *store.RoutesRepo {
+	return b.routesRepo
+}
+
+// GetVersionsRepo returns the client versions repository.
+func (b *DiscordBot) GetVersionsRepo() *store.VersionsRepo {
+	return b.versionsRepo
+}
+
+// GetNotificationsRepo returns the last notifications repository.
+func (b *DiscordBot) GetNotificationsRepo() *store.NotificationsRepo {
+	return b.notifsRepo
+}
+
+// GetJobRunsRepo returns the scheduled job runs repository.
+func (b *DiscordBot) GetJobRunsRepo() *store.JobRunsRepo {
+	return b.jobRunsRepo
+}
+
+// GetThresholdsRepo returns the check thresholds repository.
+func (b *DiscordBot) GetThresholdsRepo() *store.ThresholdsRepo {
+	return b.thresholdsRepo
+}
+
+// GetGuildConfigRepo returns the per-guild config overrides repository.
+func (b *DiscordBot) GetGuildConfigRepo() *store.GuildConfigRepo {
+	return b.guildConfigRepo
+}
+
+// GetIncidentsRepo returns the incidents repository.
+func (b *DiscordBot) GetIncidentsRepo() *store.IncidentsRepo {
+	return b.incidentsRepo
+}
+
 // GetGrafana returns the Grafana client.
 func (b *DiscordBot) GetGrafana() grafana.Client {
 	return b.grafana
@@ -221,6 +323,11 @@ func (b *DiscordBot) GetHive() hive.Hive {
 	return b.hive
 }
 
+// GetSlack returns the Slack client, or nil if Slack isn't configured.
+func (b *DiscordBot) GetSlack() slack.Slack {
+	return b.slack
+}
+
 // GetCartographoor returns the cartographoor service.
 func (b *DiscordBot) GetCartographoor() *cartographoor.Service {
 	return b.cartographoor
@@ -327,7 +434,7 @@ func (b *DiscordBot) handleInteraction(s *discordgo.Session, i *discordgo.Intera
 			}
 
 			// Check permissions before executing command.
-			if !common.HasPermission(i.Member, s, i.GuildID, b.config.AsRoleConfig(), &data) {
+			if !common.IsAllowed(i.Member, s, i.GuildID, b.config.AsRoleConfig(), cmd.Name(), &data) {
 				if err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 					Type: discordgo.InteractionResponseChannelMessageWithSource,
 					Data: &discordgo.InteractionResponseData{
@@ -358,9 +465,13 @@ func (b *DiscordBot) handleInteraction(s *discordgo.Session, i *discordgo.Intera
 	}
 }
 
-// scheduleExistingAlerts schedules all existing alerts.
+// scheduleExistingAlerts schedules all existing alerts, spreading their first runs over the startup
+// spread if one is configured.
 func (b *DiscordBot) scheduleExistingAlerts() error {
-	ctx := context.Background()
+	var (
+		ctx    = context.Background()
+		missed []scheduledJob
+	)
 
 	// Schedule monitor alerts.
 	alerts, err := b.monitorRepo.List(ctx)
@@ -387,7 +498,7 @@ func (b *DiscordBot) scheduleExistingAlerts() error {
 			schedule = alert.Schedule
 		}
 
-		if addErr := b.scheduler.AddJob(jobName, schedule, func(ctx context.Context) error {
+		run := func(ctx context.Context) error {
 			b.log.WithFields(logrus.Fields{
 				"network": alert.Network,
 				"client":  alert.Client,
@@ -403,9 +514,13 @@ func (b *DiscordBot) scheduleExistingAlerts() error {
 			}
 
 			return nil
-		}); addErr != nil {
+		}
+
+		if addErr := b.scheduler.AddSpreadJob(jobName, schedule, b.config.StartupSpread, run); addErr != nil {
 			return fmt.Errorf("failed to schedule alert: %w", addErr)
 		}
+
+		missed = b.appendMissedJob(ctx, missed, scheduledJob{name: jobName, schedule: schedule, run: run})
 	}
 
 	// Schedule Hive summary alerts.
@@ -419,7 +534,7 @@ func (b *DiscordBot) scheduleExistingAlerts() error {
 			continue
 		}
 
-		jobName := fmt.Sprintf("hive-summary-%s", alert.Network)
+		jobName := cmdhive.SummaryJobName(alert.Network, alert.Suite)
 
 		b.log.WithFields(logrus.Fields{
 			"network":  alert.Network,
@@ -427,24 +542,26 @@ func (b *DiscordBot) scheduleExistingAlerts() error {
 			"schedule": alert.Schedule,
 		}).Info("Scheduling hive summary")
 
-		if err := b.scheduler.AddJob(jobName, alert.Schedule, func(ctx context.Context) error {
+		run := func(ctx context.Context) error {
 			// Find the hive command.
 			for _, cmd := range b.commands {
 				if hiveCmd, ok := cmd.(*cmdhive.HiveCommand); ok {
-					if err := hiveCmd.RunHiveSummary(ctx, alert); err != nil {
-						b.log.WithError(err).Error("Failed to run Hive summary check")
-					}
-
-					break
+					return hiveCmd.RunScheduledSummary(ctx, alert)
 				}
 			}
 
 			return nil
-		}); err != nil {
+		}
+
+		if err := b.scheduler.AddSpreadJob(jobName, alert.Schedule, b.config.StartupSpread, run); err != nil {
 			return fmt.Errorf("failed to schedule Hive summary alert: %w", err)
 		}
+
+		missed = b.appendMissedJob(ctx, missed, scheduledJob{name: jobName, schedule: alert.Schedule, run: run})
 	}
 
+	b.catchUpMissedJobs(missed)
+
 	return nil
 }
 
@@ -464,6 +581,20 @@ func (b *DiscordBot) GetRoleConfig() *common.RoleConfig {
 	return b.config.AsRoleConfig()
 }
 
+// IsMaintenance returns true if the bot is in maintenance mode. While in maintenance mode checks
+// still run and persist their results, but no notifications are sent.
+func (b *DiscordBot) IsMaintenance() bool {
+	return b.maintenance.Load()
+}
+
+// SetMaintenance enables or disables maintenance mode.
+func (b *DiscordBot) SetMaintenance(enabled bool) {
+	b.maintenance.Store(enabled)
+	b.metrics.SetMaintenance(enabled)
+
+	b.log.WithField("enabled", enabled).Info("Maintenance mode updated")
+}
+
 // GetQueues returns all queues managed by the bot.
 func (b *DiscordBot) GetQueues() []queue.Queuer {
 	var queues []queue.Queuer
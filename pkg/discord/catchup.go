@@ -0,0 +1,72 @@
+package discord
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethpandaops/panda-pulse/pkg/scheduler"
+	"github.com/sirupsen/logrus"
+)
+
+// scheduledJob is a job scheduled on startup, kept so it can be caught up if it missed a run.
+type scheduledJob struct {
+	name     string
+	schedule string
+	run      func(context.Context) error
+}
+
+// appendMissedJob appends the job to missed if catching up is enabled and the job missed a run
+// since it last succeeded. Jobs without a recorded run are skipped, there's no telling whether
+// they missed anything and catching them all up at once after an upgrade would be noisy.
+func (b *DiscordBot) appendMissedJob(ctx context.Context, missed []scheduledJob, job scheduledJob) []scheduledJob {
+	if !b.config.CatchUpMissedRuns || b.jobRunsRepo == nil {
+		return missed
+	}
+
+	log := b.log.WithFields(logrus.Fields{
+		"job":      job.name,
+		"schedule": job.schedule,
+	})
+
+	last, err := b.jobRunsRepo.Get(ctx, job.name)
+	if err != nil {
+		log.WithError(err).Warn("Failed to get last job run, not catching up")
+
+		return missed
+	}
+
+	if last == nil {
+		log.Debug("No recorded job run, not catching up")
+
+		return missed
+	}
+
+	isMissed, err := scheduler.MissedRun(job.schedule, last.LastSuccess, time.Now())
+	if err != nil {
+		log.WithError(err).Warn("Failed to check for a missed job run")
+
+		return missed
+	}
+
+	if !isMissed {
+		return missed
+	}
+
+	log.WithField("lastSuccess", last.LastSuccess).Info("Job missed a run, catching up")
+
+	return append(missed, job)
+}
+
+// catchUpMissedJobs runs each missed job once in the background. Checks are only queued and Hive
+// summaries are limited by the Hive command, so they're safe to kick off together.
+func (b *DiscordBot) catchUpMissedJobs(missed []scheduledJob) {
+	b.metrics.SetCaughtUpJobs(len(missed))
+
+	for _, job := range missed {
+		go func() {
+			if err := job.run(context.Background()); err != nil {
+				b.log.WithError(err).WithField("job", job.name).Error("Failed to catch up missed job run")
+			}
+		}()
+	}
+}
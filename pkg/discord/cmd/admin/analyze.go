@@ -0,0 +1,220 @@
+package admin
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/ethpandaops/panda-pulse/pkg/analyzer"
+	"github.com/ethpandaops/panda-pulse/pkg/logger"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	optionNameClient = "client"
+	optionNameType   = "type"
+	optionNameNodes  = "nodes"
+	optionWeighted   = "weighted"
+
+	nodeHealthy   = "healthy"
+	nodeUnhealthy = "unhealthy"
+
+	maxAnalyzeReportSize = 2000 // Discord messages are capped at 2000 characters.
+
+	msgAnalyzeHeader       = "🔬 Analyzed %d nodes (%d unhealthy) for **%s** (%s)"
+	msgAnalyzeRootCauses   = "**Root causes**"
+	msgAnalyzeUnexplained  = "**Unexplained issues**"
+	msgAnalyzeNoRootCauses = "No root causes found"
+	msgAnalyzeNoIssues     = "No unexplained issues"
+	msgAnalyzeTruncated    = "… truncated"
+)
+
+// getAnalyzeCommandDefinition returns the '/admin analyze' subcommand definition.
+func (c *AdminCommand) getAnalyzeCommandDefinition() *discordgo.ApplicationCommandOption {
+	return &discordgo.ApplicationCommandOption{
+		Name:        "analyze",
+		Description: "Run the root cause analyzer against a list of nodes, without running any checks",
+		Type:        discordgo.ApplicationCommandOptionSubCommand,
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Name:        optionNameClient,
+				Description: "Client to analyze, as it would be targeted by a check",
+				Type:        discordgo.ApplicationCommandOptionString,
+				Required:    true,
+			},
+			{
+				Name:        optionNameNodes,
+				Description: "Comma-separated node:healthy|unhealthy pairs, eg lighthouse-geth-1:unhealthy,prysm-geth-1:healthy",
+				Type:        discordgo.ApplicationCommandOptionString,
+				Required:    true,
+			},
+			{
+				Name:        optionNameType,
+				Description: "Client type (optional, looked up in cartographoor if omitted)",
+				Type:        discordgo.ApplicationCommandOptionString,
+				Required:    false,
+				Choices: []*discordgo.ApplicationCommandOptionChoice{
+					{Name: "consensus", Value: string(analyzer.ClientTypeCL)},
+					{Name: "execution", Value: string(analyzer.ClientTypeEL)},
+				},
+			},
+			{
+				Name:        optionWeighted,
+				Description: "Weight failing pairs by how many of their listed nodes are failing (default false)",
+				Type:        discordgo.ApplicationCommandOptionBoolean,
+				Required:    false,
+			},
+		},
+	}
+}
+
+// handleAnalyze handles the '/admin analyze' subcommand.
+func (c *AdminCommand) handleAnalyze(
+	s *discordgo.Session,
+	i *discordgo.InteractionCreate,
+	data *discordgo.ApplicationCommandInteractionDataOption,
+) error {
+	var (
+		client, nodes, clientType string
+		weighted                  bool
+	)
+
+	for _, opt := range data.Options {
+		switch opt.Name {
+		case optionNameClient:
+			client = strings.ToLower(strings.TrimSpace(opt.StringValue()))
+		case optionNameNodes:
+			nodes = opt.StringValue()
+		case optionNameType:
+			clientType = opt.StringValue()
+		case optionWeighted:
+			weighted = opt.BoolValue()
+		}
+	}
+
+	if clientType == "" {
+		clientType = string(analyzer.ClientTypeCL)
+		if carto := c.bot.GetCartographoor(); carto != nil && carto.IsELClient(client) {
+			clientType = string(analyzer.ClientTypeEL)
+		}
+	}
+
+	statuses, err := parseNodeStatuses(nodes)
+	if err != nil {
+		return respondEphemeral(s, i, fmt.Sprintf("❌ %v", err))
+	}
+
+	// Run the same analyzer a check run uses, its log is thrown away as the result is reported instead.
+	a := analyzer.NewAnalyzer(
+		logger.NewCheckLogger("analyze"),
+		client,
+		analyzer.ClientType(clientType),
+		c.bot.GetCartographoor(),
+		analyzer.WithWeightedFailures(weighted),
+	)
+
+	var unhealthy int
+
+	for _, status := range statuses {
+		a.AddNodeStatus(status.Name, status.IsHealthy)
+
+		if !status.IsHealthy {
+			unhealthy++
+		}
+	}
+
+	result := a.Analyze()
+
+	c.log.WithFields(logrus.Fields{
+		"client":      client,
+		"type":        clientType,
+		"weighted":    weighted,
+		"nodes":       len(statuses),
+		"rootCauses":  result.RootCause,
+		"unexplained": len(result.UnexplainedIssues),
+	}).Info("Ran analyzer from command")
+
+	header := fmt.Sprintf(msgAnalyzeHeader, len(statuses), unhealthy, client, clientType)
+
+	return respondEphemeral(s, i, formatAnalysis(header, result))
+}
+
+// parseNodeStatuses parses a comma-separated list of node:healthy|unhealthy pairs.
+func parseNodeStatuses(value string) ([]analyzer.NodeStatus, error) {
+	var statuses []analyzer.NodeStatus
+
+	for entry := range strings.SplitSeq(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		node, health, ok := strings.Cut(entry, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid node %q, expected node:healthy or node:unhealthy", entry)
+		}
+
+		node = strings.TrimSpace(node)
+		if node == "" {
+			return nil, fmt.Errorf("invalid node %q, missing node name", entry)
+		}
+
+		switch strings.ToLower(strings.TrimSpace(health)) {
+		case nodeHealthy:
+			statuses = append(statuses, analyzer.NodeStatus{Name: node, IsHealthy: true})
+		case nodeUnhealthy:
+			statuses = append(statuses, analyzer.NodeStatus{Name: node, IsHealthy: false})
+		default:
+			return nil, fmt.Errorf("invalid health %q for %s, expected healthy or unhealthy", health, node)
+		}
+	}
+
+	if len(statuses) == 0 {
+		return nil, errors.New("no nodes given, expected node:healthy or node:unhealthy pairs")
+	}
+
+	return statuses, nil
+}
+
+// formatAnalysis formats the root causes with their evidence and the unexplained issues, keeping
+// the report within Discord's message length limit.
+func formatAnalysis(header string, result *analyzer.AnalysisResult) string {
+	lines := []string{header, "", msgAnalyzeRootCauses}
+
+	rootCauses := slices.Sorted(slices.Values(result.RootCause))
+	if len(rootCauses) == 0 {
+		lines = append(lines, msgAnalyzeNoRootCauses)
+	}
+
+	for _, rootCause := range rootCauses {
+		lines = append(lines, fmt.Sprintf("- **%s**: %s", rootCause, result.RootCauseEvidence[rootCause]))
+	}
+
+	lines = append(lines, "", msgAnalyzeUnexplained)
+
+	issues := slices.Sorted(slices.Values(result.UnexplainedIssues))
+	if len(issues) == 0 {
+		lines = append(lines, msgAnalyzeNoIssues)
+	}
+
+	for _, issue := range issues {
+		lines = append(lines, "- "+issue)
+	}
+
+	var msg strings.Builder
+
+	for _, line := range lines {
+		if msg.Len()+len(line)+len(msgAnalyzeTruncated)+2 > maxAnalyzeReportSize {
+			msg.WriteString(msgAnalyzeTruncated)
+
+			break
+		}
+
+		msg.WriteString(line)
+		msg.WriteString("\n")
+	}
+
+	return msg.String()
+}
@@ -0,0 +1,143 @@
+package admin
+
+import (
+	"fmt"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/ethpandaops/panda-pulse/pkg/discord/cmd/common"
+	"github.com/sirupsen/logrus"
+)
+
+// AdminCommand handles the /admin command.
+type AdminCommand struct {
+	log                *logrus.Logger
+	bot                common.BotContext
+	guildRegistrations map[string]string // Maps guild ID to registered command ID
+}
+
+// NewAdminCommand creates a new AdminCommand.
+func NewAdminCommand(log *logrus.Logger, bot common.BotContext) *AdminCommand {
+	return &AdminCommand{
+		log: log,
+		bot: bot,
+	}
+}
+
+// Name returns the name of the command.
+func (c *AdminCommand) Name() string {
+	return "admin"
+}
+
+// getCommandDefinition returns the application command definition.
+func (c *AdminCommand) getCommandDefinition() *discordgo.ApplicationCommand {
+	return &discordgo.ApplicationCommand{
+		Name:        c.Name(),
+		Description: "Bot administration",
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Name:        "maintenance",
+				Description: "Pause or resume all alert and Hive summary notifications",
+				Type:        discordgo.ApplicationCommandOptionSubCommand,
+				Options: []*discordgo.ApplicationCommandOption{
+					{
+						Name:        optionNameState,
+						Description: "Turn maintenance mode on or off (optional, shows the current state if omitted)",
+						Type:        discordgo.ApplicationCommandOptionString,
+						Required:    false,
+						Choices: []*discordgo.ApplicationCommandOptionChoice{
+							{Name: maintenanceOn, Value: maintenanceOn},
+							{Name: maintenanceOff, Value: maintenanceOff},
+						},
+					},
+				},
+			},
+			c.getGuildConfigCommandDefinition(),
+			c.getSelftestCommandDefinition(),
+			c.getLintAlertsCommandDefinition(),
+			c.getAnalyzeCommandDefinition(),
+		},
+	}
+}
+
+// Register registers the /admin command with the given discord session (globally).
+func (c *AdminCommand) Register(session *discordgo.Session) error {
+	cmd, err := session.ApplicationCommandCreate(session.State.User.ID, "", c.getCommandDefinition())
+	if err != nil {
+		return err
+	}
+
+	if c.guildRegistrations == nil {
+		c.guildRegistrations = make(map[string]string, 1)
+	}
+
+	c.guildRegistrations[""] = cmd.ID
+
+	return nil
+}
+
+// RegisterWithGuild registers the /admin command with a specific guild.
+func (c *AdminCommand) RegisterWithGuild(session *discordgo.Session, guildID string) error {
+	cmd, err := session.ApplicationCommandCreate(session.State.User.ID, guildID, c.getCommandDefinition())
+	if err != nil {
+		return fmt.Errorf("failed to register admin command to guild %s: %w", guildID, err)
+	}
+
+	if c.guildRegistrations == nil {
+		c.guildRegistrations = make(map[string]string, 2)
+	}
+
+	c.guildRegistrations[guildID] = cmd.ID
+
+	c.log.WithField("guild", guildID).Info("Registered admin command to guild")
+
+	return nil
+}
+
+// Handle handles the /admin command.
+func (c *AdminCommand) Handle(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	if i.Type != discordgo.InteractionApplicationCommand {
+		return
+	}
+
+	data := i.ApplicationCommandData()
+	if data.Name != c.Name() {
+		return
+	}
+
+	if err := common.ValidateOptions(c.getCommandDefinition(), &data); err != nil {
+		if respErr := respondEphemeral(s, i, err.Error()); respErr != nil {
+			c.log.Errorf("Failed to respond to interaction: %v", respErr)
+		}
+
+		return
+	}
+
+	var err error
+
+	switch data.Options[0].Name {
+	case "maintenance":
+		err = c.handleMaintenance(s, i, data.Options[0])
+	case "guild-config":
+		err = c.handleGuildConfig(s, i, data.Options[0])
+	case "selftest":
+		err = c.handleSelftest(s, i, data.Options[0])
+	case "lint-alerts":
+		err = c.handleLintAlerts(s, i, data.Options[0])
+	case "analyze":
+		err = c.handleAnalyze(s, i, data.Options[0])
+	}
+
+	if err != nil {
+		c.log.Errorf("Command failed: %v", err)
+
+		respErr := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseChannelMessageWithSource,
+			Data: &discordgo.InteractionResponseData{
+				Content: fmt.Sprintf("Command failed: %v", err),
+			},
+		})
+		if respErr != nil {
+			c.log.Errorf("Failed to respond to interaction: %v", respErr)
+		}
+	}
+}
@@ -0,0 +1,205 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/ethpandaops/panda-pulse/pkg/discord/cmd/common"
+	"github.com/ethpandaops/panda-pulse/pkg/discord/message"
+	"github.com/ethpandaops/panda-pulse/pkg/store"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	optionNameSetting = "setting"
+	optionNameValue   = "value"
+	optionNameClear   = "clear"
+
+	msgGuildConfigHeader   = "⚙️ Config for this server\n"
+	msgGuildConfigOverride = "- %s: `%s` (override)"
+	msgGuildConfigGlobal   = "- %s: `%s`"
+	msgGuildConfigSet      = "✅ Set **%s** for this server\n\n%s"
+	msgGuildConfigCleared  = "✅ Cleared the **%s** override for this server\n\n%s"
+	msgGuildConfigNotSet   = "ℹ️ This server has no **%s** override\n\n%s"
+	msgGuildConfigInvalid  = "🚫 %v"
+	msgGuildConfigNoValue  = "🚫 Provide a `value` to set **%s**, or `clear` to remove its override"
+	msgGuildConfigNoGuild  = "🚫 Guild config can only be managed from within a server"
+)
+
+// getGuildConfigCommandDefinition returns the '/admin guild-config' subcommand definition.
+func (c *AdminCommand) getGuildConfigCommandDefinition() *discordgo.ApplicationCommandOption {
+	keys := common.GuildConfigKeys()
+	choices := make([]*discordgo.ApplicationCommandOptionChoice, 0, len(keys))
+
+	for _, key := range keys {
+		choices = append(choices, &discordgo.ApplicationCommandOptionChoice{
+			Name:  key,
+			Value: key,
+		})
+	}
+
+	return &discordgo.ApplicationCommandOption{
+		Name:        "guild-config",
+		Description: "View or override global config for this server",
+		Type:        discordgo.ApplicationCommandOptionSubCommand,
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Name:        optionNameSetting,
+				Description: "Setting to change (optional, shows the current config if omitted)",
+				Type:        discordgo.ApplicationCommandOptionString,
+				Required:    false,
+				Choices:     choices,
+			},
+			{
+				Name:        optionNameValue,
+				Description: "Value to override the setting with",
+				Type:        discordgo.ApplicationCommandOptionString,
+				Required:    false,
+			},
+			{
+				Name:        optionNameClear,
+				Description: "Clear the override, falling back to the global config",
+				Type:        discordgo.ApplicationCommandOptionBoolean,
+				Required:    false,
+			},
+		},
+	}
+}
+
+// handleGuildConfig handles the '/admin guild-config' subcommand.
+func (c *AdminCommand) handleGuildConfig(
+	s *discordgo.Session,
+	i *discordgo.InteractionCreate,
+	data *discordgo.ApplicationCommandInteractionDataOption,
+) error {
+	var (
+		ctx            = context.Background()
+		guildID        = i.GuildID
+		repo           = c.bot.GetGuildConfigRepo()
+		setting, value string
+		clearOverride  bool
+	)
+
+	for _, opt := range data.Options {
+		switch opt.Name {
+		case optionNameSetting:
+			setting = opt.StringValue()
+		case optionNameValue:
+			value = strings.TrimSpace(opt.StringValue())
+		case optionNameClear:
+			clearOverride = opt.BoolValue()
+		}
+	}
+
+	if guildID == "" {
+		return respondEphemeral(s, i, msgGuildConfigNoGuild)
+	}
+
+	record, err := repo.Get(ctx, guildID)
+	if err != nil {
+		return fmt.Errorf("failed to get guild config: %w", err)
+	}
+
+	if record == nil {
+		record = &store.GuildConfig{GuildID: guildID}
+	}
+
+	if record.Overrides == nil {
+		record.Overrides = make(map[string]string)
+	}
+
+	// Without a setting to change, just show the current config.
+	if setting == "" {
+		return respondEphemeral(s, i, c.formatGuildConfig(record.Overrides))
+	}
+
+	switch {
+	case clearOverride:
+		if _, ok := record.Overrides[setting]; !ok {
+			return respondEphemeral(s, i, fmt.Sprintf(msgGuildConfigNotSet, setting, c.formatGuildConfig(record.Overrides)))
+		}
+
+		delete(record.Overrides, setting)
+	case value == "":
+		return respondEphemeral(s, i, fmt.Sprintf(msgGuildConfigNoValue, setting))
+	default:
+		if verr := common.ValidateGuildConfig(setting, value); verr != nil {
+			return respondEphemeral(s, i, fmt.Sprintf(msgGuildConfigInvalid, verr))
+		}
+
+		record.Overrides[setting] = value
+	}
+
+	record.UpdatedAt = time.Now()
+
+	if i.Member != nil && i.Member.User != nil {
+		record.UpdatedBy = i.Member.User.ID
+	}
+
+	// Drop the record entirely once the last override is cleared.
+	if len(record.Overrides) == 0 {
+		if perr := repo.Purge(ctx, guildID); perr != nil {
+			return fmt.Errorf("failed to purge guild config: %w", perr)
+		}
+	} else if perr := repo.Persist(ctx, record); perr != nil {
+		return fmt.Errorf("failed to persist guild config: %w", perr)
+	}
+
+	c.log.WithFields(logrus.Fields{
+		"guild":   guildID,
+		"setting": setting,
+		"value":   value,
+		"cleared": clearOverride,
+	}).Info("Updated guild config")
+
+	if clearOverride {
+		return respondEphemeral(s, i, fmt.Sprintf(msgGuildConfigCleared, setting, c.formatGuildConfig(record.Overrides)))
+	}
+
+	return respondEphemeral(s, i, fmt.Sprintf(msgGuildConfigSet, setting, c.formatGuildConfig(record.Overrides)))
+}
+
+// globalConfig returns the global value of each setting a guild can override.
+func (c *AdminCommand) globalConfig() map[string]string {
+	return map[string]string{
+		common.GuildConfigGrafanaURL:     c.bot.GetGrafana().GetBaseURL(),
+		common.GuildConfigSSHTemplate:    message.DefaultSSHCommandTemplate,
+		common.GuildConfigChecksSchedule: store.DefaultMonitorSchedule,
+		common.GuildConfigHiveSchedule:   store.DefaultHiveSummarySchedule,
+	}
+}
+
+// formatGuildConfig describes the effective config for a guild, marking which settings are overridden.
+func (c *AdminCommand) formatGuildConfig(overrides common.GuildOverrides) string {
+	var (
+		sb     strings.Builder
+		global = c.globalConfig()
+	)
+
+	sb.WriteString(msgGuildConfigHeader)
+
+	for _, key := range common.GuildConfigKeys() {
+		format := msgGuildConfigGlobal
+		if _, ok := overrides[key]; ok {
+			format = msgGuildConfigOverride
+		}
+
+		sb.WriteString(fmt.Sprintf(format, key, overrides.Get(key, global[key])) + "\n")
+	}
+
+	return sb.String()
+}
+
+// respondEphemeral responds to the interaction with a message only the invoking user can see.
+func respondEphemeral(s *discordgo.Session, i *discordgo.InteractionCreate, msg string) error {
+	return s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: msg,
+			Flags:   discordgo.MessageFlagsEphemeral,
+		},
+	})
+}
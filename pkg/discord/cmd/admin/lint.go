@@ -0,0 +1,347 @@
+package admin
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/ethpandaops/panda-pulse/pkg/discord/cmd/common"
+	cmdhive "github.com/ethpandaops/panda-pulse/pkg/discord/cmd/hive"
+	"github.com/ethpandaops/panda-pulse/pkg/hive"
+	"github.com/ethpandaops/panda-pulse/pkg/store"
+	"github.com/robfig/cron/v3"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	lintTimeout       = time.Minute
+	lintMinInterval   = 15 * time.Minute // Schedules firing more often than this are flagged.
+	lintScheduleRuns  = 10               // Upcoming runs compared when looking for over-frequent schedules.
+	maxLintReportSize = 2000             // Discord messages are capped at 2000 characters.
+
+	msgLintClean  = "✅ All %d enabled alerts look healthy, %d disabled alerts were skipped"
+	msgLintHeader = "🧹 Linted %d enabled alerts (%d disabled skipped), found **%d** problems:"
+	msgLintFooter = "\nNothing was changed, use `/checks` and `/hive` to fix or deregister these alerts."
+)
+
+// lintCategory groups lint problems in the report.
+type lintCategory string
+
+const (
+	lintCategorySchedule  lintCategory = "📅 Schedule"
+	lintCategoryScheduler lintCategory = "⏱️ Scheduler"
+	lintCategoryChannel   lintCategory = "📢 Channel"
+	lintCategoryNetwork   lintCategory = "🌐 Network"
+	lintCategoryClient    lintCategory = "👤 Client"
+)
+
+// lintCategories is the order categories are reported in.
+var lintCategories = []lintCategory{
+	lintCategorySchedule,
+	lintCategoryScheduler,
+	lintCategoryChannel,
+	lintCategoryNetwork,
+	lintCategoryClient,
+}
+
+// lintProblem is a single problem found with a registered alert.
+type lintProblem struct {
+	category lintCategory
+	alert    string // Describes the alert, eg "checks **devnet-0** lighthouse → <#123>".
+	detail   string
+}
+
+// linter checks registered alerts against cartographoor, the scheduler and Discord.
+type linter struct {
+	cmd      *AdminCommand
+	session  *discordgo.Session
+	problems []lintProblem
+	channels map[string]string // Channel ID to problem, so each channel is only looked up once.
+}
+
+// getLintAlertsCommandDefinition returns the '/admin lint-alerts' subcommand definition.
+func (c *AdminCommand) getLintAlertsCommandDefinition() *discordgo.ApplicationCommandOption {
+	return &discordgo.ApplicationCommandOption{
+		Name:        "lint-alerts",
+		Description: "Report registered alerts with bad schedules, channels, networks or clients, without changing them",
+		Type:        discordgo.ApplicationCommandOptionSubCommand,
+	}
+}
+
+// handleLintAlerts handles the '/admin lint-alerts' subcommand.
+func (c *AdminCommand) handleLintAlerts(
+	s *discordgo.Session,
+	i *discordgo.InteractionCreate,
+	_ *discordgo.ApplicationCommandInteractionDataOption,
+) error {
+	// Looking up every alert's channel can take a while, so acknowledge the interaction first.
+	if err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Flags: discordgo.MessageFlagsEphemeral,
+		},
+	}); err != nil {
+		return fmt.Errorf("failed to send deferred response: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), lintTimeout)
+	defer cancel()
+
+	report, err := c.lintAlerts(ctx, s)
+	if err != nil {
+		report = fmt.Sprintf("❌ Failed to lint alerts: %v", err)
+
+		c.log.WithError(err).Error("Failed to lint alerts")
+	}
+
+	if _, editErr := s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+		Content: new(report),
+	}); editErr != nil {
+		c.log.WithError(editErr).Error("Failed to edit deferred response")
+	}
+
+	return nil
+}
+
+// lintAlerts lints every enabled alert, returning the report.
+func (c *AdminCommand) lintAlerts(ctx context.Context, s *discordgo.Session) (string, error) {
+	monitorAlerts, err := c.bot.GetMonitorRepo().List(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to list alerts: %w", err)
+	}
+
+	hiveAlerts, err := c.bot.GetHiveSummaryRepo().List(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to list Hive summary alerts: %w", err)
+	}
+
+	l := &linter{
+		cmd:      c,
+		session:  s,
+		channels: make(map[string]string),
+	}
+
+	var enabled, disabled int
+
+	for _, alert := range monitorAlerts {
+		if !alert.Enabled {
+			disabled++
+
+			continue
+		}
+
+		enabled++
+
+		l.lintMonitorAlert(ctx, alert)
+	}
+
+	for _, alert := range hiveAlerts {
+		if !alert.Enabled {
+			disabled++
+
+			continue
+		}
+
+		enabled++
+
+		l.lintHiveAlert(ctx, alert)
+	}
+
+	c.log.WithFields(logrus.Fields{
+		"enabled":  enabled,
+		"disabled": disabled,
+		"problems": len(l.problems),
+	}).Info("Linted alerts")
+
+	if len(l.problems) == 0 {
+		return fmt.Sprintf(msgLintClean, enabled, disabled), nil
+	}
+
+	return formatLintReport(fmt.Sprintf(msgLintHeader, enabled, disabled, len(l.problems)), l.problems), nil
+}
+
+// lintMonitorAlert lints a health check alert.
+func (l *linter) lintMonitorAlert(ctx context.Context, alert *store.MonitorAlert) {
+	var (
+		desc     = fmt.Sprintf("checks **%s** %s → <#%s>", alert.Network, alert.Client, alert.DiscordChannel)
+		schedule = cmp.Or(alert.Schedule, store.DefaultMonitorSchedule)
+	)
+
+	l.lintSchedule(desc, schedule)
+	l.lintJob(desc, l.cmd.bot.GetMonitorRepo().Key(alert))
+	l.lintChannel(ctx, desc, alert.DiscordChannel)
+	l.lintNetwork(desc, alert.Network)
+	l.lintClients(desc, alert.Network, []string{alert.Client})
+}
+
+// lintHiveAlert lints a Hive summary alert.
+func (l *linter) lintHiveAlert(ctx context.Context, alert *hive.HiveSummaryAlert) {
+	target := alert.Network
+	if alert.Suite != "" {
+		target = fmt.Sprintf("%s (%s)", alert.Network, alert.Suite)
+	}
+
+	desc := fmt.Sprintf("hive **%s** → <#%s>", target, alert.DiscordChannel)
+
+	l.lintSchedule(desc, alert.Schedule)
+	l.lintJob(desc, cmdhive.SummaryJobName(alert.Network, alert.Suite))
+	l.lintChannel(ctx, desc, alert.DiscordChannel)
+	l.lintNetwork(desc, alert.Network)
+	l.lintClients(desc, alert.Network, alert.Clients)
+}
+
+// lintSchedule flags schedules that don't parse, or fire more often than lintMinInterval.
+func (l *linter) lintSchedule(desc, schedule string) {
+	sched, err := cron.ParseStandard(schedule)
+	if err != nil {
+		l.report(lintCategorySchedule, desc, fmt.Sprintf("invalid schedule `%s`: %v", schedule, err))
+
+		return
+	}
+
+	if gap := shortestGap(sched, time.Now(), lintScheduleRuns); gap > 0 && gap < lintMinInterval {
+		l.report(lintCategorySchedule, desc, fmt.Sprintf("schedule `%s` runs every %s, more often than every %s", schedule, gap, lintMinInterval))
+	}
+}
+
+// lintJob flags enabled alerts the scheduler has no job for, so never run.
+func (l *linter) lintJob(desc, jobName string) {
+	if !l.cmd.bot.GetScheduler().HasJob(jobName) {
+		l.report(lintCategoryScheduler, desc, "not scheduled, it won't run until the bot restarts")
+	}
+}
+
+// lintChannel flags alerts whose channel was deleted or can't be accessed.
+func (l *linter) lintChannel(ctx context.Context, desc, channelID string) {
+	problem, ok := l.channels[channelID]
+	if !ok {
+		_, err := l.session.Channel(channelID, discordgo.WithContext(ctx))
+		problem = common.ChannelProblem(err)
+		l.channels[channelID] = problem
+	}
+
+	if problem != "" {
+		l.report(lintCategoryChannel, desc, problem)
+	}
+}
+
+// lintNetwork flags devnets that are no longer active. Cartographoor only tracks devnets, so other
+// networks are skipped.
+func (l *linter) lintNetwork(desc, network string) {
+	carto := l.cmd.bot.GetCartographoor()
+	if carto == nil || !strings.Contains(network, "devnet") {
+		return
+	}
+
+	switch status := carto.GetNetworkStatus(network); status {
+	case "active":
+	case "":
+		l.report(lintCategoryNetwork, desc, "network isn't known to cartographoor")
+	default:
+		l.report(lintCategoryNetwork, desc, fmt.Sprintf("network is %s", status))
+	}
+}
+
+// lintClients flags clients that cartographoor doesn't know of, or that the network no longer runs.
+func (l *linter) lintClients(desc, network string, clients []string) {
+	carto := l.cmd.bot.GetCartographoor()
+	if carto == nil {
+		return
+	}
+
+	var (
+		known          = carto.GetAllClients()
+		networkClients = carto.GetNetworkClients(network)
+	)
+
+	for _, client := range clients {
+		switch {
+		case !slices.Contains(known, client):
+			l.report(lintCategoryClient, desc, fmt.Sprintf("%s isn't a known client", client))
+		case len(networkClients) > 0 && !slices.Contains(networkClients, client):
+			l.report(lintCategoryClient, desc, fmt.Sprintf("%s isn't running on %s", client, network))
+		}
+	}
+}
+
+// report records a problem with an alert.
+func (l *linter) report(category lintCategory, desc, detail string) {
+	l.problems = append(l.problems, lintProblem{category: category, alert: desc, detail: detail})
+}
+
+// shortestGap returns the shortest time between the next runs of a schedule.
+func shortestGap(sched cron.Schedule, from time.Time, runs int) time.Duration {
+	var (
+		shortest time.Duration
+		prev     = sched.Next(from)
+	)
+
+	for range runs - 1 {
+		next := sched.Next(prev)
+		if next.IsZero() {
+			break
+		}
+
+		if gap := next.Sub(prev); shortest == 0 || gap < shortest {
+			shortest = gap
+		}
+
+		prev = next
+	}
+
+	return shortest
+}
+
+// formatLintReport formats the problems grouped by category, keeping the report within Discord's
+// message length limit.
+func formatLintReport(header string, problems []lintProblem) string {
+	var msg strings.Builder
+
+	msg.WriteString(header)
+	msg.WriteString("\n")
+
+	written := 0
+
+	for _, category := range lintCategories {
+		var lines []string
+
+		for _, problem := range problems {
+			if problem.category == category {
+				lines = append(lines, fmt.Sprintf("- %s: %s", problem.alert, problem.detail))
+			}
+		}
+
+		if len(lines) == 0 {
+			continue
+		}
+
+		title := fmt.Sprintf("\n**%s** (%d)\n", category, len(lines))
+
+		for idx, line := range lines {
+			if idx == 0 {
+				line = title + line
+			}
+
+			// Leave room for the footer and a truncation note.
+			if msg.Len()+len(line)+len(msgLintFooter)+32 > maxLintReportSize {
+				fmt.Fprintf(&msg, "… and %d more\n", len(problems)-written)
+				msg.WriteString(msgLintFooter)
+
+				return msg.String()
+			}
+
+			msg.WriteString(line)
+			msg.WriteString("\n")
+
+			written++
+		}
+	}
+
+	msg.WriteString(msgLintFooter)
+
+	return msg.String()
+}
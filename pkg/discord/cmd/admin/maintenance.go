@@ -0,0 +1,64 @@
+package admin
+
+import (
+	"github.com/bwmarrin/discordgo"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	optionNameState = "state"
+	maintenanceOn   = "on"
+	maintenanceOff  = "off"
+
+	msgMaintenanceEnabled  = "🚧 Maintenance mode **enabled**, checks will keep running but no notifications will be sent"
+	msgMaintenanceDisabled = "✅ Maintenance mode **disabled**, notifications have resumed"
+	msgMaintenanceStatusOn = "🚧 Maintenance mode is currently **on**"
+	msgMaintenanceStatus   = "ℹ️ Maintenance mode is currently **off**"
+)
+
+// handleMaintenance handles the '/admin maintenance' subcommand.
+func (c *AdminCommand) handleMaintenance(
+	s *discordgo.Session,
+	i *discordgo.InteractionCreate,
+	data *discordgo.ApplicationCommandInteractionDataOption,
+) error {
+	var state string
+
+	for _, opt := range data.Options {
+		if opt.Name == optionNameState {
+			state = opt.StringValue()
+		}
+	}
+
+	var msg string
+
+	switch state {
+	case maintenanceOn:
+		c.bot.SetMaintenance(true)
+
+		msg = msgMaintenanceEnabled
+	case maintenanceOff:
+		c.bot.SetMaintenance(false)
+
+		msg = msgMaintenanceDisabled
+	default:
+		msg = msgMaintenanceStatus
+		if c.bot.IsMaintenance() {
+			msg = msgMaintenanceStatusOn
+		}
+	}
+
+	if state != "" {
+		c.log.WithFields(logrus.Fields{
+			"state": state,
+			"guild": i.GuildID,
+		}).Info("Maintenance mode changed via command")
+	}
+
+	return s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: msg,
+		},
+	})
+}
@@ -0,0 +1,272 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/ethpandaops/panda-pulse/pkg/discord/message"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	optionNameNetwork = "network"
+	optionNameChannel = "channel"
+
+	selftestTimeout      = 30 * time.Second
+	selftestGrafanaQuery = "vector(1)"
+	selftestPassColor    = 0x51CF66
+	selftestFailColor    = 0xFF6B6B
+	maxSelftestErrorLen  = 300 // Errors can carry whole response bodies, keep the embed readable.
+
+	msgSelftestProbe   = "🩺 Self-test message, this will be deleted shortly"
+	msgSelftestTitle   = "🩺 Self-test"
+	msgSelftestPassed  = "✅ All dependencies reachable"
+	msgSelftestFailed  = "❌ %d of %d dependencies failed"
+	msgSelftestPass    = "✅ %s"
+	msgSelftestFail    = "❌ %s\n%v"
+	msgSelftestSkipped = "⏭️ Skipped, %s"
+)
+
+// errSelftestSkipped marks a dependency that couldn't be tested, rather than one that failed.
+var errSelftestSkipped = errors.New("skipped")
+
+// selftestResult is the outcome of testing a single dependency.
+type selftestResult struct {
+	dependency string
+	latency    time.Duration
+	detail     string
+	err        error
+}
+
+// getSelftestCommandDefinition returns the '/admin selftest' subcommand definition.
+func (c *AdminCommand) getSelftestCommandDefinition() *discordgo.ApplicationCommandOption {
+	return &discordgo.ApplicationCommandOption{
+		Name:        "selftest",
+		Description: "Check the bot can reach Grafana, S3, Hive and Discord",
+		Type:        discordgo.ApplicationCommandOptionSubCommand,
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Name:        optionNameNetwork,
+				Description: "Network to check Hive availability for (optional, defaults to the first active network)",
+				Type:        discordgo.ApplicationCommandOptionString,
+				Required:    false,
+			},
+			{
+				Name:         optionNameChannel,
+				Description:  "Channel to post a test message to (optional, defaults to this channel)",
+				Type:         discordgo.ApplicationCommandOptionChannel,
+				Required:     false,
+				ChannelTypes: []discordgo.ChannelType{discordgo.ChannelTypeGuildText},
+			},
+		},
+	}
+}
+
+// handleSelftest handles the '/admin selftest' subcommand.
+func (c *AdminCommand) handleSelftest(
+	s *discordgo.Session,
+	i *discordgo.InteractionCreate,
+	data *discordgo.ApplicationCommandInteractionDataOption,
+) error {
+	var (
+		network   string
+		channelID = i.ChannelID
+	)
+
+	for _, opt := range data.Options {
+		switch opt.Name {
+		case optionNameNetwork:
+			network = opt.StringValue()
+		case optionNameChannel:
+			channelID = opt.ChannelValue(s).ID
+		}
+	}
+
+	if network == "" {
+		if carto := c.bot.GetCartographoor(); carto != nil {
+			if networks := carto.GetActiveNetworks(); len(networks) > 0 {
+				network = networks[0]
+			}
+		}
+	}
+
+	// Each dependency can take a while to time out, so acknowledge the interaction first.
+	if err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Flags: discordgo.MessageFlagsEphemeral,
+		},
+	}); err != nil {
+		return fmt.Errorf("failed to send deferred response: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), selftestTimeout)
+	defer cancel()
+
+	results := c.runSelftest(ctx, s, network, channelID)
+
+	for _, result := range results {
+		if result.err != nil && !errors.Is(result.err, errSelftestSkipped) {
+			c.log.WithError(result.err).WithFields(logrus.Fields{
+				"dependency": result.dependency,
+				"latency":    result.latency,
+			}).Warn("Self-test failed")
+		}
+	}
+
+	c.log.WithFields(logrus.Fields{
+		"guild":   i.GuildID,
+		"network": network,
+		"channel": channelID,
+	}).Info("Ran self-test")
+
+	if _, err := s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+		Embeds: &[]*discordgo.MessageEmbed{buildSelftestEmbed(results)},
+	}); err != nil {
+		c.log.WithError(err).Error("Failed to edit deferred response")
+	}
+
+	return nil
+}
+
+// runSelftest tests each dependency concurrently, returning the results in a fixed order.
+func (c *AdminCommand) runSelftest(ctx context.Context, s *discordgo.Session, network, channelID string) []selftestResult {
+	var (
+		wg     sync.WaitGroup
+		checks = []struct {
+			dependency string
+			run        func(context.Context) (string, error)
+		}{
+			{"Grafana", c.selftestGrafana},
+			{"S3", c.selftestS3},
+			{"Hive", func(ctx context.Context) (string, error) {
+				return c.selftestHive(ctx, network)
+			}},
+			{"Discord", func(context.Context) (string, error) {
+				return selftestDiscord(s, channelID)
+			}},
+		}
+		results = make([]selftestResult, len(checks))
+	)
+
+	for idx, check := range checks {
+		wg.Go(func() {
+			start := time.Now()
+			detail, err := check.run(ctx)
+
+			results[idx] = selftestResult{
+				dependency: check.dependency,
+				latency:    time.Since(start),
+				detail:     detail,
+				err:        err,
+			}
+		})
+	}
+
+	wg.Wait()
+
+	return results
+}
+
+// selftestGrafana runs a trivial query against the Prometheus datasource.
+func (c *AdminCommand) selftestGrafana(ctx context.Context) (string, error) {
+	if _, err := c.bot.GetGrafana().Query(ctx, selftestGrafanaQuery); err != nil {
+		return "", err
+	}
+
+	return c.bot.GetGrafana().GetBaseURL(), nil
+}
+
+// selftestS3 round-trips an object through the bucket.
+func (c *AdminCommand) selftestS3(ctx context.Context) (string, error) {
+	if err := c.bot.GetMonitorRepo().VerifyReadWrite(ctx); err != nil {
+		return "", err
+	}
+
+	return "list, put and delete", nil
+}
+
+// selftestHive checks Hive availability for the network.
+func (c *AdminCommand) selftestHive(ctx context.Context, network string) (string, error) {
+	if network == "" {
+		return "no network to check", errSelftestSkipped
+	}
+
+	available, err := c.bot.GetHive().IsAvailable(ctx, network)
+	if err != nil {
+		return "", err
+	}
+
+	if !available {
+		return fmt.Sprintf("reachable, not available for %s", network), nil
+	}
+
+	return fmt.Sprintf("available for %s", network), nil
+}
+
+// selftestDiscord posts a message to the channel and deletes it again.
+func selftestDiscord(s *discordgo.Session, channelID string) (string, error) {
+	msg, err := s.ChannelMessageSend(channelID, msgSelftestProbe)
+	if err != nil {
+		return "", fmt.Errorf("failed to post to <#%s>: %w", channelID, err)
+	}
+
+	if derr := s.ChannelMessageDelete(channelID, msg.ID); derr != nil {
+		return "", fmt.Errorf("posted to <#%s> but failed to delete the message: %w", channelID, derr)
+	}
+
+	return fmt.Sprintf("posted to <#%s>", channelID), nil
+}
+
+// buildSelftestEmbed reports pass/fail and latency for each dependency.
+func buildSelftestEmbed(results []selftestResult) *discordgo.MessageEmbed {
+	var (
+		failed int
+		fields = make([]*discordgo.MessageEmbedField, 0, len(results))
+	)
+
+	for _, result := range results {
+		var value string
+
+		switch {
+		case errors.Is(result.err, errSelftestSkipped):
+			value = fmt.Sprintf(msgSelftestSkipped, result.detail)
+		case result.err != nil:
+			failed++
+
+			value = fmt.Sprintf(msgSelftestFail, result.latency.Round(time.Millisecond), truncateError(result.err))
+		default:
+			value = fmt.Sprintf(msgSelftestPass, result.latency.Round(time.Millisecond)) + "\n" + result.detail
+		}
+
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:   result.dependency,
+			Value:  value,
+			Inline: false,
+		})
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       msgSelftestTitle,
+		Description: msgSelftestPassed,
+		Color:       selftestPassColor,
+		Fields:      fields,
+		Timestamp:   time.Now().Format(time.RFC3339),
+	}
+
+	if failed > 0 {
+		embed.Description = fmt.Sprintf(msgSelftestFailed, failed, len(results))
+		embed.Color = selftestFailColor
+	}
+
+	return embed
+}
+
+// truncateError shortens an error message to fit comfortably in an embed field.
+func truncateError(err error) string {
+	return message.Truncate(err.Error(), maxSelftestErrorLen)
+}
@@ -143,6 +143,7 @@ func (c *BuildCommand) getCommandDefinition() *discordgo.ApplicationCommand {
 					},
 				}, commonOptions...),
 			},
+			c.getInfoCommandDefinition(),
 		},
 	}
 }
@@ -236,8 +237,8 @@ func (c *BuildCommand) Handle(s *discordgo.Session, i *discordgo.InteractionCrea
 		"roles":      common.GetRoleNames(i.Member, s, i.GuildID),
 	}
 
-	// Check permissions before executing command.
-	if !c.hasPermission(i.Member, s, i.GuildID, c.bot.GetRoleConfig()) {
+	// Check permissions before executing command, anyone can look up build info.
+	if data.Options[0].Name != subcommandInfo && !c.hasPermission(i.Member, s, i.GuildID, c.bot.GetRoleConfig()) {
 		if err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 			Type: discordgo.InteractionResponseChannelMessageWithSource,
 			Data: &discordgo.InteractionResponseData{
@@ -258,6 +259,8 @@ func (c *BuildCommand) Handle(s *discordgo.Session, i *discordgo.InteractionCrea
 	switch data.Options[0].Name {
 	case subcommandClientCL, subcommandClientEL, subcommandTool:
 		err = c.handleBuild(s, i, data.Options[0])
+	case subcommandInfo:
+		err = c.handleInfo(s, i, data.Options[0])
 	}
 
 	if err != nil {
@@ -0,0 +1,106 @@
+package build
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/ethpandaops/panda-pulse/pkg/discord/cmd/common"
+)
+
+const (
+	subcommandInfo = "info"
+
+	msgNoBuildWorkflow = "❌ No build workflow found for **%s**"
+	maxChoices         = 25 // Discord caps the choices on an option.
+)
+
+// getInfoCommandDefinition returns the definition of the info subcommand.
+func (c *BuildCommand) getInfoCommandDefinition() *discordgo.ApplicationCommandOption {
+	choices := append(c.getCLClientChoices(), c.getELClientChoices()...)
+
+	slices.SortFunc(choices, func(a, b *discordgo.ApplicationCommandOptionChoice) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+
+	return &discordgo.ApplicationCommandOption{
+		Name:        subcommandInfo,
+		Description: "Show whether a client supports build args, and their defaults",
+		Type:        discordgo.ApplicationCommandOptionSubCommand,
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Name:        optionClient,
+				Description: "Client to show build info for",
+				Type:        discordgo.ApplicationCommandOptionString,
+				Required:    true,
+				Choices:     choices[:min(len(choices), maxChoices)],
+			},
+		},
+	}
+}
+
+// handleInfo shows what a build of the client would use by default: its repository and branch, and
+// whether it takes build args and which ones.
+func (c *BuildCommand) handleInfo(s *discordgo.Session, i *discordgo.InteractionCreate, option *discordgo.ApplicationCommandInteractionDataOption) error {
+	var (
+		clientOpt   = common.FindOption(option.Options, optionClient)
+		client      = clientOpt.StringValue()
+		displayName = c.bot.GetCartographoor().GetClientDisplayName(client)
+	)
+
+	allWorkflows, err := c.workflowFetcher.GetAllWorkflows()
+	if err != nil {
+		return fmt.Errorf("failed to fetch workflows: %w", err)
+	}
+
+	workflow, exists := allWorkflows[getClientToWorkflowName(client)]
+	if !exists {
+		return s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseChannelMessageWithSource,
+			Data: &discordgo.InteractionResponseData{
+				Content: fmt.Sprintf(msgNoBuildWorkflow, displayName),
+				Flags:   discordgo.MessageFlagsEphemeral,
+			},
+		})
+	}
+
+	return s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Embeds: []*discordgo.MessageEmbed{buildInfoEmbed(displayName, workflow, c.HasBuildArgs(client), c.GetDefaultBuildArgs(client))},
+			Flags:  discordgo.MessageFlagsEphemeral,
+		},
+	})
+}
+
+// buildInfoEmbed builds the embed describing a client's build defaults.
+func buildInfoEmbed(displayName string, workflow WorkflowInfo, hasBuildArgs bool, defaultBuildArgs string) *discordgo.MessageEmbed {
+	var (
+		buildArgs = "❌ Not supported, `build_args` is ignored"
+		defaults  = "-"
+	)
+
+	if hasBuildArgs {
+		buildArgs = "✅ Supported, pass them as `build_args` (key=value,...)"
+		defaults = "None"
+
+		if defaultBuildArgs != "" {
+			defaults = fmt.Sprintf("`%s`", defaultBuildArgs)
+		}
+	}
+
+	return &discordgo.MessageEmbed{
+		Title: fmt.Sprintf("🔧 %s build info", displayName),
+		Color: buildEmbedColor,
+		Fields: []*discordgo.MessageEmbedField{
+			{Name: "Repository", Value: cmp.Or(workflow.Repository, "-"), Inline: true},
+			{Name: "Branch", Value: cmp.Or(workflow.Branch, fallbackDefaultBranch), Inline: true},
+			{Name: "Build args", Value: buildArgs},
+			{Name: "Default build args", Value: defaults},
+		},
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: "Defaults are used when a build doesn't override them",
+		},
+	}
+}
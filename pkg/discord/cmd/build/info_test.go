@@ -0,0 +1,47 @@
+package build
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildInfoEmbed(t *testing.T) {
+	workflow := WorkflowInfo{Repository: "sigp/lighthouse", Branch: "unstable"}
+
+	fieldValues := func(hasBuildArgs bool, defaults string) map[string]string {
+		embed := buildInfoEmbed("Lighthouse", workflow, hasBuildArgs, defaults)
+		require.Equal(t, "🔧 Lighthouse build info", embed.Title)
+
+		values := make(map[string]string, len(embed.Fields))
+		for _, field := range embed.Fields {
+			values[field.Name] = field.Value
+		}
+
+		return values
+	}
+
+	t.Run("with default build args", func(t *testing.T) {
+		values := fieldValues(true, "FEATURES=portable")
+		assert.Equal(t, "sigp/lighthouse", values["Repository"])
+		assert.Equal(t, "unstable", values["Branch"])
+		assert.Contains(t, values["Build args"], "Supported")
+		assert.Equal(t, "`FEATURES=portable`", values["Default build args"])
+	})
+
+	t.Run("without default build args", func(t *testing.T) {
+		assert.Equal(t, "None", fieldValues(true, "")["Default build args"])
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		values := fieldValues(false, "")
+		assert.Contains(t, values["Build args"], "Not supported")
+		assert.Equal(t, "-", values["Default build args"])
+	})
+
+	t.Run("branch falls back", func(t *testing.T) {
+		workflow.Branch = ""
+		assert.Equal(t, fallbackDefaultBranch, fieldValues(false, "")["Branch"])
+	})
+}
@@ -2,9 +2,11 @@ package build
 
 import (
 	"strings"
+	"unicode/utf8"
 
 	"github.com/bwmarrin/discordgo"
 	"github.com/ethpandaops/panda-pulse/pkg/discord/cmd/common"
+	"github.com/ethpandaops/panda-pulse/pkg/discord/message"
 )
 
 // getAdditionalWorkflows returns workflow information, dynamically fetched from GitHub.
@@ -183,7 +185,7 @@ func (c *BuildCommand) handleToolAutocomplete(s *discordgo.Session, i *discordgo
 // It first strips common boilerplate prefixes/suffixes from GitHub workflow names
 // (e.g. "Build lighthouse docker image" → "lighthouse") before truncating.
 func truncateChoiceName(name string) string {
-	if len(name) <= maxChoiceNameLength {
+	if utf8.RuneCountInString(name) <= maxChoiceNameLength {
 		return name
 	}
 
@@ -191,11 +193,7 @@ func truncateChoiceName(name string) string {
 	cleaned = strings.TrimSuffix(cleaned, " docker image")
 	cleaned = strings.TrimSuffix(cleaned, " image")
 
-	if len(cleaned) <= maxChoiceNameLength {
-		return cleaned
-	}
-
-	return cleaned[:maxChoiceNameLength]
+	return message.Truncate(cleaned, maxChoiceNameLength)
 }
 
 // hasPermission checks if a member has permission to execute the build command.
@@ -0,0 +1,228 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/ethpandaops/panda-pulse/pkg/discord/cmd/common"
+	"github.com/ethpandaops/panda-pulse/pkg/discord/message"
+	"github.com/ethpandaops/panda-pulse/pkg/store"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	msgAlertActionUnknown  = "🚫 This button is no longer supported"
+	msgAlertActionNotFound = "🚫 **%s** on **%s** is no longer registered"
+	msgAlertActionFailed   = "🚫 Failed to %s the alert, please try again"
+)
+
+// alertActionPermissions maps each alert action to the subcommand its permissions are checked as, so
+// "checks mute=admin" also covers unmuting.
+var alertActionPermissions = map[string]string{
+	message.AlertActionAcknowledge: message.AlertActionAcknowledge,
+	message.AlertActionSnooze:      message.AlertActionSnooze,
+	message.AlertActionUnsnooze:    message.AlertActionSnooze,
+	message.AlertActionMute:        message.AlertActionMute,
+	message.AlertActionUnmute:      message.AlertActionMute,
+}
+
+// HandleComponent handles the buttons acknowledging, snoozing and muting an alert from its
+// notification. The action is recorded against the alert and the notification's buttons are updated
+// to reflect it.
+func (c *ChecksCommand) HandleComponent(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	action, network, client, ok := message.ParseAlertActionID(i.MessageComponentData().CustomID)
+
+	subcommand, known := alertActionPermissions[action]
+	if !ok || !known {
+		c.respondToComponent(s, i, msgAlertActionUnknown)
+
+		return
+	}
+
+	// Permissions are checked as if the action were a subcommand taking the alert's client, so client
+	// teams can act on their own alerts.
+	data := &discordgo.ApplicationCommandInteractionData{
+		Name: c.Name(),
+		Options: []*discordgo.ApplicationCommandInteractionDataOption{{
+			Name: subcommand,
+			Type: discordgo.ApplicationCommandOptionSubCommand,
+			Options: []*discordgo.ApplicationCommandInteractionDataOption{{
+				Name:  "client",
+				Type:  discordgo.ApplicationCommandOptionString,
+				Value: client,
+			}},
+		}},
+	}
+
+	if !common.IsAllowed(i.Member, s, i.GuildID, c.bot.GetRoleConfig(), c.Name(), data) {
+		c.respondToComponent(s, i, common.NoPermissionError(fmt.Sprintf("%s %s", c.Name(), subcommand)).Error())
+
+		return
+	}
+
+	log := c.log.WithFields(logrus.Fields{
+		"network": network,
+		"client":  client,
+		"action":  action,
+		"user":    interactionUser(i),
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), persistTimeout)
+	defer cancel()
+
+	alert, err := c.bot.GetMonitorRepo().Get(ctx, network, client)
+	if err != nil {
+		log.WithError(err).Error("Failed to get alert")
+		c.respondToComponent(s, i, fmt.Sprintf(msgAlertActionFailed, action))
+
+		return
+	}
+
+	if alert == nil {
+		c.respondToComponent(s, i, fmt.Sprintf(msgAlertActionNotFound, client, network))
+
+		return
+	}
+
+	now := time.Now()
+
+	applyAlertAction(alert, action, interactionUser(i), now)
+
+	if err := c.bot.GetMonitorRepo().Persist(ctx, alert); err != nil {
+		log.WithError(err).Error("Failed to persist alert action")
+		c.respondToComponent(s, i, fmt.Sprintf(msgAlertActionFailed, action))
+
+		return
+	}
+
+	log.Info("Recorded alert action")
+
+	var components []discordgo.MessageComponent
+	if i.Message != nil {
+		components = i.Message.Components
+	}
+
+	if err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{
+			Components: message.ReplaceAlertActionButtons(components, alert, now),
+		},
+	}); err != nil {
+		log.WithError(err).Error("Failed to update alert message")
+	}
+}
+
+// applyAlertAction records the action against the alert.
+func applyAlertAction(alert *store.MonitorAlert, action, user string, now time.Time) {
+	record := &store.AlertAction{User: user, At: now}
+
+	switch action {
+	case message.AlertActionAcknowledge:
+		alert.Acknowledged = record
+	case message.AlertActionSnooze:
+		record.Until = now.Add(message.AlertSnoozeDuration)
+		alert.Snoozed = record
+	case message.AlertActionUnsnooze:
+		alert.Snoozed = nil
+	case message.AlertActionMute:
+		alert.Muted = record
+	case message.AlertActionUnmute:
+		alert.Muted = nil
+	}
+}
+
+// heldOutcome returns the outcome of the alert's notification being held by an action taken on it,
+// or an empty outcome if nothing is holding it.
+func heldOutcome(alert *store.MonitorAlert, now time.Time) notifyOutcome {
+	switch {
+	case alert.Muted != nil:
+		return outcomeMuted
+	case alert.IsSnoozed(now):
+		return outcomeSnoozed
+	case alert.Acknowledged != nil:
+		return outcomeAcked
+	default:
+		return ""
+	}
+}
+
+// storedAlert returns the latest stored state of the alert, as scheduled runs hold on to the alert
+// as it was when scheduled and miss actions taken since. Lookup failures are logged and fall back to
+// the alert as given.
+func (c *ChecksCommand) storedAlert(ctx context.Context, alert *store.MonitorAlert) *store.MonitorAlert {
+	stored, err := c.bot.GetMonitorRepo().Get(ctx, alert.Network, alert.Client)
+	if err != nil {
+		c.log.WithError(err).WithFields(logrus.Fields{
+			"network": alert.Network,
+			"client":  alert.Client,
+		}).Warn("Failed to get stored alert, ignoring actions taken on it")
+
+		return alert
+	}
+
+	if stored == nil {
+		return alert
+	}
+
+	return stored
+}
+
+// clearAcknowledgement clears the alert's acknowledgement once its client has recovered, so the next
+// failure notifies again.
+func (c *ChecksCommand) clearAcknowledgement(ctx context.Context, alert *store.MonitorAlert) {
+	log := c.log.WithFields(logrus.Fields{
+		"network": alert.Network,
+		"client":  alert.Client,
+	})
+
+	stored, err := c.bot.GetMonitorRepo().Get(ctx, alert.Network, alert.Client)
+	if err != nil {
+		log.WithError(err).Warn("Failed to get stored alert, leaving its acknowledgement")
+
+		return
+	}
+
+	if stored == nil || stored.Acknowledged == nil {
+		return
+	}
+
+	stored.Acknowledged = nil
+
+	if err := c.bot.GetMonitorRepo().Persist(ctx, stored); err != nil {
+		log.WithError(err).Error("Failed to clear alert acknowledgement")
+	}
+}
+
+// respondToComponent responds to a button interaction with a message only the clicking user can see.
+func (c *ChecksCommand) respondToComponent(s *discordgo.Session, i *discordgo.InteractionCreate, msg string) {
+	if err := respondEphemeral(s, i, msg); err != nil {
+		c.log.WithError(err).Error("Failed to respond to component interaction")
+	}
+}
+
+// interactionUser returns the name of the user behind an interaction.
+func interactionUser(i *discordgo.InteractionCreate) string {
+	switch {
+	case i.Member != nil && i.Member.User != nil:
+		return i.Member.User.Username
+	case i.User != nil:
+		return i.User.Username
+	default:
+		return "unknown"
+	}
+}
+
+// interactionUserID returns the ID of the user behind an interaction, or an empty string if there's
+// no user.
+func interactionUserID(i *discordgo.InteractionCreate) string {
+	switch {
+	case i.Member != nil && i.Member.User != nil:
+		return i.Member.User.ID
+	case i.User != nil:
+		return i.User.ID
+	default:
+		return ""
+	}
+}
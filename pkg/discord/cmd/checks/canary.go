@@ -0,0 +1,88 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	msgCanaryStarted     = "\n🐤 Running the checks once now, results will follow"
+	msgCanaryHeader      = "🐤 **Canary run** for **%s**:"
+	msgCanaryPassed      = "- ✅ **%s**: all checks passed"
+	msgCanaryIssues      = "- ℹ️ **%s**: issues detected, alerted in <#%s>"
+	msgCanarySuppressed  = "- ℹ️ **%s**: issues detected, but not alerted (%s)"
+	msgCanaryNetworkDown = "- 🚨 **%s**: skipped, the network looks to be down as a whole"
+	msgCanaryFailed      = "- ⚠️ **%s**: failed to run, `%v`"
+	msgCanaryNoAlerts    = "⚠️ Canary run for **%s** found no registered alerts to run"
+)
+
+// canaryRun runs the checks for alerts just registered on the network in the channel, once, so the
+// operator finds out straight away whether monitoring works rather than at the next scheduled run.
+// Issues are alerted as a scheduled run would, and the outcome for each client is followed up on the
+// interaction. An empty clients runs every client registered in the channel.
+func (c *ChecksCommand) canaryRun(
+	s *discordgo.Session,
+	i *discordgo.InteractionCreate,
+	network, channelID string,
+	clients []string,
+) {
+	ctx := context.Background()
+
+	log := c.log.WithFields(logrus.Fields{
+		"network": network,
+		"channel": channelID,
+	})
+
+	alerts, err := c.bot.GetMonitorRepo().List(ctx)
+	if err != nil {
+		log.WithError(err).Error("Failed to list alerts for canary run")
+
+		return
+	}
+
+	lines := []string{fmt.Sprintf(msgCanaryHeader, network)}
+
+	for _, alert := range alerts {
+		if alert.Network != network || alert.DiscordChannel != channelID || alert.DiscordGuildID != i.GuildID {
+			continue
+		}
+
+		if len(clients) > 0 && !slices.Contains(clients, alert.Client) {
+			continue
+		}
+
+		outcome, _, rerr := c.runChecks(ctx, alert, false)
+
+		switch {
+		case rerr != nil:
+			log.WithError(rerr).WithField("client", alert.Client).Warn("Canary run failed")
+
+			lines = append(lines, fmt.Sprintf(msgCanaryFailed, alert.Client, rerr))
+		case outcome == outcomeSent:
+			lines = append(lines, fmt.Sprintf(msgCanaryIssues, alert.Client, channelID))
+		case outcome == outcomeNetworkDown:
+			lines = append(lines, fmt.Sprintf(msgCanaryNetworkDown, alert.Client))
+		case outcome == outcomeNoIssues || outcome == outcomeNoFailures:
+			lines = append(lines, fmt.Sprintf(msgCanaryPassed, alert.Client))
+		default:
+			lines = append(lines, fmt.Sprintf(msgCanarySuppressed, alert.Client, outcome))
+		}
+	}
+
+	msg := strings.Join(lines, "\n")
+	if len(lines) == 1 {
+		msg = fmt.Sprintf(msgCanaryNoAlerts, network)
+	}
+
+	if _, err := s.FollowupMessageCreate(i.Interaction, true, &discordgo.WebhookParams{
+		Content: msg,
+		Flags:   discordgo.MessageFlagsEphemeral,
+	}); err != nil {
+		log.WithError(err).Error("Failed to send canary run results")
+	}
+}
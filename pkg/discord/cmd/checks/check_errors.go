@@ -0,0 +1,122 @@
+package checks
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/ethpandaops/panda-pulse/pkg/checks"
+	"github.com/ethpandaops/panda-pulse/pkg/discord/message"
+	"github.com/ethpandaops/panda-pulse/pkg/store"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	msgChecksErroring = "⚠️ Unable to evaluate checks for **%s** on **%s**, %s errored for more than %d " +
+		"consecutive runs. This is a problem with our monitoring (likely Grafana), not the client\n%s"
+	msgChecksRecovered = "✅ Checks for **%s** on **%s** can be evaluated again: %s"
+
+	maxCheckErrorLen = 200 // Keeps a message listing every check's error within Discord's limit.
+)
+
+// trackCheckErrors counts the consecutive runs each of the alert's checks has errored, as opposed to
+// failed. Once a check has errored for longer than the grace count, a single notification is posted
+// that the client can't be evaluated, and another once the check runs again. A single errored run
+// is usually a transient Grafana hiccup and isn't worth anyone's attention.
+func (c *ChecksCommand) trackCheckErrors(alert *store.MonitorAlert, results []*checks.Result) {
+	if c.config.CheckErrorGrace < 0 {
+		return
+	}
+
+	var (
+		escalated = make([]*checks.Result, 0)
+		recovered = make([]string, 0)
+		prefix    = fmt.Sprintf("%s/%s/%s/", alert.Network, alert.Client, alert.DiscordChannel)
+	)
+
+	c.checkErrorsMu.Lock()
+
+	for _, result := range results {
+		key := prefix + result.Name
+
+		if result.Status != checks.StatusError {
+			if c.checkErrors[key] > c.config.CheckErrorGrace {
+				recovered = append(recovered, result.Name)
+			}
+
+			delete(c.checkErrors, key)
+
+			continue
+		}
+
+		c.checkErrors[key]++
+
+		if c.checkErrors[key] == c.config.CheckErrorGrace+1 {
+			escalated = append(escalated, result)
+		}
+	}
+
+	c.checkErrorsMu.Unlock()
+
+	log := c.log.WithFields(logrus.Fields{
+		"network": alert.Network,
+		"client":  alert.Client,
+	})
+
+	if len(escalated) > 0 {
+		names := make([]string, 0, len(escalated))
+		details := make([]string, 0, len(escalated))
+
+		for _, result := range escalated {
+			names = append(names, fmt.Sprintf("`%s`", result.Name))
+			details = append(details, fmt.Sprintf("- `%s`: %s", result.Name, truncateCheckError(result.Description)))
+		}
+
+		log.WithField("checks", names).Warn("Checks have errored past the grace count")
+
+		c.sendCheckErrorsMessage(alert, fmt.Sprintf(
+			msgChecksErroring,
+			alert.Client,
+			alert.Network,
+			strings.Join(names, ", "),
+			c.config.CheckErrorGrace,
+			strings.Join(details, "\n"),
+		))
+	}
+
+	if len(recovered) > 0 {
+		slices.Sort(recovered)
+
+		log.WithField("checks", recovered).Info("Errored checks have recovered")
+
+		c.sendCheckErrorsMessage(alert, fmt.Sprintf(
+			msgChecksRecovered, alert.Client, alert.Network, "`"+strings.Join(recovered, "`, `")+"`",
+		))
+	}
+}
+
+// sendCheckErrorsMessage posts a monitoring notification to the configured errors channel, or the
+// alert's channel if there isn't one. Nothing is posted while notifications are paused.
+func (c *ChecksCommand) sendCheckErrorsMessage(alert *store.MonitorAlert, msg string) {
+	if c.bot.IsMaintenance() || c.config.DiscordDisabled {
+		return
+	}
+
+	channelID := c.config.CheckErrorChannelID
+	if channelID == "" {
+		channelID = alert.DiscordChannel
+	}
+
+	if _, err := c.bot.GetSession().ChannelMessageSend(channelID, msg); err != nil {
+		c.log.WithError(err).WithFields(logrus.Fields{
+			"network": alert.Network,
+			"client":  alert.Client,
+			"channel": channelID,
+		}).Error("Failed to send check errors notification")
+	}
+}
+
+// truncateCheckError shortens a check's error description, which can embed a whole Grafana response.
+func truncateCheckError(msg string) string {
+	return message.Truncate(msg, maxCheckErrorLen)
+}
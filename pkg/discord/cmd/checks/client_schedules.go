@@ -0,0 +1,85 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+const (
+	// clientSchedulesSeparator separates client schedules, cron schedules already use commas.
+	clientSchedulesSeparator = ";"
+
+	msgInvalidClientSchedules = "🚫 Invalid client schedules: %v"
+	msgClientSchedulesUnused  = "🚫 Client schedules were given for clients that aren't being registered: %s"
+	msgRescheduledClient      = "🔁 **%s** was already registered for **%s** in <#%s>, so its schedule was updated to `%s`"
+	msgPresetRescheduled      = "\n🔁 Already registered, so only their schedules were updated: %s"
+)
+
+// cronParser parses the five field cron schedules checks run on.
+var cronParser = cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+
+// parseClientSchedules parses client schedules separated by semicolons, such as
+// "geth=*/5 * * * *; lodestar=0 * * * *", into a map of client to schedule.
+func parseClientSchedules(value string) (map[string]string, error) {
+	schedules := make(map[string]string)
+
+	for entry := range strings.SplitSeq(value, clientSchedulesSeparator) {
+		if strings.TrimSpace(entry) == "" {
+			continue
+		}
+
+		client, schedule, ok := strings.Cut(entry, "=")
+		client, schedule = strings.TrimSpace(client), strings.TrimSpace(schedule)
+
+		if !ok || client == "" || schedule == "" {
+			return nil, fmt.Errorf("%q isn't a client=schedule pair", strings.TrimSpace(entry))
+		}
+
+		if _, exists := schedules[client]; exists {
+			return nil, fmt.Errorf("%s is given more than one schedule", client)
+		}
+
+		if _, err := cronParser.Parse(schedule); err != nil {
+			return nil, fmt.Errorf("invalid schedule for %s: %w", client, err)
+		}
+
+		schedules[client] = schedule
+	}
+
+	return schedules, nil
+}
+
+// rescheduleAlert moves an already registered alert onto a new schedule, replacing its scheduled job
+// rather than adding another. Returns false, leaving the alert alone, if it's already on the schedule.
+func (c *ChecksCommand) rescheduleAlert(ctx context.Context, network, channelID, guildID, client, schedule string) (bool, error) {
+	alerts, err := c.bot.GetMonitorRepo().List(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to list alerts: %w", err)
+	}
+
+	for _, alert := range alerts {
+		if alert.Network != network || alert.Client != client || alert.DiscordChannel != channelID || alert.DiscordGuildID != guildID {
+			continue
+		}
+
+		if alert.Schedule == schedule {
+			return false, nil
+		}
+
+		alert.Schedule = schedule
+		alert.UpdatedAt = time.Now()
+
+		// Jobs are named after the alert, so scheduling it again replaces its job.
+		if err := c.scheduleAlert(ctx, alert); err != nil {
+			return false, fmt.Errorf("failed to reschedule alert: %w", err)
+		}
+
+		return true, nil
+	}
+
+	return false, nil
+}
@@ -2,16 +2,21 @@ package checks
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"slices"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/bwmarrin/discordgo"
+	"github.com/ethpandaops/panda-pulse/pkg/analyzer"
 	"github.com/ethpandaops/panda-pulse/pkg/checks"
 	"github.com/ethpandaops/panda-pulse/pkg/clients"
 	"github.com/ethpandaops/panda-pulse/pkg/discord/cmd/common"
 	"github.com/ethpandaops/panda-pulse/pkg/discord/message"
+	"github.com/ethpandaops/panda-pulse/pkg/grafana"
 	"github.com/ethpandaops/panda-pulse/pkg/hive"
 	"github.com/ethpandaops/panda-pulse/pkg/queue"
 	"github.com/ethpandaops/panda-pulse/pkg/store"
@@ -23,25 +28,48 @@ import (
 const (
 	threadAutoArchiveDuration = 60 // 1 hour.
 	threadDateFormat          = "2006-01-02"
+	persistTimeout            = 30 * time.Second
+	rateLimitWindow           = time.Minute // How long after the first suppressed alert of a burst it's summarised.
+	msgAlertsSuppressed       = "🔇 **%d** additional alerts suppressed, see `/checks list`"
+	queryArtifactType         = "query"   // The check artifact type raw query responses are persisted as.
+	resultsArtifactType       = "results" // The check artifact type structured results are persisted as.
 	// DefaultCheckSchedule defines when checks should run (daily at 7am UTC).
-	DefaultCheckSchedule = "0 7 * * *"
+	DefaultCheckSchedule = store.DefaultMonitorSchedule
 )
 
 // ChecksCommand handles the /checks command.
 type ChecksCommand struct {
 	log                 *logrus.Logger
 	bot                 common.BotContext
+	config              *Config
 	queue               *queue.AlertQueue
+	metrics             *Metrics
+	limiter             *common.ChannelRateLimiter // Nil when alerts aren't rate limited.
 	autocompleteHandler *common.AutocompleteHandler
 	guildRegistrations  map[string]string // Maps guild ID to registered command ID for updates
+	networksDownMu      sync.Mutex
+	networksDown        map[string]bool // Network/channel pairs already notified of the network being down.
+	checkErrorsMu       sync.Mutex
+	checkErrors         map[string]int // Consecutive errored runs, keyed by network/client/channel/check.
+	crossNetworkMu      sync.Mutex
+	crossNetwork        map[string]*crossNetworkIncident // Recent root cause alerts, keyed by client.
 }
 
 // NewChecksCommand creates a new checks command.
-func NewChecksCommand(log *logrus.Logger, bot common.BotContext) *ChecksCommand {
+func NewChecksCommand(log *logrus.Logger, bot common.BotContext, cfg *Config) *ChecksCommand {
 	cmd := &ChecksCommand{
 		log:                 log,
 		bot:                 bot,
+		config:              cfg.withDefaults(),
+		metrics:             NewMetrics("panda_pulse"),
 		autocompleteHandler: common.NewAutocompleteHandler(bot, log),
+		networksDown:        make(map[string]bool),
+		checkErrors:         make(map[string]int),
+		crossNetwork:        make(map[string]*crossNetworkIncident),
+	}
+
+	if cmd.config.AlertsPerMinute > 0 {
+		cmd.limiter = common.NewChannelRateLimiter(cmd.config.AlertsPerMinute)
 	}
 
 	cmd.queue = queue.NewAlertQueue(
@@ -90,6 +118,18 @@ func (c *ChecksCommand) getCommandDefinition() *discordgo.ApplicationCommand {
 						Required:    true,
 						Choices:     clientChoices,
 					},
+					{
+						Name:        "force",
+						Description: "Notify even if the client was notified within the cooldown",
+						Type:        discordgo.ApplicationCommandOptionBoolean,
+						Required:    false,
+					},
+					{
+						Name:        "details",
+						Description: "List the checks that ran when they all pass (defaults to CHECKS_MANUAL_RUN_DETAILS)",
+						Type:        discordgo.ApplicationCommandOptionBoolean,
+						Required:    false,
+					},
 				},
 			},
 			{
@@ -126,6 +166,65 @@ func (c *ChecksCommand) getCommandDefinition() *discordgo.ApplicationCommand {
 						Type:        discordgo.ApplicationCommandOptionString,
 						Required:    false,
 					},
+					{
+						Name:        "client-schedules",
+						Description: "Per-client schedules overriding schedule, eg geth=*/5 * * * *; lodestar=0 * * * * (optional)",
+						Type:        discordgo.ApplicationCommandOptionString,
+						Required:    false,
+					},
+					{
+						Name:        "min-instances",
+						Description: "Only notify once at least this many instances are failing (default 1)",
+						Type:        discordgo.ApplicationCommandOptionInteger,
+						Required:    false,
+						MinValue:    new(float64(1)),
+					},
+					{
+						Name:        "preset",
+						Description: "Group of clients to monitor, in place of a single client (optional)",
+						Type:        discordgo.ApplicationCommandOptionString,
+						Required:    false,
+						Choices:     c.getPresetChoices(),
+					},
+					{
+						Name:        "hive-screenshot",
+						Description: "Attach a screenshot of the client's Hive results to alerts (default true)",
+						Type:        discordgo.ApplicationCommandOptionBoolean,
+						Required:    false,
+					},
+					{
+						Name:        "mention-team",
+						Description: "Mention the client team's roles in alerts, alongside any explicit mentions (default false)",
+						Type:        discordgo.ApplicationCommandOptionBoolean,
+						Required:    false,
+					},
+					{
+						Name:        "remediation-script",
+						Description: "Attach a script running a command on every affected instance to alerts (default false)",
+						Type:        discordgo.ApplicationCommandOptionBoolean,
+						Required:    false,
+					},
+					{
+						Name:        "compact",
+						Description: "Post alerts as a single message summarising the affected instances, without a thread (default false)",
+						Type:        discordgo.ApplicationCommandOptionBoolean,
+						Required:    false,
+					},
+					{
+						Name:        "recovery-channel",
+						Description: "Channel to post all-clears to when a client recovers (defaults to the alerts channel)",
+						Type:        discordgo.ApplicationCommandOptionChannel,
+						Required:    false,
+						ChannelTypes: []discordgo.ChannelType{
+							discordgo.ChannelTypeGuildText,
+						},
+					},
+					{
+						Name:        "run-now",
+						Description: "Run the checks once straight away to confirm they work, alerting as usual (default false)",
+						Type:        discordgo.ApplicationCommandOptionBoolean,
+						Required:    false,
+					},
 				},
 			},
 			{
@@ -161,6 +260,12 @@ func (c *ChecksCommand) getCommandDefinition() *discordgo.ApplicationCommand {
 						Required:     false,
 						Autocomplete: true,
 					},
+					{
+						Name:        "registered-by",
+						Description: "Only list checks registered by this user (optional)",
+						Type:        discordgo.ApplicationCommandOptionUser,
+						Required:    false,
+					},
 				},
 			},
 			{
@@ -174,8 +279,26 @@ func (c *ChecksCommand) getCommandDefinition() *discordgo.ApplicationCommand {
 						Type:        discordgo.ApplicationCommandOptionString,
 						Required:    true,
 					},
+					{
+						Name:        "format",
+						Description: "Attach the freeform log, or the structured results and analysis as JSON (default log)",
+						Type:        discordgo.ApplicationCommandOptionString,
+						Required:    false,
+						Choices: []*discordgo.ApplicationCommandOptionChoice{
+							{Name: "log", Value: debugFormatLog},
+							{Name: "json", Value: debugFormatJSON},
+						},
+					},
 				},
 			},
+			c.getRouteCommandDefinition(clientChoices),
+			c.getRegisterAllCommandDefinition(),
+			c.getSetThresholdCommandDefinition(),
+			c.getIncidentsCommandDefinition(),
+			c.getRootCausesCommandDefinition(),
+			c.getReplayCommandDefinition(),
+			c.getStatusCommandDefinition(clientChoices),
+			c.getOverviewCommandDefinition(),
 		},
 	}
 }
@@ -258,6 +381,14 @@ func (c *ChecksCommand) Handle(s *discordgo.Session, i *discordgo.InteractionCre
 		return
 	}
 
+	if err := common.ValidateOptions(c.getCommandDefinition(), &data); err != nil {
+		if respErr := respondEphemeral(s, i, err.Error()); respErr != nil {
+			c.log.Errorf("Failed to respond to interaction: %v", respErr)
+		}
+
+		return
+	}
+
 	var err error
 
 	switch data.Options[0].Name {
@@ -271,6 +402,22 @@ func (c *ChecksCommand) Handle(s *discordgo.Session, i *discordgo.InteractionCre
 		err = c.handleList(s, i, data.Options[0])
 	case "debug":
 		err = c.handleDebug(s, i, data.Options[0])
+	case "route":
+		err = c.handleRoute(s, i, data.Options[0])
+	case "register-all-networks":
+		err = c.handleRegisterAllNetworks(s, i, data.Options[0])
+	case "set-threshold":
+		err = c.handleSetThreshold(s, i, data.Options[0])
+	case "incidents":
+		err = c.handleIncidents(s, i, data.Options[0])
+	case "root-causes":
+		err = c.handleRootCauses(s, i, data.Options[0])
+	case "replay":
+		err = c.handleReplay(s, i, data.Options[0])
+	case "status":
+		err = c.handleStatus(s, i, data.Options[0])
+	case "overview":
+		err = c.handleOverview(s, i, data.Options[0])
 	}
 
 	if err != nil {
@@ -288,30 +435,71 @@ func (c *ChecksCommand) Handle(s *discordgo.Session, i *discordgo.InteractionCre
 	}
 }
 
-// RunChecks runs the health checks for a given alert.
+// RunChecks runs the health checks for a scheduled alert, returning whether a notification was sent.
 func (c *ChecksCommand) RunChecks(ctx context.Context, alert *store.MonitorAlert) (bool, error) {
+	outcome, _, err := c.runChecks(ctx, alert, false)
+	if err == nil {
+		common.RecordJobRun(ctx, c.log, c.bot.GetJobRunsRepo(), c.bot.GetMonitorRepo().Key(alert))
+	}
+
+	return outcome == outcomeSent, err
+}
+
+// runChecks runs the health checks for a given alert, returning what happened to its notification
+// and the results of the checks that ran. Setting bypassCooldown notifies even if the client was
+// notified within the cooldown.
+func (c *ChecksCommand) runChecks(
+	ctx context.Context,
+	alert *store.MonitorAlert,
+	bypassCooldown bool,
+) (notifyOutcome, []*checks.Result, error) {
 	if alert.ClientType == clients.ClientTypeAll {
-		return false, fmt.Errorf("running checks for all clients is not supported")
+		return "", nil, fmt.Errorf("running checks for all clients is not supported")
 	}
 
-	runner, err := c.setupRunner(alert)
+	// Bound the whole run, a slow grafana/hive/discord combo shouldn't be able to back up the queue.
+	ctx, cancel := context.WithTimeout(ctx, c.config.RunTimeout)
+	defer cancel()
+
+	// A network that's down as a whole gets a single network-wide alert, rather than one per client.
+	if c.isNetworkDown(ctx, alert) {
+		c.metrics.RecordNotification(alert.Network, alert.Client, string(outcomeNetworkDown))
+
+		return outcomeNetworkDown, nil, nil
+	}
+
+	runner, err := c.setupRunner(ctx, alert)
 	if err != nil {
-		return false, err
+		return "", nil, err
 	}
 
 	if err := runner.RunChecks(ctx); err != nil {
-		return false, fmt.Errorf("failed to run checks: %w", err)
+		// Still persist the log, it's the only record of how far the run got before failing.
+		if perr := c.persistCheckResults(ctx, alert, runner); perr != nil {
+			c.log.WithError(perr).Error("Failed to persist partial check log")
+		}
+
+		return "", nil, fmt.Errorf("failed to run checks: %w", err)
 	}
 
+	c.recordHealth(alert, runner)
+	c.trackCheckErrors(alert, runner.GetResults())
+
 	if err := c.persistCheckResults(ctx, alert, runner); err != nil {
-		return false, err
+		return "", nil, err
 	}
 
-	return c.sendResults(ctx, alert, runner)
+	outcome, err := c.sendResults(ctx, alert, runner, bypassCooldown)
+
+	c.metrics.RecordNotification(alert.Network, alert.Client, string(outcome))
+	c.trackIncident(ctx, alert, runner, outcome)
+
+	return outcome, runner.GetResults(), err
 }
 
-// setupRunner creates and configures a new checks runner.
-func (c *ChecksCommand) setupRunner(alert *store.MonitorAlert) (checks.Runner, error) {
+// setupRunner creates and configures a new checks runner, applying any of the network's threshold
+// overrides.
+func (c *ChecksCommand) setupRunner(ctx context.Context, alert *store.MonitorAlert) (checks.Runner, error) {
 	var consensusNode, executionNode string
 
 	cartographoor := c.bot.GetCartographoor()
@@ -321,26 +509,70 @@ func (c *ChecksCommand) setupRunner(alert *store.MonitorAlert) (checks.Runner, e
 		consensusNode = alert.Client
 	}
 
+	var (
+		thresholds    = c.networkThresholds(ctx, alert.Network)
+		grafanaClient = c.bot.GetGrafana()
+		opts          []checks.RunnerOption
+	)
+
+	// Keep the raw responses the checks are based on, so disputed verdicts can be looked into later.
+	if c.config.PersistQueries {
+		recorder := grafana.NewRecorder(grafanaClient)
+		grafanaClient = recorder
+		opts = append(opts, checks.WithQueryRecorder(recorder))
+	}
+
+	if c.config.WeightedFailures {
+		opts = append(opts, checks.WithWeightedFailures(checks.NewGrafanaInstanceLister(grafanaClient)))
+	}
+
 	runner := checks.NewDefaultRunner(checks.Config{
 		Network:       alert.Network,
 		ConsensusNode: consensusNode,
 		ExecutionNode: executionNode,
-	}, cartographoor)
+		Thresholds:    thresholds,
+	}, cartographoor, opts...)
+
+	runner.RegisterCheck(checks.NewCLSyncCheck(grafanaClient))
+	runner.RegisterCheck(checks.NewHeadSlotCheck(grafanaClient))
+	runner.RegisterCheck(checks.NewCLFinalizedEpochCheck(grafanaClient))
+	runner.RegisterCheck(checks.NewAttestationParticipationCheck(grafanaClient))
+	runner.RegisterCheck(checks.NewELSyncCheck(grafanaClient))
+	runner.RegisterCheck(checks.NewELBlockHeightCheck(grafanaClient))
+	runner.RegisterCheck(checks.NewELFinalizedEpochCheck(grafanaClient))
 
-	runner.RegisterCheck(checks.NewCLSyncCheck(c.bot.GetGrafana()))
-	runner.RegisterCheck(checks.NewHeadSlotCheck(c.bot.GetGrafana()))
-	runner.RegisterCheck(checks.NewCLFinalizedEpochCheck(c.bot.GetGrafana()))
-	runner.RegisterCheck(checks.NewELSyncCheck(c.bot.GetGrafana()))
-	runner.RegisterCheck(checks.NewELBlockHeightCheck(c.bot.GetGrafana()))
+	runner.RegisterCheck(checks.NewCLSyncFlappingCheck(grafanaClient, thresholds.Flapping))
+	runner.RegisterCheck(checks.NewELSyncFlappingCheck(grafanaClient, thresholds.Flapping))
 
 	return runner, nil
 }
 
-// persistCheckResults persists the check results to storage.
+// recordHealth exports the client's health as evaluated by a completed check run, so dashboards and
+// alerts can be built on top of it.
+func (c *ChecksCommand) recordHealth(alert *store.MonitorAlert, runner checks.Runner) {
+	var (
+		affected  = failedNodes(runner.GetResults())
+		rootCause = slices.Contains(runner.GetAnalysis().RootCause, alert.Client)
+	)
+
+	c.metrics.RecordHealth(alert.Network, alert.Client, len(affected), minAffectedInstances(alert), rootCause)
+}
+
+// minAffectedInstances returns how many instances must be failing before the alert notifies.
+func minAffectedInstances(alert *store.MonitorAlert) int {
+	return max(alert.MinAffectedInstances, 1)
+}
+
+// persistCheckResults persists the check results to storage, along with the raw query responses
+// they were based on if they're recorded.
 func (c *ChecksCommand) persistCheckResults(ctx context.Context, alert *store.MonitorAlert, runner checks.Runner) error {
+	// Detach from the run timeout so a cancelled run doesn't leave a half-written log behind.
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), persistTimeout)
+	defer cancel()
+
 	now := time.Now()
 
-	return c.bot.GetChecksRepo().Persist(ctx, &store.CheckArtifact{
+	if err := c.bot.GetChecksRepo().Persist(ctx, &store.CheckArtifact{
 		Network:   alert.Network,
 		Client:    alert.Client,
 		CheckID:   runner.GetID(),
@@ -348,11 +580,108 @@ func (c *ChecksCommand) persistCheckResults(ctx context.Context, alert *store.Mo
 		CreatedAt: now,
 		UpdatedAt: now,
 		Content:   runner.GetLog().GetBuffer().Bytes(),
+	}); err != nil {
+		return err
+	}
+
+	c.persistStructuredResults(ctx, alert, runner, now)
+
+	if queries := runner.GetQueries(); len(queries) > 0 {
+		c.persistQueries(ctx, alert, runner.GetID(), queries, now)
+	}
+
+	return nil
+}
+
+// resultsPayload is the structured form of a check run's results, for tools to parse rather than
+// scraping the log.
+type resultsPayload struct {
+	Network   string                   `json:"network"`
+	Client    string                   `json:"client"`
+	CheckID   string                   `json:"checkId"`
+	Results   []*checks.Result         `json:"results"`
+	Analysis  *analyzer.AnalysisResult `json:"analysis"` // Nil if the run failed before analysis.
+	CreatedAt time.Time                `json:"createdAt"`
+}
+
+// persistStructuredResults persists the results and analysis of a check run as JSON. Failures are
+// logged, the log is the record that matters.
+func (c *ChecksCommand) persistStructuredResults(ctx context.Context, alert *store.MonitorAlert, runner checks.Runner, now time.Time) {
+	log := c.log.WithFields(logrus.Fields{
+		"network": alert.Network,
+		"client":  alert.Client,
+		"checkID": runner.GetID(),
+	})
+
+	content, err := json.MarshalIndent(&resultsPayload{
+		Network:   alert.Network,
+		Client:    alert.Client,
+		CheckID:   runner.GetID(),
+		Results:   runner.GetResults(),
+		Analysis:  runner.GetAnalysis(),
+		CreatedAt: now,
+	}, "", "  ")
+	if err != nil {
+		log.WithError(err).Error("Failed to marshal structured results")
+
+		return
+	}
+
+	if perr := c.bot.GetChecksRepo().Persist(ctx, &store.CheckArtifact{
+		Network:   alert.Network,
+		Client:    alert.Client,
+		CheckID:   runner.GetID(),
+		Type:      resultsArtifactType,
+		CreatedAt: now,
+		UpdatedAt: now,
+		Content:   content,
+	}); perr != nil {
+		log.WithError(perr).Error("Failed to persist structured results")
+	}
+}
+
+// persistQueries persists the raw query responses of a check run. Failures are logged, the log is
+// the record that matters.
+func (c *ChecksCommand) persistQueries(
+	ctx context.Context,
+	alert *store.MonitorAlert,
+	checkID string,
+	queries []grafana.RecordedQuery,
+	now time.Time,
+) {
+	log := c.log.WithFields(logrus.Fields{
+		"network": alert.Network,
+		"client":  alert.Client,
+		"checkID": checkID,
 	})
+
+	content, err := json.MarshalIndent(queries, "", "  ")
+	if err != nil {
+		log.WithError(err).Error("Failed to marshal query responses")
+
+		return
+	}
+
+	if perr := c.bot.GetChecksRepo().Persist(ctx, &store.CheckArtifact{
+		Network:   alert.Network,
+		Client:    alert.Client,
+		CheckID:   checkID,
+		Type:      queryArtifactType,
+		CreatedAt: now,
+		UpdatedAt: now,
+		Content:   content,
+	}); perr != nil {
+		log.WithError(perr).Error("Failed to persist query responses")
+	}
 }
 
-// sendResults sends the analysis results to Discord.
-func (c *ChecksCommand) sendResults(ctx context.Context, alert *store.MonitorAlert, runner checks.Runner) (bool, error) {
+// sendResults sends the analysis results to Discord, returning what happened to the notification.
+func (c *ChecksCommand) sendResults(
+	ctx context.Context,
+	alert *store.MonitorAlert,
+	runner checks.Runner,
+	bypassCooldown bool,
+) (notifyOutcome, error) {
 	var (
 		hasFailures          = false
 		isRootCause          = false
@@ -360,6 +689,7 @@ func (c *ChecksCommand) sendResults(ctx context.Context, alert *store.MonitorAle
 		checkID              = runner.GetID()
 		analysis             = runner.GetAnalysis()
 		results              = runner.GetResults()
+		critical             = c.criticalFailures(results)
 	)
 
 	// Check if Hive is available for this network
@@ -379,14 +709,23 @@ func (c *ChecksCommand) sendResults(ctx context.Context, alert *store.MonitorAle
 		}
 	}
 
+	// Critical checks always alert, whatever the suppression rules below make of them.
+	if len(critical) > 0 {
+		c.log.WithFields(logrus.Fields{
+			"network":  alert.Network,
+			"client":   alert.Client,
+			"critical": critical,
+		}).Info("Critical checks failing, bypassing suppression")
+	}
+
 	// If they are neither, we're done.
-	if !isRootCause && !hasUnexplainedIssues {
+	if !isRootCause && !hasUnexplainedIssues && len(critical) == 0 {
 		c.log.WithFields(logrus.Fields{
 			"network": alert.Network,
 			"client":  alert.Client,
 		}).Info("No issues detected, skipped notification")
 
-		return false, nil
+		return outcomeNoIssues, nil
 	}
 
 	for _, result := range results {
@@ -404,26 +743,17 @@ func (c *ChecksCommand) sendResults(ctx context.Context, alert *store.MonitorAle
 			"client":  alert.Client,
 		}).Info("No failures detected, skipped notification")
 
-		return false, nil
+		return outcomeNoFailures, nil
 	}
 
 	// Get mentions for this client/network.
-	mentions, err := c.bot.GetMentionsRepo().Get(context.Background(), alert.Network, alert.Client, alert.DiscordGuildID)
-	if err != nil {
-		c.log.WithError(err).Error("Failed to get mentions")
-	}
+	mentions := c.alertMentions(ctx, alert)
+
+	// The guild may point its alerts at its own Grafana and SSH hosts.
+	overrides := common.LoadGuildOverrides(ctx, c.log, c.bot.GetGuildConfigRepo(), alert.DiscordGuildID)
 
 	// Use the new builder.
-	builder := message.NewAlertMessageBuilder(&message.Config{
-		Alert:          alert,
-		CheckID:        checkID,
-		Results:        results,
-		HiveAvailable:  isHiveAvailable,
-		GrafanaBaseURL: c.bot.GetGrafana().GetBaseURL(),
-		HiveBaseURL:    c.bot.GetHive().GetBaseURL(),
-		RootCauses:     analysis.RootCause,
-		Cartographoor:  c.bot.GetCartographoor(),
-	})
+	builder := c.newAlertMessageBuilder(alert, checkID, results, isHiveAvailable, analysis, overrides)
 
 	// Process the data to detect infrastructure issues.
 	// We need to populate this field by calling the category-specific methods.
@@ -438,97 +768,588 @@ func (c *ChecksCommand) sendResults(ctx context.Context, alert *store.MonitorAle
 		builder.BuildThreadMessages(category, cat.failedChecks)
 	}
 
+	// Aliases collapse the failing checks' names in the alert, so keep the raw names to hand.
+	if len(c.config.CheckNameAliases) > 0 {
+		c.log.WithFields(logrus.Fields{
+			"network": alert.Network,
+			"client":  alert.Client,
+			"checks":  failedCheckNames(results),
+		}).Debug("Failing checks before collapsing their names")
+	}
+
 	// Check if all issues are infrastructure or unrelated only.
-	if builder.HasOnlyInfraOrUnrelatedIssues() {
+	if builder.HasOnlyInfraOrUnrelatedIssues() && len(critical) == 0 {
 		c.log.WithFields(logrus.Fields{
 			"network": alert.Network,
 			"client":  alert.Client,
 		}).Info("Only infrastructure or unrelated issues detected, skipped notification")
 
-		return false, nil
+		return outcomeInfraOnly, nil
+	}
+
+	// A handful of failing instances may just be a bad VM or two rather than a pattern. The check log
+	// has already been persisted and the incident is still tracked, only the notification is skipped.
+	if affected, minimum := len(failedNodes(results)), minAffectedInstances(alert); affected < minimum && len(critical) == 0 {
+		c.log.WithFields(logrus.Fields{
+			"network":  alert.Network,
+			"client":   alert.Client,
+			"affected": affected,
+			"minimum":  minimum,
+		}).Info("Fewer affected instances than the alert's minimum, skipped notification")
+
+		return outcomeBelowMin, nil
+	}
+
+	// The check log has already been persisted, so history is kept while notifications are paused.
+	if c.bot.IsMaintenance() {
+		c.log.WithFields(logrus.Fields{
+			"network": alert.Network,
+			"client":  alert.Client,
+		}).Info("Maintenance mode enabled, skipped notification")
+
+		return outcomeMaintenance, nil
+	}
+
+	// Likewise for an alert acknowledged, snoozed or muted from one of its notifications.
+	if held := heldOutcome(c.storedAlert(ctx, alert), time.Now()); held != "" {
+		c.log.WithFields(logrus.Fields{
+			"network": alert.Network,
+			"client":  alert.Client,
+			"outcome": held,
+		}).Info("Alert held by an action taken on it, skipped notification")
+
+		return held, nil
+	}
+
+	// Likewise for a client notified moments ago, eg a manual run straight after the scheduled one.
+	if !bypassCooldown {
+		if last := c.lastNotification(ctx, alert); last != nil && time.Since(last.NotifiedAt) < c.config.NotificationCooldown {
+			c.log.WithFields(logrus.Fields{
+				"network":    alert.Network,
+				"client":     alert.Client,
+				"notifiedAt": last.NotifiedAt,
+				"lastCheck":  last.CheckID,
+			}).Info("Notified within cooldown, skipped notification")
+
+			return outcomeCooldown, nil
+		}
+	}
+
+	// If hive is available, grab a screenshot of the test coverage to pop into the thread(s), one per
+	// suite registered for the network.
+	var (
+		hiveSuites  []string
+		screenshots []message.HiveScreenshot
+	)
+
+	if isHiveAvailable && c.hiveScreenshotsEnabled(alert) {
+		hiveSuites = c.hiveSuites(ctx, alert.Network)
+
+		// Screenshots are the heaviest part of an alert, so outside screenshot hours they're left to
+		// the Hive button.
+		if c.config.HiveScreenshotHours.Contains(time.Now()) {
+			screenshots = c.captureHiveSnapshots(ctx, alert, checkID, hiveSuites)
+		} else {
+			c.log.WithFields(logrus.Fields{
+				"network": alert.Network,
+				"client":  alert.Client,
+			}).Debug("Outside Hive screenshot hours, skipped screenshot")
+		}
+	}
+
+	// Keep what the alert was rendered from, so it can be replayed after the underlying state changes.
+	c.persistAlertPayload(ctx, &alertPayload{
+		Alert:         alert,
+		CheckID:       checkID,
+		Results:       results,
+		Analysis:      analysis,
+		HiveAvailable: isHiveAvailable,
+		HiveSuites:    hiveSuites,
+		CreatedAt:     time.Now(),
+	})
+
+	// Work out where each failing result should be delivered, routing rules can fan a single
+	// registration out to different channels depending on what's failing.
+	severity := store.RouteSeverityWarning
+	if isRootCause || len(critical) > 0 {
+		severity = store.RouteSeverityCritical
+	}
+
+	var (
+		deliveries = c.routeResults(ctx, alert, results, severity)
+		sent       int
+		delivered  *discordgo.Message // The first alert posted to Discord, linked from cross-network incidents.
+	)
+
+	// Slack goes first, a failed Discord delivery below returns early.
+	if c.deliverSlack(ctx, alert, checkID, results, builder) {
+		sent++
+	}
+
+	// Deployments alerting only through Slack turn Discord delivery off.
+	if c.config.DiscordDisabled {
+		deliveries = nil
+	}
+
+	for _, delivery := range deliveries {
+		if !c.allowAlert(delivery.channelID) {
+			c.log.WithFields(logrus.Fields{
+				"network": alert.Network,
+				"client":  alert.Client,
+				"channel": delivery.channelID,
+			}).Warn("Channel alert rate limit exceeded, suppressed delivery")
+
+			continue
+		}
+
+		routed := *alert
+		routed.DiscordChannel = delivery.channelID
+
+		deliveryBuilder := builder
+		if len(deliveries) > 1 {
+			deliveryBuilder = c.newAlertMessageBuilder(&routed, checkID, delivery.results, isHiveAvailable, analysis, overrides)
+		}
+
+		var (
+			msg *discordgo.Message
+			err error
+		)
+
+		// Compact alerts skip the thread, and everything posted to it, for a single message.
+		if alert.Compact {
+			msg, err = c.deliverCompactAlert(&routed, deliveryBuilder, mentions)
+		} else {
+			msg, err = c.deliverAlert(&routed, checkID, delivery.results, deliveryBuilder, screenshots, mentions)
+		}
+
+		if err != nil {
+			// Earlier deliveries went out, so they still count towards the cooldown.
+			if sent > 0 {
+				c.recordNotification(ctx, alert, checkID)
+			}
+
+			return outcomeSent, err
+		}
+
+		if delivered == nil {
+			delivered = msg
+		}
+
+		sent++
+	}
+
+	// Nothing went out, so leave the cooldown alone and let the next run try again.
+	if sent == 0 {
+		return outcomeRateLimited, nil
+	}
+
+	c.recordNotification(ctx, alert, checkID)
+
+	// Checks without timestamped data can't say when the problem showed up in it.
+	if dataTimestamp := builder.DataTimestamp(); !dataTimestamp.IsZero() {
+		c.metrics.RecordDetectionLatency(alert.Network, alert.Client, time.Since(dataTimestamp))
+	}
+
+	// The same client failing on several networks at once is rolled up into a single incident.
+	if isRootCause {
+		c.recordCrossNetworkAlert(alert, delivered)
+	}
+
+	c.log.WithFields(logrus.Fields{
+		"network":    alert.Network,
+		"client":     alert.Client,
+		"deliveries": sent,
+	}).Info("Issues detected, sent notification")
+
+	return outcomeSent, nil
+}
+
+// lastNotification returns when the alert's client was last notified, or nil if it never has been
+// or the cooldown is disabled. Lookup failures are logged and treated as never notified, a missed
+// cooldown is better than a missed alert.
+func (c *ChecksCommand) lastNotification(ctx context.Context, alert *store.MonitorAlert) *store.LastNotification {
+	repo := c.bot.GetNotificationsRepo()
+	if repo == nil || c.config.NotificationCooldown < 0 {
+		return nil
+	}
+
+	last, err := repo.Get(ctx, alert.Network, alert.Client)
+	if err != nil {
+		c.log.WithError(err).WithFields(logrus.Fields{
+			"network": alert.Network,
+			"client":  alert.Client,
+		}).Warn("Failed to get last notification, ignoring cooldown")
+
+		return nil
+	}
+
+	return last
+}
+
+// recordNotification records that the alert's client was just notified, starting its cooldown.
+func (c *ChecksCommand) recordNotification(ctx context.Context, alert *store.MonitorAlert, checkID string) {
+	repo := c.bot.GetNotificationsRepo()
+	if repo == nil {
+		return
+	}
+
+	// Detach from the run timeout, the notification has already gone out.
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), persistTimeout)
+	defer cancel()
+
+	if err := repo.Persist(ctx, &store.LastNotification{
+		Network:    alert.Network,
+		Client:     alert.Client,
+		CheckID:    checkID,
+		NotifiedAt: time.Now(),
+	}); err != nil {
+		c.log.WithError(err).WithFields(logrus.Fields{
+			"network": alert.Network,
+			"client":  alert.Client,
+		}).Error("Failed to record notification")
+	}
+}
+
+// allowAlert reports whether an alert can be posted to the channel without exceeding its rate
+// limit. The first alert suppressed in a burst schedules a summary of the whole burst.
+func (c *ChecksCommand) allowAlert(channelID string) bool {
+	if c.limiter == nil {
+		return true
+	}
+
+	allowed, suppressed := c.limiter.Allow(channelID)
+	if allowed {
+		return true
+	}
+
+	c.metrics.RecordSuppressed(channelID)
+
+	if suppressed == 1 {
+		time.AfterFunc(rateLimitWindow, func() {
+			c.sendSuppressedSummary(channelID)
+		})
+	}
+
+	return false
+}
+
+// sendSuppressedSummary posts a single message in place of the alerts suppressed for a channel.
+func (c *ChecksCommand) sendSuppressedSummary(channelID string) {
+	suppressed := c.limiter.TakeSuppressed(channelID)
+	if suppressed == 0 {
+		return
 	}
 
+	if _, err := c.bot.GetSession().ChannelMessageSend(channelID, fmt.Sprintf(msgAlertsSuppressed, suppressed)); err != nil {
+		c.log.WithError(err).WithField("channel", channelID).Error("Failed to send suppressed alerts summary")
+	}
+}
+
+// newAlertMessageBuilder creates an alert message builder for the given results, applying the
+// guild's config overrides.
+func (c *ChecksCommand) newAlertMessageBuilder(
+	alert *store.MonitorAlert,
+	checkID string,
+	results []*checks.Result,
+	hiveAvailable bool,
+	analysis *analyzer.AnalysisResult,
+	overrides common.GuildOverrides,
+) *message.AlertMessageBuilder {
+	return message.NewAlertMessageBuilder(&message.Config{
+		Alert:              alert,
+		CheckID:            checkID,
+		Results:            results,
+		HiveAvailable:      hiveAvailable,
+		GrafanaBaseURL:     overrides.Get(common.GuildConfigGrafanaURL, c.bot.GetGrafana().GetBaseURL()),
+		DashboardUID:       c.config.DashboardUID,
+		LogsDashboardUID:   c.config.LogsDashboardUID,
+		NetworkDashboards:  c.config.NetworkDashboards,
+		HiveBaseURL:        c.bot.GetHive().GetBaseURL(),
+		SSHCommandTemplate: overrides.Get(common.GuildConfigSSHTemplate, message.DefaultSSHCommandTemplate),
+		RemediationCommand: c.config.RemediationCommand,
+		StaleDataThreshold: max(c.config.StaleDataThreshold, 0),
+		FlatInstanceList:   c.config.FlatInstanceList,
+		InstancePattern:    c.config.InstancePatterns[alert.Network],
+		RegionPattern:      c.config.RegionPattern,
+		Templates:          c.config.Templates,
+		Team:               clientTeam(c.bot.GetCartographoor().GetTeamRoles(alert.Client)),
+		CriticalChecks:     c.criticalFailures(results),
+		CheckNameAliases:   c.config.CheckNameAliases,
+		EmbedFields:        c.config.EmbedFields,
+		RootCauses:         analysis.RootCause,
+		PeerHealth:         analysis.PeerHealth,
+		Cartographoor:      c.bot.GetCartographoor(),
+	})
+}
+
+// criticalFailures returns the names of the failing checks configured as critical.
+func (c *ChecksCommand) criticalFailures(results []*checks.Result) []string {
+	critical := make([]string, 0)
+
+	for _, result := range results {
+		if result.Status != checks.StatusFail || slices.Contains(critical, result.Name) {
+			continue
+		}
+
+		if slices.ContainsFunc(c.config.CriticalChecks, func(name string) bool { return strings.EqualFold(name, result.Name) }) {
+			critical = append(critical, result.Name)
+		}
+	}
+
+	return critical
+}
+
+// failedCheckNames returns the raw names of the failed checks, sorted and deduplicated.
+func failedCheckNames(results []*checks.Result) []string {
+	names := make([]string, 0)
+
+	for _, result := range results {
+		if result.Status == checks.StatusFail {
+			names = append(names, result.Name)
+		}
+	}
+
+	slices.Sort(names)
+
+	return slices.Compact(names)
+}
+
+// clientTeam returns the name of the team owning a client, the first of its team's roles.
+func clientTeam(teamRoles []string) string {
+	if len(teamRoles) == 0 {
+		return ""
+	}
+
+	return teamRoles[0]
+}
+
+// deliverAlert sends the main message, thread breakdown, hive screenshots and mentions to the alert's
+// channel, returning the main message.
+func (c *ChecksCommand) deliverAlert(
+	alert *store.MonitorAlert,
+	checkID string,
+	results []*checks.Result,
+	builder *message.AlertMessageBuilder,
+	screenshots []message.HiveScreenshot,
+	mentions []string,
+) (*discordgo.Message, error) {
 	// Create the main message.
 	msg, err := c.createMainMessage(alert, builder)
 	if err != nil {
-		return false, fmt.Errorf("failed to create main message: %w", err)
+		return nil, fmt.Errorf("failed to create main message: %w", err)
 	}
 
 	// Create a thread off our main message.
-	thread, err := c.createThread(msg.ID, alert)
+	thread, err := c.createThread(msg.ID, checkID, alert)
 	if err != nil {
-		return true, err
+		return nil, err
 	}
 
 	// Populate the thread.
 	if err := c.sendThreadMessages(thread.ID, alert, results, builder); err != nil {
-		return true, err
+		return nil, err
 	}
 
-	// If hive is available, pop a screenshot of the test coverage into the thread.
-	if isHiveAvailable {
-		// Get a screenshot of the test coverage.
-		var consensusNode, executionNode string
-
-		cartographoor := c.bot.GetCartographoor()
-		if cartographoor.IsELClient(alert.Client) {
-			executionNode = alert.Client
-		} else {
-			consensusNode = alert.Client
+	// Send the hive screenshots to the thread.
+	for _, hiveMsg := range builder.BuildHiveMessages(screenshots) {
+		if _, err := c.bot.GetSession().ChannelMessageSendComplex(thread.ID, hiveMsg); err != nil {
+			c.log.WithError(err).Error("Failed to send Hive screenshots")
 		}
+	}
 
-		content, err := c.bot.GetHive().Snapshot(ctx, hive.SnapshotConfig{
-			Network:       alert.Network,
-			ConsensusNode: consensusNode,
-			ExecutionNode: executionNode,
-		})
-		if err != nil {
-			if strings.Contains(err.Error(), "context deadline exceeded") {
-				c.log.WithFields(logrus.Fields{
-					"network":       alert.Network,
-					"consensusNode": consensusNode,
-					"executionNode": executionNode,
-				}).WithError(err).Error("hive screenshot timed out")
-			} else {
-				c.log.WithError(err).Error("Failed to get Hive screenshot")
-			}
-		} else if len(content) > 0 {
-			// Store the screenshot.
-			now := time.Now()
-
-			err = c.bot.GetChecksRepo().Persist(ctx, &store.CheckArtifact{
-				Network:   alert.Network,
-				Client:    alert.Client,
-				CheckID:   checkID,
-				Type:      "png",
-				CreatedAt: now,
-				UpdatedAt: now,
-				Content:   content,
-			})
-			if err != nil {
-				c.log.WithError(err).Error("Failed to persist Hive screenshot")
-			} else {
-				// Send the screenshot to the thread.
-				if _, err := c.bot.GetSession().ChannelMessageSendComplex(thread.ID, builder.BuildHiveMessage(content)); err != nil {
-					c.log.WithError(err).Error("Failed to send Hive screenshot")
-				}
+	// Attach the remediation script if the alert opted into it.
+	if alert.RemediationScript {
+		if script := builder.BuildRemediationScript(); script != nil {
+			if _, err := c.bot.GetSession().ChannelMessageSendComplex(thread.ID, script); err != nil {
+				c.log.WithError(err).Error("Failed to send remediation script")
 			}
 		}
 	}
 
-	// Add mentions at the bottom of the thread if they're enabled.
-	if mentions != nil && mentions.Enabled && len(mentions.Mentions) > 0 {
-		if _, err := c.bot.GetSession().ChannelMessageSendComplex(thread.ID, builder.BuildMentionMessage(mentions.Mentions)); err != nil {
+	// Add mentions at the bottom of the thread if there are any.
+	if len(mentions) > 0 {
+		if _, err := c.bot.GetSession().ChannelMessageSendComplex(thread.ID, builder.BuildMentionMessage(mentions)); err != nil {
 			c.log.WithError(err).Error("Failed to send mentions message")
 		}
 	}
 
-	c.log.WithFields(logrus.Fields{
-		"network": alert.Network,
-		"client":  alert.Client,
-	}).Info("Issues detected, sent notification")
+	return msg, nil
+}
+
+// deliverCompactAlert sends the alert to its channel as a single message, summarising the affected
+// instances and carrying any mentions, returning the message.
+func (c *ChecksCommand) deliverCompactAlert(
+	alert *store.MonitorAlert,
+	builder *message.AlertMessageBuilder,
+	mentions []string,
+) (*discordgo.Message, error) {
+	msg, err := c.bot.GetSession().ChannelMessageSendComplex(alert.DiscordChannel, builder.BuildCompactMessage(mentions))
+	if err != nil {
+		return nil, fmt.Errorf("failed to send compact message: %w", err)
+	}
+
+	return msg, nil
+}
+
+// alertMentions returns who to mention in an alert's thread: the client/network's explicit mentions
+// if they're enabled, and the client team's roles in the guild if the alert opted into them.
+func (c *ChecksCommand) alertMentions(ctx context.Context, alert *store.MonitorAlert) []string {
+	mentions := make([]string, 0)
+
+	explicit, err := c.bot.GetMentionsRepo().Get(ctx, alert.Network, alert.Client, alert.DiscordGuildID)
+	if err != nil {
+		c.log.WithError(err).Error("Failed to get mentions")
+	}
+
+	if explicit != nil && explicit.Enabled {
+		mentions = append(mentions, explicit.Mentions...)
+	}
+
+	if alert.MentionTeam {
+		roleIDs, rerr := common.FindRoleIDs(c.bot.GetSession(), alert.DiscordGuildID, c.bot.GetRoleConfig().ClientRoles[alert.Client])
+		if rerr != nil {
+			c.log.WithError(rerr).Error("Failed to get team roles to mention")
+		}
+
+		for _, roleID := range roleIDs {
+			// The team may already be an explicit mention.
+			if mention := fmt.Sprintf("<@&%s>", roleID); !slices.Contains(mentions, mention) {
+				mentions = append(mentions, mention)
+			}
+		}
+	}
+
+	return mentions
+}
+
+// hiveScreenshotsEnabled returns true if Hive screenshots should be taken for the alert, they're
+// skipped entirely when turned off globally or for the alert, the alert is compact and has no thread
+// to post them to, or nothing is posted to Discord.
+func (c *ChecksCommand) hiveScreenshotsEnabled(alert *store.MonitorAlert) bool {
+	return !c.config.DiscordDisabled && !c.config.DisableHiveScreenshots && !alert.DisableHiveScreenshot && !alert.Compact
+}
+
+// hiveSuites returns the suites with an enabled Hive summary alert registered for the network.
+func (c *ChecksCommand) hiveSuites(ctx context.Context, network string) []string {
+	alerts, err := c.bot.GetHiveSummaryRepo().List(ctx)
+	if err != nil {
+		c.log.WithError(err).Error("Failed to list Hive summary alerts")
+
+		return nil
+	}
+
+	var suites []string
+
+	for _, alert := range alerts {
+		if alert.Enabled && alert.Network == network && alert.Suite != "" && !slices.Contains(suites, alert.Suite) {
+			suites = append(suites, alert.Suite)
+		}
+	}
+
+	slices.Sort(suites)
+
+	return suites
+}
+
+// captureHiveSnapshots takes a screenshot of the client's hive test coverage for each suite, or a
+// single one when no suites are registered. Suites the client doesn't run are left out.
+func (c *ChecksCommand) captureHiveSnapshots(
+	ctx context.Context,
+	alert *store.MonitorAlert,
+	checkID string,
+	suites []string,
+) []message.HiveScreenshot {
+	if len(suites) == 0 {
+		suites = []string{""}
+	}
+
+	var (
+		screenshots = make([]message.HiveScreenshot, len(suites))
+		wg          sync.WaitGroup
+	)
+
+	// Hive limits how many snapshots are taken at once, the rest wait their turn.
+	for idx, suite := range suites {
+		wg.Go(func() {
+			screenshots[idx] = message.HiveScreenshot{
+				Suite:   suite,
+				Content: c.captureHiveSnapshot(ctx, alert, checkID, suite),
+			}
+		})
+	}
+
+	wg.Wait()
+
+	return slices.DeleteFunc(screenshots, func(screenshot message.HiveScreenshot) bool {
+		return len(screenshot.Content) == 0
+	})
+}
+
+// captureHiveSnapshot takes a screenshot of the client's hive test coverage for the suite, and
+// persists it alongside the other check artifacts. Returns nil if no screenshot could be taken.
+func (c *ChecksCommand) captureHiveSnapshot(ctx context.Context, alert *store.MonitorAlert, checkID, suite string) []byte {
+	var consensusNode, executionNode string
+
+	cartographoor := c.bot.GetCartographoor()
+	if cartographoor.IsELClient(alert.Client) {
+		executionNode = alert.Client
+	} else {
+		consensusNode = alert.Client
+	}
+
+	content, err := c.bot.GetHive().Snapshot(ctx, hive.SnapshotConfig{
+		Network:       alert.Network,
+		ConsensusNode: consensusNode,
+		ExecutionNode: executionNode,
+		Suite:         suite,
+	})
+	if err != nil {
+		if errors.Is(err, hive.ErrTimeout) {
+			c.log.WithFields(logrus.Fields{
+				"network":       alert.Network,
+				"consensusNode": consensusNode,
+				"executionNode": executionNode,
+				"suite":         suite,
+			}).WithError(err).Error("hive screenshot timed out")
+		} else {
+			c.log.WithError(err).Error("Failed to get Hive screenshot")
+		}
 
-	return true, nil
+		return nil
+	}
+
+	if len(content) == 0 {
+		return nil
+	}
+
+	// Store the screenshot.
+	now := time.Now()
+
+	if err := c.bot.GetChecksRepo().Persist(ctx, &store.CheckArtifact{
+		Network:   alert.Network,
+		Client:    alert.Client,
+		CheckID:   checkID,
+		Type:      hiveArtifactType(suite),
+		CreatedAt: now,
+		UpdatedAt: now,
+		Content:   content,
+	}); err != nil {
+		c.log.WithError(err).Error("Failed to persist Hive screenshot")
+
+		return nil
+	}
+
+	return content
+}
+
+// hiveArtifactType returns the artifact type a suite's screenshot is stored under, suites get their
+// own so each is kept.
+func hiveArtifactType(suite string) string {
+	if slug := message.HiveSuiteSlug(suite); slug != "" {
+		return slug + ".png"
+	}
+
+	return "png"
 }
 
 // createMainMessage creates the main message with embed and buttons.
@@ -543,14 +1364,17 @@ func (c *ChecksCommand) createMainMessage(alert *store.MonitorAlert, builder *me
 }
 
 // createThread creates a new thread for the given message.
-func (c *ChecksCommand) createThread(messageID string, alert *store.MonitorAlert) (*discordgo.Channel, error) {
-	threadName := fmt.Sprintf("Issues - %s", time.Now().Format(threadDateFormat))
-	if alert.Client != "" {
-		threadName = fmt.Sprintf(
-			"%s Issues - %s",
-			cases.Title(language.English, cases.Compact).String(alert.Client),
-			time.Now().Format(threadDateFormat),
-		)
+func (c *ChecksCommand) createThread(messageID, checkID string, alert *store.MonitorAlert) (*discordgo.Channel, error) {
+	vars := common.ThreadNameVars{
+		Client:  cases.Title(language.English, cases.Compact).String(alert.Client),
+		Network: alert.Network,
+		Date:    time.Now().Format(threadDateFormat),
+		CheckID: checkID,
+	}
+
+	threadName := common.RenderThreadName(c.config.ThreadNameTemplate, vars)
+	if threadName == "" {
+		threadName = common.RenderThreadName(DefaultThreadNameTemplate, vars)
 	}
 
 	return c.bot.GetSession().MessageThreadStartComplex(alert.DiscordChannel, messageID, &discordgo.ThreadStart{
@@ -560,9 +1384,38 @@ func (c *ChecksCommand) createThread(messageID string, alert *store.MonitorAlert
 	})
 }
 
-// sendThreadMessages sends category-specific issues to the thread.
+// sendThreadMessages sends category-specific issues to the thread, capped so a large number of
+// affected instances can't flood it.
 func (c *ChecksCommand) sendThreadMessages(threadID string, alert *store.MonitorAlert, results []*checks.Result, builder *message.AlertMessageBuilder) error {
-	categories := groupResultsByCategory(results)
+	messages, overflow := builder.LimitThreadMessages(buildThreadMessages(results, builder), c.config.MaxThreadMessages)
+
+	for _, msg := range messages {
+		if _, err := c.bot.GetSession().ChannelMessageSend(threadID, msg); err != nil {
+			return fmt.Errorf("failed to send category message: %w", err)
+		}
+	}
+
+	if overflow != nil {
+		c.log.WithFields(logrus.Fields{
+			"network": alert.Network,
+			"client":  alert.Client,
+			"limit":   c.config.MaxThreadMessages,
+		}).Info("Thread message limit reached, attached the remaining detail")
+
+		if _, err := c.bot.GetSession().ChannelMessageSendComplex(threadID, overflow); err != nil {
+			return fmt.Errorf("failed to send overflow message: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// buildThreadMessages builds the category-specific messages breaking down the failed results.
+func buildThreadMessages(results []*checks.Result, builder *message.AlertMessageBuilder) []string {
+	var (
+		categories = groupResultsByCategory(results)
+		messages   []string
+	)
 
 	for _, category := range orderedCategories {
 		cat, exists := categories[category]
@@ -570,15 +1423,10 @@ func (c *ChecksCommand) sendThreadMessages(threadID string, alert *store.Monitor
 			continue
 		}
 
-		messages := builder.BuildThreadMessages(category, cat.failedChecks)
-		for _, msg := range messages {
-			if _, err := c.bot.GetSession().ChannelMessageSend(threadID, msg); err != nil {
-				return fmt.Errorf("failed to send category message: %w", err)
-			}
-		}
+		messages = append(messages, builder.BuildThreadMessages(category, cat.failedChecks)...)
 	}
 
-	return nil
+	return messages
 }
 
 // Helper function to group results by category.
@@ -0,0 +1,192 @@
+package checks
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/ethpandaops/panda-pulse/pkg/discord/message"
+)
+
+const (
+	// DefaultRunTimeout bounds a single end-to-end check run (grafana queries, hive
+	// snapshot and discord sends) so a pathological run can't back up the queue.
+	DefaultRunTimeout = 2 * time.Minute
+	// DefaultThreadNameTemplate is the name given to alert threads.
+	DefaultThreadNameTemplate = "{client} Issues - {date}"
+	// DefaultNotificationCooldown is the minimum time between notifications for a network/client.
+	DefaultNotificationCooldown = time.Hour
+	// DefaultMaxThreadMessages caps the messages posted to an alert thread, past which the detail is
+	// attached as a file instead.
+	DefaultMaxThreadMessages = 10
+	// DefaultAlertsPerMinute caps the alerts posted to a single channel each minute.
+	DefaultAlertsPerMinute = 10
+	// DefaultStaleDataThreshold is how old the data behind an alert can be before it's flagged as stale.
+	DefaultStaleDataThreshold = 5 * time.Minute
+	// DefaultNetworkMinHealthyNodes is the number of synced nodes below which a network is considered
+	// down as a whole, rather than any one client having issues.
+	DefaultNetworkMinHealthyNodes = 1
+	// DefaultCheckErrorGrace is the number of consecutive runs a check can error before it's
+	// notified that the client can't be evaluated.
+	DefaultCheckErrorGrace = 2
+	// DefaultCrossNetworkMinimum is the number of networks a client must be a root cause on
+	// before a cross-network incident is posted.
+	DefaultCrossNetworkMinimum = 2
+	// DefaultCrossNetworkWindow is how close together root cause alerts must be sent to be rolled up
+	// into the same cross-network incident.
+	DefaultCrossNetworkWindow = 30 * time.Minute
+)
+
+// Config contains configuration for the checks command.
+type Config struct {
+	// RunTimeout is the overall timeout applied to each RunChecks call.
+	RunTimeout time.Duration
+	// ThreadNameTemplate names alert threads, supporting {client}, {network}, {date} and {checkID}.
+	ThreadNameTemplate string
+	// FlappingWindow is how far back sync status changes are counted when looking for flapping nodes.
+	FlappingWindow time.Duration
+	// FlappingThreshold is the number of sync status changes within the window above which a node is flapping.
+	FlappingThreshold int
+	// NotificationCooldown is the minimum time between notifications for a network/client, a
+	// negative value disables the cooldown.
+	NotificationCooldown time.Duration
+	// MaxThreadMessages caps the messages posted to an alert thread, a negative value disables the cap.
+	MaxThreadMessages int
+	// AlertsPerMinute caps the alerts posted to a single channel each minute, past which they're
+	// summarised in a single message. A negative value disables the cap.
+	AlertsPerMinute int
+	// StaleDataThreshold is how old the data behind an alert can be before it's flagged as stale, a
+	// negative value disables the flag.
+	StaleDataThreshold time.Duration
+	// FlatInstanceList lists affected instances in a single list, rather than split into likely
+	// unrelated and infrastructure sections. Which alerts are sent is unaffected.
+	FlatInstanceList bool
+	// InstancePatterns parses instance names on networks that don't follow the clclient-elclient-N
+	// convention, keyed by network. Networks without a pattern use the default.
+	InstancePatterns map[string]*regexp.Regexp
+	// RegionPattern recognises a region suffixed to instance names, so they're parsed and listed by
+	// region. Defaults to message.DefaultRegionPattern.
+	RegionPattern *regexp.Regexp
+	// DashboardUID is the Grafana dashboard alerts link to for the client, defaults to
+	// message.DefaultDashboardUID.
+	DashboardUID string
+	// LogsDashboardUID is the Grafana dashboard alerts link to for logs, defaults to
+	// message.DefaultLogsDashboardUID.
+	LogsDashboardUID string
+	// NetworkDashboards are the Grafana dashboards the Grafana button on alerts links to instead,
+	// keyed by network, for networks with a bespoke dashboard.
+	NetworkDashboards map[string]string
+	// NetworkMinHealthyNodes is the number of synced nodes below which a network is considered down,
+	// replacing its per-client alerts with a single network-wide one. A negative value disables it.
+	NetworkMinHealthyNodes int
+	// CheckErrorGrace is the number of consecutive runs a check can error, rather than fail, before
+	// it's notified that the client can't be evaluated. A negative value disables the notification.
+	CheckErrorGrace int
+	// CheckErrorChannelID is the channel errored checks are notified in, defaults to the alert's.
+	CheckErrorChannelID string
+	// CrossNetworkChannelID is the channel cross-network incidents are posted to, summarising a
+	// client that's a root cause on several networks at once. Empty disables them.
+	CrossNetworkChannelID string
+	// CrossNetworkMinimum is the number of networks a client must be a root cause on within the
+	// window before a cross-network incident is posted.
+	CrossNetworkMinimum int
+	// CrossNetworkWindow is how close together root cause alerts must be sent to be rolled up into
+	// the same cross-network incident.
+	CrossNetworkWindow time.Duration
+	// EscalationChannelID is the channel incidents open for longer than the escalation thresholds are
+	// posted to. Empty disables escalation.
+	EscalationChannelID string
+	// EscalationThresholds are how long an incident is open before it's escalated, shortest first.
+	// Each is posted once per incident. Defaults to DefaultEscalationThresholds.
+	EscalationThresholds []time.Duration
+	// RemediationCommand is run on every affected instance by the remediation script alerts can opt
+	// into, defaults to message.DefaultRemediationCommand.
+	RemediationCommand string
+	// CriticalChecks are the names of checks that always alert when failing, regardless of root cause
+	// attribution, infrastructure or unrelated issues, or the alert's minimum affected instances.
+	CriticalChecks []string
+	// CheckNameAliases collapses near-duplicate check names in the issues listed by alerts, the raw
+	// names are kept in the check log and logged at debug level.
+	CheckNameAliases message.CheckNameAliases
+	// ClientPresets are named groups of clients '/checks register' can register at once, keyed by
+	// name. They're added to, and override, the built-in production presets.
+	ClientPresets map[string][]string
+	// Templates is the wording of alert messages, defaults to message.DefaultTemplates().
+	Templates *message.Templates
+	// EmbedFields are the fields of an alert's main embed in the order they're shown, those left out
+	// are hidden. Defaults to message.DefaultEmbedFields.
+	EmbedFields []message.EmbedField
+	// PersistQueries persists the raw Grafana response to every query a check run makes alongside its
+	// log, retrievable with '/checks debug'. Off by default as it adds to storage.
+	PersistQueries bool
+	// DisableHiveScreenshots stops Hive screenshots being taken for alerts, the Hive button is kept.
+	DisableHiveScreenshots bool
+	// HiveScreenshotHours limits Hive screenshots to a window, such as business hours, outside of
+	// which alerts just link to Hive. Nil takes them at any time.
+	HiveScreenshotHours *ScreenshotHours
+	// DiscordDisabled stops alerts being posted to Discord, for deployments only alerting via Slack.
+	DiscordDisabled bool
+	// EnforceNetworkClients rejects registering clients cartographoor doesn't list as running on the
+	// network, rather than registering them with a warning.
+	EnforceNetworkClients bool
+	// WeightedFailures weights the analyzer's failing client pairs by how many of their instances are
+	// failing, so a pair with one of ten instances failing counts for less than one with all ten.
+	WeightedFailures bool
+	// ManualRunDetails lists the checks that ran, and their status, when a '/checks run' passes,
+	// unless the command says otherwise.
+	ManualRunDetails bool
+}
+
+// withDefaults returns a copy of the config with any unset values defaulted.
+func (c *Config) withDefaults() *Config {
+	cfg := &Config{}
+
+	if c != nil {
+		*cfg = *c
+	}
+
+	if cfg.RunTimeout <= 0 {
+		cfg.RunTimeout = DefaultRunTimeout
+	}
+
+	if cfg.ThreadNameTemplate == "" {
+		cfg.ThreadNameTemplate = DefaultThreadNameTemplate
+	}
+
+	if cfg.NotificationCooldown == 0 {
+		cfg.NotificationCooldown = DefaultNotificationCooldown
+	}
+
+	if cfg.MaxThreadMessages == 0 {
+		cfg.MaxThreadMessages = DefaultMaxThreadMessages
+	}
+
+	if cfg.AlertsPerMinute == 0 {
+		cfg.AlertsPerMinute = DefaultAlertsPerMinute
+	}
+
+	if cfg.StaleDataThreshold == 0 {
+		cfg.StaleDataThreshold = DefaultStaleDataThreshold
+	}
+
+	if cfg.NetworkMinHealthyNodes == 0 {
+		cfg.NetworkMinHealthyNodes = DefaultNetworkMinHealthyNodes
+	}
+
+	if cfg.CheckErrorGrace == 0 {
+		cfg.CheckErrorGrace = DefaultCheckErrorGrace
+	}
+
+	if cfg.CrossNetworkMinimum <= 0 {
+		cfg.CrossNetworkMinimum = DefaultCrossNetworkMinimum
+	}
+
+	if cfg.CrossNetworkWindow <= 0 {
+		cfg.CrossNetworkWindow = DefaultCrossNetworkWindow
+	}
+
+	if len(cfg.EscalationThresholds) == 0 {
+		cfg.EscalationThresholds = DefaultEscalationThresholds
+	}
+
+	return cfg
+}
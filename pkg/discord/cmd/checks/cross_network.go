@@ -0,0 +1,106 @@
+package checks
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/ethpandaops/panda-pulse/pkg/store"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	msgCrossNetworkIncident = "🌐 **Cross-network incident**: **%s** has been alerted as a root cause on **%d** " +
+		"networks in the last %s, this is more likely a client bug than a problem with any one network\n%s"
+	msgCrossNetworkAlert = "- **%s**: %s"
+)
+
+// crossNetworkIncident buffers a client's recent root cause alerts, keyed by network.
+type crossNetworkIncident struct {
+	alerts     map[string]crossNetworkAlert
+	notifiedAt time.Time
+}
+
+// crossNetworkAlert is a root cause alert sent for a client on a single network.
+type crossNetworkAlert struct {
+	link   string
+	sentAt time.Time
+}
+
+// recordCrossNetworkAlert buffers a root cause alert sent for the alert's client. Once the client
+// has been alerted on enough networks within the window, a single cross-network incident linking
+// each network's alert is posted. It's posted at most once per window, alerts on further networks
+// within it are left to their own channels.
+func (c *ChecksCommand) recordCrossNetworkAlert(alert *store.MonitorAlert, msg *discordgo.Message) {
+	if c.config.CrossNetworkChannelID == "" || msg == nil {
+		return
+	}
+
+	var (
+		now    = time.Now()
+		window = c.config.CrossNetworkWindow
+	)
+
+	c.crossNetworkMu.Lock()
+
+	// Drop anything that's aged out of the window, so the buffer only ever holds recent alerts.
+	for client, incident := range c.crossNetwork {
+		maps.DeleteFunc(incident.alerts, func(_ string, sent crossNetworkAlert) bool {
+			return now.Sub(sent.sentAt) > window
+		})
+
+		if len(incident.alerts) == 0 && now.Sub(incident.notifiedAt) > window {
+			delete(c.crossNetwork, client)
+		}
+	}
+
+	incident, ok := c.crossNetwork[alert.Client]
+	if !ok {
+		incident = &crossNetworkIncident{alerts: make(map[string]crossNetworkAlert)}
+		c.crossNetwork[alert.Client] = incident
+	}
+
+	incident.alerts[alert.Network] = crossNetworkAlert{
+		link:   messageLink(alert.DiscordGuildID, msg.ChannelID, msg.ID),
+		sentAt: now,
+	}
+
+	if len(incident.alerts) < c.config.CrossNetworkMinimum || now.Sub(incident.notifiedAt) <= window {
+		c.crossNetworkMu.Unlock()
+
+		return
+	}
+
+	incident.notifiedAt = now
+	alerts := maps.Clone(incident.alerts)
+
+	c.crossNetworkMu.Unlock()
+
+	networks := slices.Sorted(maps.Keys(alerts))
+	lines := make([]string, 0, len(networks))
+
+	for _, network := range networks {
+		lines = append(lines, fmt.Sprintf(msgCrossNetworkAlert, network, alerts[network].link))
+	}
+
+	log := c.log.WithFields(logrus.Fields{
+		"client":   alert.Client,
+		"networks": networks,
+	})
+
+	log.Warn("Client is a root cause on several networks, posting cross-network incident")
+
+	if _, err := c.bot.GetSession().ChannelMessageSend(c.config.CrossNetworkChannelID, fmt.Sprintf(
+		msgCrossNetworkIncident, alert.Client, len(networks), window, strings.Join(lines, "\n"),
+	)); err != nil {
+		log.WithError(err).WithField("channel", c.config.CrossNetworkChannelID).Error("Failed to send cross-network incident")
+	}
+}
+
+// messageLink returns a link to a Discord message.
+func messageLink(guildID, channelID, messageID string) string {
+	return fmt.Sprintf("https://discord.com/channels/%s/%s/%s", guildID, channelID, messageID)
+}
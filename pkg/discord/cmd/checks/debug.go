@@ -4,17 +4,18 @@ import (
 	"bytes"
 	"context"
 	"fmt"
-	"io"
 
-	"github.com/aws/aws-sdk-go-v2/aws"
-	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/bwmarrin/discordgo"
 	"github.com/ethpandaops/panda-pulse/pkg/store"
 )
 
 const (
-	msgNoCheckFound = "ℹ️ No check found with ID: %s"
-	debugEmbedColor = 0x7289DA
+	msgNoCheckFound   = "ℹ️ No check found with ID: %s"
+	msgNoResultsFound = "ℹ️ No structured results stored for check ID: %s. Only checks run since they were persisted have them, use the log instead"
+	debugEmbedColor   = 0x7289DA
+
+	debugFormatLog  = "log"  // Attach the freeform log, and any raw query responses.
+	debugFormatJSON = "json" // Attach the structured results and analysis.
 )
 
 func (c *ChecksCommand) handleDebug(
@@ -33,7 +34,16 @@ func (c *ChecksCommand) handleDebug(
 		return fmt.Errorf("failed to acknowledge interaction: %w", err)
 	}
 
-	checkID := opt.Options[0].StringValue()
+	var checkID, format string
+
+	for _, o := range opt.Options {
+		switch o.Name {
+		case "id":
+			checkID = o.StringValue()
+		case "format":
+			format = o.StringValue()
+		}
+	}
 
 	// List all artifacts and find the one with matching ID.
 	artifacts, err := c.bot.GetChecksRepo().List(context.Background())
@@ -61,21 +71,43 @@ func (c *ChecksCommand) handleDebug(
 		return nil
 	}
 
-	// Get the log content.
-	output, err := c.bot.GetChecksRepo().GetStore().GetObject(context.Background(), &s3.GetObjectInput{
-		Bucket: aws.String(c.bot.GetChecksRepo().GetBucket()),
-		Key:    aws.String(c.getLogPath(matchingArtifact)),
-	})
+	if format == debugFormatJSON {
+		return c.sendDebugResults(s, i, matchingArtifact)
+	}
+
+	// Get the log content, decompressed if need be.
+	logArtifact, err := c.bot.GetChecksRepo().GetArtifact(
+		context.Background(),
+		matchingArtifact.Network,
+		matchingArtifact.Client,
+		matchingArtifact.CheckID,
+		"log",
+	)
 	if err != nil {
 		return fmt.Errorf("failed to get log content: %w", err)
 	}
 
-	defer output.Body.Close()
+	files := []*discordgo.File{
+		{
+			Name:        fmt.Sprintf("%s.log", matchingArtifact.CheckID),
+			ContentType: "text/plain",
+			Reader:      bytes.NewReader(logArtifact.Content),
+		},
+	}
 
-	// Read the log content.
-	logContent, err := io.ReadAll(output.Body)
-	if err != nil {
-		return fmt.Errorf("failed to read log content: %w", err)
+	// Raw query responses are only persisted when enabled, so there may be none.
+	if queryArtifact, qerr := c.bot.GetChecksRepo().GetArtifact(
+		context.Background(),
+		matchingArtifact.Network,
+		matchingArtifact.Client,
+		matchingArtifact.CheckID,
+		queryArtifactType,
+	); qerr == nil {
+		files = append(files, &discordgo.File{
+			Name:        fmt.Sprintf("%s-queries.json", matchingArtifact.CheckID),
+			ContentType: "application/json",
+			Reader:      bytes.NewReader(queryArtifact.Content),
+		})
 	}
 
 	// Send the response.
@@ -85,15 +117,9 @@ func (c *ChecksCommand) handleDebug(
 		return fmt.Errorf("failed to send embed: %w", err)
 	}
 
-	// Follow up with the log file.
+	// Follow up with the log file, and the query responses if there are any.
 	if _, err = s.FollowupMessageCreate(i.Interaction, true, &discordgo.WebhookParams{
-		Files: []*discordgo.File{
-			{
-				Name:        fmt.Sprintf("%s.log", matchingArtifact.CheckID),
-				ContentType: "text/plain",
-				Reader:      bytes.NewReader(logContent),
-			},
-		},
+		Files: files,
 		Flags: discordgo.MessageFlagsEphemeral,
 	}); err != nil {
 		return fmt.Errorf("failed to send log file: %w", err)
@@ -102,13 +128,45 @@ func (c *ChecksCommand) handleDebug(
 	return nil
 }
 
-// getLogPath returns the S3 path for a check's log file.
-func (c *ChecksCommand) getLogPath(artifact *store.CheckArtifact) string {
-	return fmt.Sprintf(
-		"%s/networks/%s/checks/%s/%s.log",
-		c.bot.GetChecksRepo().GetPrefix(),
-		artifact.Network,
-		artifact.Client,
-		artifact.CheckID,
+// sendDebugResults follows up with the structured results and analysis of a check run as JSON.
+func (c *ChecksCommand) sendDebugResults(s *discordgo.Session, i *discordgo.InteractionCreate, match *store.CheckArtifact) error {
+	artifact, err := c.bot.GetChecksRepo().GetArtifact(
+		context.Background(),
+		match.Network,
+		match.Client,
+		match.CheckID,
+		resultsArtifactType,
 	)
+	if err != nil {
+		c.log.WithError(err).WithField("checkID", match.CheckID).Debug("No structured results stored")
+
+		if _, ierr := s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+			Content: stringPtr(fmt.Sprintf(msgNoResultsFound, match.CheckID)),
+		}); ierr != nil {
+			return fmt.Errorf("failed to send not found message: %w", ierr)
+		}
+
+		return nil
+	}
+
+	if _, err = s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+		Content: stringPtr(fmt.Sprintf("✅ Structured results found for **`%s`**", match.CheckID)),
+	}); err != nil {
+		return fmt.Errorf("failed to send response: %w", err)
+	}
+
+	if _, err = s.FollowupMessageCreate(i.Interaction, true, &discordgo.WebhookParams{
+		Files: []*discordgo.File{
+			{
+				Name:        fmt.Sprintf("%s-results.json", match.CheckID),
+				ContentType: "application/json",
+				Reader:      bytes.NewReader(artifact.Content),
+			},
+		},
+		Flags: discordgo.MessageFlagsEphemeral,
+	}); err != nil {
+		return fmt.Errorf("failed to send results file: %w", err)
+	}
+
+	return nil
 }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/bwmarrin/discordgo"
+	"github.com/ethpandaops/panda-pulse/pkg/discord/cmd/common"
 	"github.com/ethpandaops/panda-pulse/pkg/store"
 	"github.com/sirupsen/logrus"
 )
@@ -24,13 +25,13 @@ func (c *ChecksCommand) handleDeregister(
 ) error {
 	var (
 		options = data.Options
-		network = options[0].StringValue()
+		network = common.FindOption(options, "network").StringValue()
 		client  *string
 		guildID = i.GuildID // Get the guild ID from the interaction
 	)
 
-	if len(options) > 1 {
-		c := options[1].StringValue()
+	if opt := common.FindOption(options, "client"); opt != nil {
+		c := opt.StringValue()
 		client = &c
 	}
 
@@ -147,6 +148,16 @@ func (c *ChecksCommand) unscheduleAlert(ctx context.Context, alert *store.Monito
 	// Remove from scheduler
 	c.bot.GetScheduler().RemoveJob(key)
 
+	// And stop exporting its health, stale series would look like a network that's stopped evaluating.
+	c.metrics.DeleteHealth(alert.Network, alert.Client)
+
+	// An open incident would otherwise never be resolved, nothing checks the client any more.
+	if repo := c.bot.GetIncidentsRepo(); repo != nil {
+		if err := repo.Purge(ctx, alert.Network, alert.Client); err != nil {
+			c.log.WithError(err).WithField("key", key).Warn("Failed to purge incident")
+		}
+	}
+
 	return nil
 }
 
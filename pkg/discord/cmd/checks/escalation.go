@@ -0,0 +1,197 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ethpandaops/panda-pulse/pkg/store"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultEscalationSchedule is how often open incidents are checked for escalation.
+	DefaultEscalationSchedule = "*/15 * * * *"
+	// DefaultEscalationHistory is how far back a client's resolved incidents are counted in escalations.
+	DefaultEscalationHistory = 7 * 24 * time.Hour
+
+	escalationJobName     = "escalate-incidents"
+	maxEscalatedInstances = 10 // Affected instances listed in an escalation, the rest are counted.
+
+	msgIncidentEscalated = "⏫ **Escalated incident**: **%s** on **%s** has been failing for **%s**, since <t:%d:f>\n" +
+		"- Found failing by %d check runs, %d of them as a root cause\n" +
+		"- %d affected instances%s\n" +
+		"- Latest check `%s`, see `/checks debug`\n" +
+		"- %d earlier incidents resolved in the last %d days"
+	msgEscalatedInstancesMore = ", and %d more"
+)
+
+// DefaultEscalationThresholds are how long an incident is open before it's escalated.
+var DefaultEscalationThresholds = []time.Duration{4 * time.Hour}
+
+// ParseEscalationThresholds parses comma-separated durations an incident is escalated after, such
+// as "4h,12h,24h". They're returned shortest first. An empty value has no thresholds.
+func ParseEscalationThresholds(value string) ([]time.Duration, error) {
+	thresholds := make([]time.Duration, 0)
+
+	for part := range strings.SplitSeq(value, ",") {
+		if part = strings.TrimSpace(part); part == "" {
+			continue
+		}
+
+		threshold, err := time.ParseDuration(part)
+		if err != nil {
+			return nil, fmt.Errorf("invalid duration %q: %w", part, err)
+		}
+
+		if threshold <= 0 {
+			return nil, fmt.Errorf("invalid duration %q, expected a positive duration", part)
+		}
+
+		thresholds = append(thresholds, threshold)
+	}
+
+	slices.Sort(thresholds)
+
+	return slices.Compact(thresholds), nil
+}
+
+// escalationsDue returns how many of the thresholds an incident open for the given duration has
+// crossed. Thresholds must be sorted shortest first.
+func escalationsDue(open time.Duration, thresholds []time.Duration) int {
+	due, _ := slices.BinarySearch(thresholds, open+1)
+
+	return due
+}
+
+// Start schedules the escalation of prolonged incidents, if there's an escalation channel.
+func (c *ChecksCommand) Start(_ context.Context) error {
+	if c.config.EscalationChannelID == "" || c.bot.GetIncidentsRepo() == nil {
+		return nil
+	}
+
+	if err := c.bot.GetScheduler().AddJob(escalationJobName, DefaultEscalationSchedule, c.escalateIncidents); err != nil {
+		return fmt.Errorf("failed to schedule incident escalation: %w", err)
+	}
+
+	c.log.WithFields(logrus.Fields{
+		"channel":    c.config.EscalationChannelID,
+		"thresholds": c.config.EscalationThresholds,
+	}).Info("Scheduled incident escalation")
+
+	return nil
+}
+
+// escalateIncidents posts open incidents that have crossed another escalation threshold to the
+// escalation channel. Each threshold is only posted once per incident, an incident crossing several
+// since it was last looked at is posted once. Nothing is posted while notifications are paused, the
+// incidents are picked up again once they resume.
+func (c *ChecksCommand) escalateIncidents(ctx context.Context) error {
+	if c.bot.IsMaintenance() || c.config.DiscordDisabled {
+		return nil
+	}
+
+	repo := c.bot.GetIncidentsRepo()
+
+	incidents, err := repo.List(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list incidents: %w", err)
+	}
+
+	now := time.Now()
+
+	for _, incident := range incidents {
+		if !incident.IsOpen() {
+			continue
+		}
+
+		due := escalationsDue(incident.Duration(now), c.config.EscalationThresholds)
+		if due <= incident.Escalations {
+			continue
+		}
+
+		log := c.log.WithFields(logrus.Fields{
+			"network":  incident.Network,
+			"client":   incident.Client,
+			"incident": incident.ID,
+			"duration": incident.Duration(now).Truncate(time.Second),
+		})
+
+		earlier, herr := c.resolvedIncidents(ctx, incident, now.Add(-DefaultEscalationHistory))
+		if herr != nil {
+			log.WithError(herr).Warn("Failed to get incident history")
+		}
+
+		// Left unmarked if it can't be posted, so it's tried again next time.
+		if _, serr := c.bot.GetSession().ChannelMessageSend(
+			c.config.EscalationChannelID,
+			formatEscalation(incident, earlier, now),
+		); serr != nil {
+			log.WithError(serr).WithField("channel", c.config.EscalationChannelID).Error("Failed to send incident escalation")
+
+			continue
+		}
+
+		log.Warn("Escalated incident")
+
+		incident.Escalations = due
+
+		if perr := repo.Persist(ctx, incident); perr != nil {
+			log.WithError(perr).Error("Failed to persist incident")
+		}
+	}
+
+	return nil
+}
+
+// resolvedIncidents counts the incident's client's incidents on the network resolved since the
+// given time.
+func (c *ChecksCommand) resolvedIncidents(ctx context.Context, incident *store.Incident, since time.Time) (int, error) {
+	history, err := c.bot.GetIncidentsRepo().ListHistory(ctx, incident.Network, since)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+
+	for _, resolved := range history {
+		if resolved.Client == incident.Client {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+// formatEscalation describes an escalated incident, with how long it's been open and its history.
+func formatEscalation(incident *store.Incident, earlier int, now time.Time) string {
+	var (
+		instances = incident.AffectedInstances[:min(len(incident.AffectedInstances), maxEscalatedInstances)]
+		listed    string
+	)
+
+	if len(instances) > 0 {
+		listed = ": `" + strings.Join(instances, "`, `") + "`"
+
+		if more := len(incident.AffectedInstances) - len(instances); more > 0 {
+			listed += fmt.Sprintf(msgEscalatedInstancesMore, more)
+		}
+	}
+
+	return fmt.Sprintf(
+		msgIncidentEscalated,
+		incident.Client,
+		incident.Network,
+		incident.Duration(now).Truncate(time.Minute),
+		incident.StartedAt.Unix(),
+		incident.Runs,
+		incident.RootCauseRuns,
+		len(incident.AffectedInstances),
+		listed,
+		incident.LastCheckID,
+		earlier,
+		int(DefaultEscalationHistory.Hours()/24),
+	)
+}
@@ -0,0 +1,265 @@
+package checks
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/ethpandaops/panda-pulse/pkg/checks"
+	"github.com/ethpandaops/panda-pulse/pkg/store"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	msgNoIncidents       = "✅ No open incidents%s"
+	msgIncidentsHeader   = "🚨 Open incidents%s\n"
+	msgIncidentsNetwork  = " on **%s**"
+	msgIncidentLine      = "- **%s** on **%s**, open for %s since <t:%d:f> (%d affected instances, %d runs)%s\n"
+	msgIncidentRootCause = ", root cause"
+	msgIncidentsMore     = "...and %d more\n"
+	maxIncidentsMessage  = 1900 // Discord messages are capped at 2000 characters.
+	msgClientRecovered   = "✅ **%s** on **%s** has recovered after failing for %s"
+)
+
+// failing returns true if the outcome means the client was found failing, whether or not a
+// notification was actually sent.
+func (o notifyOutcome) failing() bool {
+	switch o {
+	case outcomeSent, outcomeMaintenance, outcomeCooldown, outcomeRateLimited, outcomeBelowMin,
+		outcomeMuted, outcomeSnoozed, outcomeAcked:
+		return true
+	default:
+		return false
+	}
+}
+
+// trackIncident opens, updates or resolves the client's incident based on the outcome of a check
+// run. A client found failing opens an incident if it doesn't have one, each further failing run
+// updates it, and the first run to find the client healthy resolves it. Failures are logged, incident
+// tracking shouldn't get in the way of alerting.
+func (c *ChecksCommand) trackIncident(ctx context.Context, alert *store.MonitorAlert, runner checks.Runner, outcome notifyOutcome) {
+	repo := c.bot.GetIncidentsRepo()
+	if repo == nil || outcome == "" {
+		return
+	}
+
+	// Detach from the run timeout, the run itself has finished.
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), persistTimeout)
+	defer cancel()
+
+	log := c.log.WithFields(logrus.Fields{
+		"network": alert.Network,
+		"client":  alert.Client,
+	})
+
+	incident, err := repo.Get(ctx, alert.Network, alert.Client)
+	if err != nil {
+		log.WithError(err).Warn("Failed to get incident")
+
+		return
+	}
+
+	var (
+		now    = time.Now()
+		isOpen = incident != nil && incident.IsOpen()
+	)
+
+	switch {
+	case outcome.failing() && !isOpen:
+		incident = &store.Incident{
+			ID:        runner.GetID(),
+			Network:   alert.Network,
+			Client:    alert.Client,
+			Status:    store.IncidentStatusOpen,
+			StartedAt: now,
+		}
+
+		log.WithField("incident", incident.ID).Info("Opened incident")
+	case outcome.failing():
+		// Already open, just refreshed below.
+	case isOpen:
+		incident.Status = store.IncidentStatusResolved
+		incident.ResolvedAt = now
+		incident.UpdatedAt = now
+
+		log.WithFields(logrus.Fields{
+			"incident": incident.ID,
+			"duration": incident.Duration(now).Truncate(time.Second),
+		}).Info("Resolved incident")
+	default:
+		// Healthy and nothing open, nothing to do.
+		return
+	}
+
+	if incident.IsOpen() {
+		incident.UpdatedAt = now
+		incident.LastCheckID = runner.GetID()
+		incident.Runs++
+		incident.AffectedInstances = failedNodes(runner.GetResults())
+		incident.IsRootCause = slices.Contains(runner.GetAnalysis().RootCause, alert.Client)
+
+		if incident.IsRootCause {
+			incident.RootCauseRuns++
+		}
+
+		if outcome == outcomeSent {
+			incident.Notified = true
+		}
+	}
+
+	if perr := repo.Persist(ctx, incident); perr != nil {
+		log.WithError(perr).Error("Failed to persist incident")
+	}
+
+	if !incident.IsOpen() {
+		if aerr := repo.Archive(ctx, incident); aerr != nil {
+			log.WithError(aerr).Error("Failed to archive incident")
+		}
+
+		c.clearAcknowledgement(ctx, alert)
+		c.sendRecovery(alert, incident, now)
+	}
+}
+
+// sendRecovery posts an all-clear for a resolved incident to the alert's recovery channel. Only
+// incidents that were alerted on are posted, an incident that never got past the cooldown or minimum
+// instances wasn't news in the first place. Nothing is posted while notifications are paused.
+func (c *ChecksCommand) sendRecovery(alert *store.MonitorAlert, incident *store.Incident, now time.Time) {
+	if !incident.Notified || c.bot.IsMaintenance() || c.config.DiscordDisabled {
+		return
+	}
+
+	channelID := alert.GetRecoveryChannel()
+
+	if _, err := c.bot.GetSession().ChannelMessageSend(channelID, fmt.Sprintf(
+		msgClientRecovered, alert.Client, alert.Network, incident.Duration(now).Truncate(time.Minute),
+	)); err != nil {
+		c.log.WithError(err).WithFields(logrus.Fields{
+			"network": alert.Network,
+			"client":  alert.Client,
+			"channel": channelID,
+		}).Error("Failed to send recovery notification")
+	}
+}
+
+// failedNodes returns the nodes affected by any failed result, sorted and deduplicated.
+func failedNodes(results []*checks.Result) []string {
+	nodes := make([]string, 0)
+
+	for _, result := range results {
+		if result.Status != checks.StatusFail {
+			continue
+		}
+
+		nodes = append(nodes, result.AffectedNodes...)
+	}
+
+	slices.Sort(nodes)
+
+	return slices.Compact(nodes)
+}
+
+// getIncidentsCommandDefinition returns the '/checks incidents' subcommand definition.
+func (c *ChecksCommand) getIncidentsCommandDefinition() *discordgo.ApplicationCommandOption {
+	return &discordgo.ApplicationCommandOption{
+		Name:        "incidents",
+		Description: "List open incidents for registered health checks",
+		Type:        discordgo.ApplicationCommandOptionSubCommand,
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Name:         "network",
+				Description:  "Network to list incidents for (optional)",
+				Type:         discordgo.ApplicationCommandOptionString,
+				Required:     false,
+				Autocomplete: true,
+			},
+		},
+	}
+}
+
+// handleIncidents handles the '/checks incidents' command.
+func (c *ChecksCommand) handleIncidents(
+	s *discordgo.Session,
+	i *discordgo.InteractionCreate,
+	data *discordgo.ApplicationCommandInteractionDataOption,
+) error {
+	var (
+		ctx     = context.Background()
+		network *string
+		suffix  string
+	)
+
+	for _, opt := range data.Options {
+		if opt.Name == "network" {
+			n := opt.StringValue()
+			network = &n
+			suffix = fmt.Sprintf(msgIncidentsNetwork, n)
+		}
+	}
+
+	// Only list incidents for the checks this guild has registered.
+	alerts, err := c.listAlerts(ctx, i.GuildID, network)
+	if err != nil {
+		return err
+	}
+
+	incidents, err := c.bot.GetIncidentsRepo().List(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list incidents: %w", err)
+	}
+
+	incidents = slices.DeleteFunc(incidents, func(incident *store.Incident) bool {
+		return !incident.IsOpen() || c.getExistingAlert(alerts, incident.Network, incident.Client) == nil
+	})
+
+	if len(incidents) == 0 {
+		return respondEphemeral(s, i, fmt.Sprintf(msgNoIncidents, suffix))
+	}
+
+	// Longest running first.
+	slices.SortFunc(incidents, func(a, b *store.Incident) int {
+		return cmp.Or(a.StartedAt.Compare(b.StartedAt), cmp.Compare(a.Network, b.Network), cmp.Compare(a.Client, b.Client))
+	})
+
+	return respondEphemeral(s, i, formatIncidents(incidents, suffix, time.Now()))
+}
+
+// formatIncidents lists open incidents with how long they've been open, truncating the list to fit
+// in a single message.
+func formatIncidents(incidents []*store.Incident, suffix string, now time.Time) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, msgIncidentsHeader, suffix)
+
+	for idx, incident := range incidents {
+		rootCause := ""
+		if incident.IsRootCause {
+			rootCause = msgIncidentRootCause
+		}
+
+		line := fmt.Sprintf(
+			msgIncidentLine,
+			incident.Client,
+			incident.Network,
+			incident.Duration(now).Truncate(time.Minute),
+			incident.StartedAt.Unix(),
+			len(incident.AffectedInstances),
+			incident.Runs,
+			rootCause,
+		)
+
+		if sb.Len()+len(line) > maxIncidentsMessage {
+			fmt.Fprintf(&sb, msgIncidentsMore, len(incidents)-idx)
+
+			break
+		}
+
+		sb.WriteString(line)
+	}
+
+	return sb.String()
+}
@@ -3,28 +3,40 @@ package checks
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/bwmarrin/discordgo"
+	"github.com/ethpandaops/panda-pulse/pkg/discord/cmd/common"
 	"github.com/ethpandaops/panda-pulse/pkg/store"
 	"github.com/robfig/cron/v3"
 )
 
 const (
-	msgNoChecksRegistered = "ℹ️ No checks are currently registered%s\n"
-	msgNoChecksForNetwork = " for the network **%s**"
-	msgNoChecksAnyNetwork = " for any network"
-	msgNetworkClients     = "🌐 Clients registered for **%s** notifications\n"
-	msgAlertsSentTo       = "Alerts are sent to "
+	msgNoChecksRegistered  = "ℹ️ No checks are currently registered%s\n"
+	msgNoChecksForNetwork  = " for the network **%s**"
+	msgNoChecksAnyNetwork  = " for any network"
+	msgNoChecksByUser      = " by <@%s>"
+	msgNetworkClients      = "🌐 Clients registered for **%s** notifications\n"
+	msgAlertsSentTo        = "Alerts are sent to "
+	msgRecoveriesSentTo    = "Recoveries are sent to "
+	msgRegisteredBy        = "Registered by "
+	msgRegisteredByNobody  = "unknown"
+	msgChecksRunOn         = "Checks run on "
+	msgNextRunDisabled     = "disabled"
+	msgNextRunNotScheduled = "not scheduled"
 )
 
 // clientInfo represents registration status and channel for a client.
 type clientInfo struct {
-	registered bool
-	channelID  string
-	schedule   string
-	nextRun    time.Time
+	registered   bool
+	channelID    string
+	schedule     string
+	nextRun      time.Time
+	notScheduled string // Shown in place of the next run when the scheduler has no job for the alert.
+	minInstances int
 }
 
 // handleList handles the '/checks list' command.
@@ -34,20 +46,29 @@ func (c *ChecksCommand) handleList(
 	data *discordgo.ApplicationCommandInteractionDataOption,
 ) error {
 	var (
-		network *string
-		guildID = i.GuildID
+		network      *string
+		registeredBy string
+		guildID      = i.GuildID
 	)
 
-	if len(data.Options) > 0 {
-		n := data.Options[0].StringValue()
+	if opt := common.FindOption(data.Options, "network"); opt != nil {
+		n := opt.StringValue()
 		network = &n
 	}
 
+	if opt := common.FindOption(data.Options, "registered-by"); opt != nil {
+		registeredBy = opt.UserValue(nil).ID
+	}
+
 	alerts, err := c.listAlerts(context.Background(), guildID, network)
 	if err != nil {
 		return fmt.Errorf("failed to list alerts: %w", err)
 	}
 
+	if registeredBy != "" {
+		alerts = filterRegisteredBy(alerts, registeredBy)
+	}
+
 	// Get all unique networks.
 	networks := make(map[string]bool)
 
@@ -63,6 +84,10 @@ func (c *ChecksCommand) handleList(
 			suffix = fmt.Sprintf(msgNoChecksForNetwork, *network)
 		}
 
+		if registeredBy != "" {
+			suffix += fmt.Sprintf(msgNoChecksByUser, registeredBy)
+		}
+
 		return s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 			Type: discordgo.InteractionResponseChannelMessageWithSource,
 			Data: &discordgo.InteractionResponseData{
@@ -106,12 +131,15 @@ func (c *ChecksCommand) handleList(
 		// Update with registered clients and their channels.
 		for _, alert := range alerts {
 			if alert.Network == networkName {
-				nextRun := calculateNextRun(alert.Schedule)
+				nextRun, notScheduled := c.nextRun(alert)
+
 				registered[alert.Client] = clientInfo{
-					registered: true,
-					channelID:  alert.DiscordChannel,
-					schedule:   alert.Schedule,
-					nextRun:    nextRun,
+					registered:   true,
+					channelID:    alert.DiscordChannel,
+					schedule:     effectiveSchedule(alert),
+					nextRun:      nextRun,
+					notScheduled: notScheduled,
+					minInstances: minAffectedInstances(alert),
 				}
 			}
 		}
@@ -148,6 +176,22 @@ func (c *ChecksCommand) handleList(
 			msg.WriteString("\n")
 		}
 
+		// Recoveries go to the alert channels above unless a recovery channel is set.
+		recoveryChannels := make([]string, 0)
+
+		for _, alert := range alerts {
+			if alert.Network == networkName && alert.RecoveryChannel != "" && !slices.Contains(recoveryChannels, alert.RecoveryChannel) {
+				recoveryChannels = append(recoveryChannels, alert.RecoveryChannel)
+			}
+		}
+
+		if len(recoveryChannels) > 0 {
+			msg.WriteString(msgRecoveriesSentTo + "<#" + strings.Join(recoveryChannels, ">, <#") + ">\n")
+		}
+
+		msg.WriteString(buildScheduleLine(alerts, networkName))
+		msg.WriteString(buildRegisteredByLine(alerts, networkName))
+
 		// For the first network, edit the response
 		if firstMessage {
 			_, err = s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
@@ -205,6 +249,126 @@ func (c *ChecksCommand) listAlerts(ctx context.Context, guildID string, network
 	return filtered, nil
 }
 
+// filterRegisteredBy returns the alerts registered by the given user.
+func filterRegisteredBy(alerts []*store.MonitorAlert, userID string) []*store.MonitorAlert {
+	filtered := make([]*store.MonitorAlert, 0, len(alerts))
+
+	for _, alert := range alerts {
+		if alert.RegisteredBy == userID {
+			filtered = append(filtered, alert)
+		}
+	}
+
+	return filtered
+}
+
+// buildRegisteredByLine lists who registered the network's alerts along with their clients, such as
+// "Registered by <@123> (geth, prysm), unknown (teku)". Alerts registered before the user was
+// recorded are listed as unknown.
+func buildRegisteredByLine(alerts []*store.MonitorAlert, network string) string {
+	var (
+		users   = make([]string, 0)
+		clients = make(map[string][]string)
+	)
+
+	for _, alert := range alerts {
+		if alert.Network != network {
+			continue
+		}
+
+		if _, ok := clients[alert.RegisteredBy]; !ok && alert.RegisteredBy != "" {
+			users = append(users, alert.RegisteredBy)
+		}
+
+		clients[alert.RegisteredBy] = append(clients[alert.RegisteredBy], alert.Client)
+	}
+
+	// Known users first, then the legacy alerts.
+	if _, ok := clients[""]; ok {
+		users = append(users, "")
+	}
+
+	if len(users) == 0 {
+		return ""
+	}
+
+	parts := make([]string, 0, len(users))
+
+	for _, user := range users {
+		name := msgRegisteredByNobody
+		if user != "" {
+			name = fmt.Sprintf("<@%s>", user)
+		}
+
+		slices.Sort(clients[user])
+		parts = append(parts, fmt.Sprintf("%s (%s)", name, strings.Join(clients[user], ", ")))
+	}
+
+	return msgRegisteredBy + strings.Join(parts, ", ") + "\n"
+}
+
+// buildScheduleLine lists the schedules the network's checks run on, such as "Checks run on
+// `0 7 * * *`". Networks with clients on their own schedules list each schedule with its clients.
+func buildScheduleLine(alerts []*store.MonitorAlert, network string) string {
+	clients := make(map[string][]string)
+
+	for _, alert := range alerts {
+		if alert.Network == network {
+			schedule := effectiveSchedule(alert)
+			clients[schedule] = append(clients[schedule], alert.Client)
+		}
+	}
+
+	if len(clients) == 0 {
+		return ""
+	}
+
+	schedules := slices.Sorted(maps.Keys(clients))
+
+	if len(schedules) == 1 {
+		return fmt.Sprintf("%s`%s`\n", msgChecksRunOn, schedules[0])
+	}
+
+	parts := make([]string, 0, len(schedules))
+
+	for _, schedule := range schedules {
+		slices.Sort(clients[schedule])
+		parts = append(parts, fmt.Sprintf("`%s` (%s)", schedule, strings.Join(clients[schedule], ", ")))
+	}
+
+	return msgChecksRunOn + strings.Join(parts, ", ") + "\n"
+}
+
+// effectiveSchedule returns the schedule an alert runs on, alerts stored without one run on the default.
+func effectiveSchedule(alert *store.MonitorAlert) string {
+	if alert.Schedule == "" {
+		return DefaultCheckSchedule
+	}
+
+	return alert.Schedule
+}
+
+// nextRun returns when the alert's checks next run, asking the scheduler and falling back to working
+// it out from the schedule before the scheduler has started. Alerts the scheduler has no job for
+// never run, so there's no next run, just why: they're disabled or not scheduled.
+func (c *ChecksCommand) nextRun(alert *store.MonitorAlert) (time.Time, string) {
+	key := c.bot.GetMonitorRepo().Key(alert)
+
+	if !c.bot.GetScheduler().HasJob(key) {
+		if !alert.Enabled {
+			return time.Time{}, msgNextRunDisabled
+		}
+
+		return time.Time{}, msgNextRunNotScheduled
+	}
+
+	if next, err := c.bot.GetScheduler().NextRun(key); err == nil {
+		return next, ""
+	}
+
+	return calculateNextRun(effectiveSchedule(alert)), ""
+}
+
 // calculateNextRun calculates the next run time based on the cron schedule.
 func calculateNextRun(schedule string) time.Time {
 	if schedule == "" {
@@ -235,27 +399,32 @@ func buildClientTable(clients []string, registered map[string]clientInfo) string
 	var msg strings.Builder
 
 	msg.WriteString("```\n")
-	msg.WriteString("┌──────────────┬────────┬────────────────────┐\n")
-	msg.WriteString("│ Client       │ Status │ Next Run           │\n")
-	msg.WriteString("├──────────────┼────────┼────────────────────┤\n")
+	msg.WriteString("┌──────────────┬────────┬─────┬────────────────────┐\n")
+	msg.WriteString("│ Client       │ Status │ Min │ Next Run           │\n")
+	msg.WriteString("├──────────────┼────────┼─────┼────────────────────┤\n")
 
 	for _, client := range clients {
 		info := registered[client]
 		status := "❌"
+		minAffected := "-"
 		nextRun := "N/A"
 
 		if info.registered {
 			status = "✅"
+			minAffected = fmt.Sprint(info.minInstances)
 
-			if !info.nextRun.IsZero() {
+			switch {
+			case info.notScheduled != "":
+				nextRun = info.notScheduled
+			case !info.nextRun.IsZero():
 				nextRun = formatNextRun(info.nextRun)
 			}
 		}
 
-		fmt.Fprintf(&msg, "│ %-12s │   %s   │ %-18s │\n", client, status, nextRun)
+		fmt.Fprintf(&msg, "│ %-12s │   %s   │ %3s │ %-18s │\n", client, status, minAffected, nextRun)
 	}
 
-	msg.WriteString("└──────────────┴────────┴────────────────────┘\n```")
+	msg.WriteString("└──────────────┴────────┴─────┴────────────────────┘\n```")
 
 	return msg.String()
 }
@@ -0,0 +1,132 @@
+package checks
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// notifyOutcome describes what happened to a check run's notification.
+type notifyOutcome string
+
+const (
+	outcomeSent        notifyOutcome = "sent"
+	outcomeNoIssues    notifyOutcome = "no_issues"
+	outcomeNoFailures  notifyOutcome = "no_failures"
+	outcomeInfraOnly   notifyOutcome = "infra_only"
+	outcomeMaintenance notifyOutcome = "maintenance"
+	outcomeCooldown    notifyOutcome = "cooldown"
+	outcomeRateLimited notifyOutcome = "rate_limited"
+	outcomeBelowMin    notifyOutcome = "below_min_instances"
+	outcomeNetworkDown notifyOutcome = "network_down"
+	outcomeMuted       notifyOutcome = "muted"
+	outcomeSnoozed     notifyOutcome = "snoozed"
+	outcomeAcked       notifyOutcome = "acknowledged"
+)
+
+type Metrics struct {
+	notificationsTotal *prometheus.CounterVec
+	suppressedTotal    *prometheus.CounterVec
+	affectedInstances  *prometheus.GaugeVec
+	isRootCause        *prometheus.GaugeVec
+	lastCheckSuccess   *prometheus.GaugeVec
+	minAffected        *prometheus.GaugeVec
+	detectionLatency   *prometheus.HistogramVec
+}
+
+func NewMetrics(namespace string) *Metrics {
+	m := &Metrics{
+		notificationsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "checks",
+			Name:      "notifications_total",
+			Help:      "Total number of check runs by notification outcome, either sent or the reason it was suppressed",
+		}, []string{"network", "client", "outcome"}),
+		suppressedTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "checks",
+			Name:      "alerts_suppressed_total",
+			Help:      "Total number of alerts suppressed by the per-channel rate limit",
+		}, []string{"channel"}),
+		affectedInstances: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "checks",
+			Name:      "affected_instances",
+			Help:      "Number of the client's instances failing checks as of its last check run",
+		}, []string{"network", "client"}),
+		isRootCause: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "checks",
+			Name:      "is_root_cause",
+			Help:      "Whether the client was a root cause of the failures in its last check run (1) or not (0)",
+		}, []string{"network", "client"}),
+		lastCheckSuccess: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "checks",
+			Name:      "last_check_success_timestamp_seconds",
+			Help:      "Unix timestamp of the client's last check run that completed",
+		}, []string{"network", "client"}),
+		minAffected: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "checks",
+			Name:      "min_affected_instances",
+			Help:      "Number of instances that must be failing before the client is notified about",
+		}, []string{"network", "client"}),
+		detectionLatency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "checks",
+			Name:      "detection_latency_seconds",
+			Help:      "Time from the stalest data behind an alert's failing checks to the alert being sent",
+			Buckets:   []float64{30, 60, 120, 300, 600, 900, 1800, 3600},
+		}, []string{"network", "client"}),
+	}
+
+	prometheus.MustRegister(
+		m.notificationsTotal,
+		m.suppressedTotal,
+		m.affectedInstances,
+		m.isRootCause,
+		m.lastCheckSuccess,
+		m.minAffected,
+		m.detectionLatency,
+	)
+
+	return m
+}
+
+// RecordNotification increments the notification outcome counter.
+func (m *Metrics) RecordNotification(network, client, outcome string) {
+	m.notificationsTotal.WithLabelValues(network, client, outcome).Inc()
+}
+
+// RecordSuppressed increments the rate limited alerts counter for a channel.
+func (m *Metrics) RecordSuppressed(channelID string) {
+	m.suppressedTotal.WithLabelValues(channelID).Inc()
+}
+
+// RecordHealth records the outcome of a completed check run for a network/client.
+func (m *Metrics) RecordHealth(network, client string, affectedInstances, minAffected int, rootCause bool) {
+	var isRootCause float64
+	if rootCause {
+		isRootCause = 1
+	}
+
+	m.affectedInstances.WithLabelValues(network, client).Set(float64(affectedInstances))
+	m.isRootCause.WithLabelValues(network, client).Set(isRootCause)
+	m.lastCheckSuccess.WithLabelValues(network, client).Set(float64(time.Now().Unix()))
+	m.minAffected.WithLabelValues(network, client).Set(float64(minAffected))
+}
+
+// RecordDetectionLatency records how long after its data was sampled an alert was sent.
+func (m *Metrics) RecordDetectionLatency(network, client string, latency time.Duration) {
+	m.detectionLatency.WithLabelValues(network, client).Observe(latency.Seconds())
+}
+
+// DeleteHealth removes the health series for a network/client that is no longer monitored.
+func (m *Metrics) DeleteHealth(network, client string) {
+	m.affectedInstances.DeleteLabelValues(network, client)
+	m.isRootCause.DeleteLabelValues(network, client)
+	m.lastCheckSuccess.DeleteLabelValues(network, client)
+	m.minAffected.DeleteLabelValues(network, client)
+	m.detectionLatency.DeleteLabelValues(network, client)
+}
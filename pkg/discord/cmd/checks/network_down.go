@@ -0,0 +1,90 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethpandaops/panda-pulse/pkg/checks"
+	"github.com/ethpandaops/panda-pulse/pkg/store"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	msgNetworkDown = "🚨 **%s** looks to be down network-wide, only **%d** of %d nodes are synced. " +
+		"Per-client alerts are paused until at least %d are synced again, this is more likely a " +
+		"network-wide event (genesis or spec issue) than a problem with any one client"
+	msgNetworkRecovered = "✅ **%s** has recovered, %d of %d nodes are synced. Per-client alerts have resumed"
+)
+
+// isNetworkDown checks the health of the alert's network as a whole, returning true if it's down.
+// The first alert for each channel to find it down posts a single network-wide notification,
+// later ones are suppressed until the network recovers. Failed lookups are logged and treated as
+// the network being up, per-client alerts are better than none.
+func (c *ChecksCommand) isNetworkDown(ctx context.Context, alert *store.MonitorAlert) bool {
+	if c.config.NetworkMinHealthyNodes < 0 {
+		return false
+	}
+
+	log := c.log.WithFields(logrus.Fields{
+		"network": alert.Network,
+		"client":  alert.Client,
+	})
+
+	health, err := checks.QueryNetworkHealth(ctx, c.bot.GetGrafana(), alert.Network)
+	if err != nil {
+		log.WithError(err).Warn("Failed to query network health, running per-client checks")
+
+		return false
+	}
+
+	key := fmt.Sprintf("%s/%s", alert.Network, alert.DiscordChannel)
+
+	if !health.IsDown(c.config.NetworkMinHealthyNodes) {
+		c.networksDownMu.Lock()
+		notified := c.networksDown[key]
+		delete(c.networksDown, key)
+		c.networksDownMu.Unlock()
+
+		if notified {
+			c.sendNetworkMessage(alert, alert.GetRecoveryChannel(), fmt.Sprintf(
+				msgNetworkRecovered, alert.Network, health.Healthy, health.Nodes,
+			))
+		}
+
+		return false
+	}
+
+	log.WithFields(logrus.Fields{
+		"nodes":   health.Nodes,
+		"healthy": health.Healthy,
+		"minimum": c.config.NetworkMinHealthyNodes,
+	}).Warn("Network is down, skipped per-client checks")
+
+	// Notifications are paused, so leave the channel to be notified once they resume.
+	if c.bot.IsMaintenance() || c.config.DiscordDisabled {
+		return true
+	}
+
+	c.networksDownMu.Lock()
+	notified := c.networksDown[key]
+	c.networksDown[key] = true
+	c.networksDownMu.Unlock()
+
+	if !notified {
+		c.sendNetworkMessage(alert, alert.DiscordChannel, fmt.Sprintf(
+			msgNetworkDown, alert.Network, health.Healthy, health.Nodes, c.config.NetworkMinHealthyNodes,
+		))
+	}
+
+	return true
+}
+
+// sendNetworkMessage posts a network-wide notification to one of the alert's channels.
+func (c *ChecksCommand) sendNetworkMessage(alert *store.MonitorAlert, channelID, msg string) {
+	if _, err := c.bot.GetSession().ChannelMessageSend(channelID, msg); err != nil {
+		c.log.WithError(err).WithFields(logrus.Fields{
+			"network": alert.Network,
+			"channel": channelID,
+		}).Error("Failed to send network notification")
+	}
+}
@@ -0,0 +1,308 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/ethpandaops/panda-pulse/pkg/checks"
+	"github.com/ethpandaops/panda-pulse/pkg/clients"
+	"github.com/ethpandaops/panda-pulse/pkg/store"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// overviewConcurrency bounds how many clients are checked at once for an overview, so a large
+	// network doesn't flood Grafana.
+	overviewConcurrency = 4
+	// overviewMaxColumns is the most clients shown in a single overview embed, past which the matrix
+	// is split across several so rows stay readable.
+	overviewMaxColumns = 8
+	// maxEmbedsPerMessage is the most embeds Discord allows on a message.
+	maxEmbedsPerMessage = 10
+	overviewEmbedColor  = 0x3498DB
+
+	overviewCellPass  = "✓"
+	overviewCellFail  = "✗"
+	overviewCellError = "!"
+	overviewCellNone  = "·"
+
+	msgOverviewRunning   = "🔄 Running the checks for %d clients on **%s**..."
+	msgOverviewDone      = "Checked %d clients on **%s**, nothing was notified"
+	msgOverviewNoClients = "ℹ️ No clients are registered on **%s** in this server"
+	msgOverviewTitle     = "📋 Checks overview for %s"
+	msgOverviewTitlePart = "📋 Checks overview for %s (%d/%d)"
+	msgOverviewLegend    = "`✓` passed, `✗` failing, `!` errored, `·` not run"
+	msgOverviewFailed    = "⚠️ Couldn't check: %s"
+)
+
+// getOverviewCommandDefinition returns the '/checks overview' subcommand definition.
+func (c *ChecksCommand) getOverviewCommandDefinition() *discordgo.ApplicationCommandOption {
+	return &discordgo.ApplicationCommandOption{
+		Name:        "overview",
+		Description: "Run the checks for every client registered on a network, and show them side by side",
+		Type:        discordgo.ApplicationCommandOptionSubCommand,
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Name:         "network",
+				Description:  "Network to show the overview of",
+				Type:         discordgo.ApplicationCommandOptionString,
+				Required:     true,
+				Autocomplete: true,
+			},
+		},
+	}
+}
+
+// overviewRun is the outcome of checking a single client for an overview.
+type overviewRun struct {
+	client  string
+	results []*checks.Result
+	err     error
+}
+
+// handleOverview handles the '/checks overview' command. Each client registered on the network in
+// the server is checked, as '/checks run' would, but nothing is notified, the results are only
+// shown as a matrix of checks against clients.
+func (c *ChecksCommand) handleOverview(
+	s *discordgo.Session,
+	i *discordgo.InteractionCreate,
+	data *discordgo.ApplicationCommandInteractionDataOption,
+) error {
+	var (
+		ctx     = context.Background()
+		network string
+	)
+
+	for _, opt := range data.Options {
+		if opt.Name == "network" {
+			network = opt.StringValue()
+		}
+	}
+
+	alerts, err := c.listAlerts(ctx, i.GuildID, &network)
+	if err != nil {
+		return err
+	}
+
+	clientNames := make([]string, 0, len(alerts))
+
+	for _, alert := range alerts {
+		if alert.ClientType == clients.ClientTypeAll || slices.Contains(clientNames, alert.Client) {
+			continue
+		}
+
+		clientNames = append(clientNames, alert.Client)
+	}
+
+	if len(clientNames) == 0 {
+		return respondEphemeral(s, i, fmt.Sprintf(msgOverviewNoClients, network))
+	}
+
+	slices.Sort(clientNames)
+
+	if err := respondEphemeral(s, i, fmt.Sprintf(msgOverviewRunning, len(clientNames), network)); err != nil {
+		return fmt.Errorf("failed to send initial response: %w", err)
+	}
+
+	runs := c.runOverview(ctx, network, clientNames)
+	embeds := buildOverviewEmbeds(network, runs)
+
+	// The first batch replaces the initial response, any others follow it.
+	for idx, batch := range slices.Collect(slices.Chunk(embeds, maxEmbedsPerMessage)) {
+		if idx == 0 {
+			if _, err := s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+				Content: stringPtr(fmt.Sprintf(msgOverviewDone, len(clientNames), network)),
+				Embeds:  &batch,
+			}); err != nil {
+				c.log.WithError(err).Error("Failed to edit initial response")
+			}
+
+			continue
+		}
+
+		if _, err := s.FollowupMessageCreate(i.Interaction, true, &discordgo.WebhookParams{
+			Embeds: batch,
+			Flags:  discordgo.MessageFlagsEphemeral,
+		}); err != nil {
+			c.log.WithError(err).Error("Failed to send overview")
+		}
+	}
+
+	return nil
+}
+
+// runOverview checks each client on the network, at most overviewConcurrency at once, returning
+// the outcomes in the order of clientNames.
+func (c *ChecksCommand) runOverview(ctx context.Context, network string, clientNames []string) []overviewRun {
+	var (
+		runs  = make([]overviewRun, len(clientNames))
+		slots = make(chan struct{}, overviewConcurrency)
+		wg    sync.WaitGroup
+	)
+
+	for idx, client := range clientNames {
+		wg.Go(func() {
+			slots <- struct{}{}
+			defer func() { <-slots }()
+
+			results, err := c.evaluateClient(ctx, &store.MonitorAlert{Network: network, Client: client})
+			if err != nil {
+				c.log.WithFields(logrus.Fields{
+					"network": network,
+					"client":  client,
+				}).WithError(err).Warn("Failed to check client for overview")
+			}
+
+			runs[idx] = overviewRun{client: client, results: results, err: err}
+		})
+	}
+
+	wg.Wait()
+
+	return runs
+}
+
+// evaluateClient runs the checks for the alert's client and persists the results, without sending
+// or tracking anything off the back of them.
+func (c *ChecksCommand) evaluateClient(ctx context.Context, alert *store.MonitorAlert) ([]*checks.Result, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.config.RunTimeout)
+	defer cancel()
+
+	runner, err := c.setupRunner(ctx, alert)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := runner.RunChecks(ctx); err != nil {
+		return nil, fmt.Errorf("failed to run checks: %w", err)
+	}
+
+	if err := c.persistCheckResults(ctx, alert, runner); err != nil {
+		c.log.WithError(err).Error("Failed to persist overview check results")
+	}
+
+	return runner.GetResults(), nil
+}
+
+// buildOverviewEmbeds renders the runs as a matrix of checks against clients, split across embeds
+// of at most overviewMaxColumns clients. Clients that couldn't be checked are listed underneath
+// rather than given a column.
+func buildOverviewEmbeds(network string, runs []overviewRun) []*discordgo.MessageEmbed {
+	var (
+		checked = make([]overviewRun, 0, len(runs))
+		failed  = make([]string, 0)
+	)
+
+	for _, run := range runs {
+		if run.err != nil {
+			failed = append(failed, run.client)
+
+			continue
+		}
+
+		checked = append(checked, run)
+	}
+
+	var (
+		chunks = slices.Collect(slices.Chunk(checked, overviewMaxColumns))
+		embeds = make([]*discordgo.MessageEmbed, 0, len(chunks))
+	)
+
+	for idx, chunk := range chunks {
+		title := fmt.Sprintf(msgOverviewTitle, network)
+		if len(chunks) > 1 {
+			title = fmt.Sprintf(msgOverviewTitlePart, network, idx+1, len(chunks))
+		}
+
+		embeds = append(embeds, &discordgo.MessageEmbed{
+			Title:       title,
+			Description: renderOverviewMatrix(chunk) + "\n" + msgOverviewLegend,
+			Color:       overviewEmbedColor,
+		})
+	}
+
+	if len(failed) > 0 {
+		msg := fmt.Sprintf(msgOverviewFailed, strings.Join(failed, ", "))
+
+		if len(embeds) == 0 {
+			embeds = append(embeds, &discordgo.MessageEmbed{
+				Title: fmt.Sprintf(msgOverviewTitle, network),
+				Color: overviewEmbedColor,
+			})
+		}
+
+		last := embeds[len(embeds)-1]
+		last.Description = strings.TrimPrefix(last.Description+"\n"+msg, "\n")
+	}
+
+	return embeds
+}
+
+// renderOverviewMatrix renders a code block with a row per check and a numbered column per client,
+// with the clients' names keyed underneath so the columns stay narrow.
+func renderOverviewMatrix(runs []overviewRun) string {
+	var (
+		names = make([]string, 0)
+		cells = make(map[string][]string)
+		width = 0
+	)
+
+	for col, run := range runs {
+		for _, result := range run.results {
+			if _, ok := cells[result.Name]; !ok {
+				names = append(names, result.Name)
+				cells[result.Name] = slices.Repeat([]string{overviewCellNone}, len(runs))
+				width = max(width, len(result.Name))
+			}
+
+			cells[result.Name][col] = overviewCell(result.Status)
+		}
+	}
+
+	slices.Sort(names)
+
+	var sb strings.Builder
+
+	sb.WriteString("```\n")
+	fmt.Fprintf(&sb, "%-*s", width, "")
+
+	for col := range runs {
+		fmt.Fprintf(&sb, " %2d", col+1)
+	}
+
+	sb.WriteString("\n")
+
+	for _, name := range names {
+		fmt.Fprintf(&sb, "%-*s", width, name)
+
+		for _, cell := range cells[name] {
+			fmt.Fprintf(&sb, "  %s", cell)
+		}
+
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString("```\n")
+
+	for col, run := range runs {
+		fmt.Fprintf(&sb, "`%d` %s  ", col+1, run.client)
+	}
+
+	return strings.TrimSpace(sb.String())
+}
+
+// overviewCell returns the matrix cell for a check's status.
+func overviewCell(status checks.Status) string {
+	switch status {
+	case checks.StatusOK:
+		return overviewCellPass
+	case checks.StatusFail:
+		return overviewCellFail
+	default:
+		return overviewCellError
+	}
+}
@@ -0,0 +1,84 @@
+package checks
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+const (
+	// PresetProductionCL is the built-in preset of production consensus clients.
+	PresetProductionCL = "production-cl"
+	// PresetProductionEL is the built-in preset of production execution clients.
+	PresetProductionEL = "production-el"
+	// PresetAllProduction is the built-in preset of all production clients.
+	PresetAllProduction = "all-production"
+
+	maxChoices = 25 // Discord caps the choices an option can have.
+)
+
+// ParseClientPresets parses a JSON object of preset name to the clients it registers, such as
+// {"core-cl": ["lighthouse", "prysm"]}. An empty value has no presets.
+func ParseClientPresets(jsonValue string) (map[string][]string, error) {
+	presets := make(map[string][]string)
+
+	if strings.TrimSpace(jsonValue) == "" {
+		return presets, nil
+	}
+
+	if err := json.Unmarshal([]byte(jsonValue), &presets); err != nil {
+		return nil, fmt.Errorf("invalid JSON: %w", err)
+	}
+
+	for name, clients := range presets {
+		if name == "" || name != strings.ToLower(name) || strings.ContainsAny(name, " \t") {
+			return nil, fmt.Errorf("invalid preset name %q, expected a lowercase name without spaces", name)
+		}
+
+		if len(clients) == 0 {
+			return nil, fmt.Errorf("preset %q has no clients", name)
+		}
+	}
+
+	return presets, nil
+}
+
+// clientPresets returns every preset, the built-in production presets built from cartographoor
+// overridden by any configured ones of the same name.
+func (c *ChecksCommand) clientPresets() map[string][]string {
+	var (
+		carto      = c.bot.GetCartographoor()
+		production = func(clients []string) []string {
+			return slices.DeleteFunc(slices.Clone(clients), carto.IsPreProductionClient)
+		}
+		presets = map[string][]string{
+			PresetProductionCL: production(carto.GetCLClients()),
+			PresetProductionEL: production(carto.GetELClients()),
+		}
+	)
+
+	presets[PresetAllProduction] = slices.Concat(presets[PresetProductionCL], presets[PresetProductionEL])
+
+	maps.Copy(presets, c.config.ClientPresets)
+
+	return presets
+}
+
+// getPresetChoices returns the preset choices, capped at the number of choices Discord allows.
+func (c *ChecksCommand) getPresetChoices() []*discordgo.ApplicationCommandOptionChoice {
+	names := slices.Sorted(maps.Keys(c.clientPresets()))
+	choices := make([]*discordgo.ApplicationCommandOptionChoice, 0, len(names))
+
+	for _, name := range names[:min(len(names), maxChoices)] {
+		choices = append(choices, &discordgo.ApplicationCommandOptionChoice{
+			Name:  name,
+			Value: name,
+		})
+	}
+
+	return choices
+}
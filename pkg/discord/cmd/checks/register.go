@@ -2,21 +2,30 @@ package checks
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/bwmarrin/discordgo"
 	"github.com/ethpandaops/panda-pulse/pkg/clients"
+	"github.com/ethpandaops/panda-pulse/pkg/discord/cmd/common"
 	"github.com/ethpandaops/panda-pulse/pkg/store"
-	"github.com/robfig/cron/v3"
 	"github.com/sirupsen/logrus"
 )
 
 const (
-	msgAlreadyRegistered = "ℹ️ Client **%s** is already registered for **%s** in <#%s>"
-	msgRegisteredClient  = "✅ Successfully registered **%s** for **%s** notifications in <#%s>"
-	msgRegisteredAll     = "✅ Successfully registered **all clients** for **%s** notifications in <#%s>"
+	msgAlreadyRegistered    = "ℹ️ Client **%s** is already registered for **%s** in <#%s>"
+	msgRegisteredClient     = "✅ Successfully registered **%s** for **%s** notifications in <#%s>"
+	msgRegisteredAll        = "✅ Successfully registered **all clients** for **%s** notifications in <#%s>"
+	msgRegisteredPreset     = "✅ Successfully registered the **%s** preset for **%s** notifications in <#%s>, it expanded to: %s"
+	msgPresetSkipped        = "\nℹ️ Already registered, so skipped: %s"
+	msgPresetAndClient      = "🚫 Choose either a client or a preset, not both"
+	msgUnknownPreset        = "🚫 Unknown preset **%s**"
+	msgPresetUnknownClients = "🚫 The **%s** preset has clients that aren't known, nothing was registered: %s"
+	msgNotOnNetwork         = "\n⚠️ Cartographoor doesn't list %s as running on **%s**, so the checks may never find any instances"
+	msgNotOnNetworkRejected = "🚫 Cartographoor doesn't list %s as running on **%s**, nothing was registered. It runs: %s"
 )
 
 // handleRegister handles the '/checks register' command.
@@ -26,54 +35,77 @@ func (c *ChecksCommand) handleRegister(
 	data *discordgo.ApplicationCommandInteractionDataOption,
 ) error {
 	var (
-		options  = data.Options
-		network  = options[0].StringValue()
-		channel  = options[1].ChannelValue(s)
-		client   *string
-		guildID  = i.GuildID // Get the guild ID from the interaction
-		schedule = DefaultCheckSchedule
+		options      = data.Options
+		network      = common.FindOption(options, "network").StringValue()
+		channel      = common.FindOption(options, "channel").ChannelValue(s)
+		client       *string
+		guildID      = i.GuildID // Get the guild ID from the interaction
+		schedule     string
+		scheduleSet  bool
+		clientScheds map[string]string
+		preset       string
+		minInstances int
+		noScreenshot bool
+		mentionTeam  bool
+		script       bool
+		compact      bool
+		runNow       bool
+		recoveryID   string
 	)
 
-	// Check if it's a text channel.
-	if channel.Type != discordgo.ChannelTypeGuildText {
+	if msg := validateAlertChannel(s, channel); msg != "" {
 		return s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 			Type: discordgo.InteractionResponseChannelMessageWithSource,
 			Data: &discordgo.InteractionResponseData{
-				Content: "🚫 Alerts can only be registered in text channels",
+				Content: msg,
 				Flags:   discordgo.MessageFlagsEphemeral,
 			},
 		})
 	}
 
-	// Check if channel is in the 'bots' category.
-	if parentChannel, err := s.Channel(channel.ParentID); err == nil {
-		if !strings.EqualFold(parentChannel.Name, "bots") && !strings.EqualFold(parentChannel.Name, "monitoring") {
-			return s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
-				Type: discordgo.InteractionResponseChannelMessageWithSource,
-				Data: &discordgo.InteractionResponseData{
-					Content: "🚫 Alerts can only be registered in channels under the `bots` or `monitoring` category",
-					Flags:   discordgo.MessageFlagsEphemeral,
-				},
-			})
-		}
-	}
-
 	for _, opt := range options {
-		if opt.Name == "client" {
+		switch opt.Name {
+		case "client":
 			c := opt.StringValue()
 			client = &c
+		case "min-instances":
+			minInstances = int(opt.IntValue())
+		case "preset":
+			preset = opt.StringValue()
+		case "hive-screenshot":
+			noScreenshot = !opt.BoolValue()
+		case "mention-team":
+			mentionTeam = opt.BoolValue()
+		case "remediation-script":
+			script = opt.BoolValue()
+		case "compact":
+			compact = opt.BoolValue()
+		case "run-now":
+			runNow = opt.BoolValue()
+		case "recovery-channel":
+			recoveryID = opt.ChannelValue(s).ID
+		case "client-schedules":
+			parsed, err := parseClientSchedules(opt.StringValue())
+			if err != nil {
+				return respondEphemeral(s, i, fmt.Sprintf(msgInvalidClientSchedules, err))
+			}
 
-			break
+			clientScheds = parsed
 		}
 	}
 
+	if preset != "" && client != nil {
+		return respondEphemeral(s, i, msgPresetAndClient)
+	}
+
 	// Get schedule if provided, and ensure its valid.
 	for _, opt := range options {
 		if opt.Name == "schedule" {
 			schedule = opt.StringValue()
+			scheduleSet = true
 
 			// Validate the cron schedule
-			if _, err := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow).Parse(schedule); err != nil {
+			if _, err := cronParser.Parse(schedule); err != nil {
 				return s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 					Type: discordgo.InteractionResponseChannelMessageWithSource,
 					Data: &discordgo.InteractionResponseData{
@@ -87,8 +119,61 @@ func (c *ChecksCommand) handleRegister(
 		}
 	}
 
-	if err := c.registerAlert(context.Background(), network, channel.ID, guildID, client, schedule); err != nil {
+	if schedule == "" {
+		schedule = c.defaultSchedule(context.Background(), guildID)
+	}
+
+	for client := range clientScheds {
+		if c.bot.GetCartographoor().GetClientType(client) == string(clients.ClientTypeAll) {
+			return respondEphemeral(s, i, fmt.Sprintf(msgInvalidClientSchedules, fmt.Errorf("unknown client: %s", client)))
+		}
+	}
+
+	settings := alertSettings{
+		schedule:              schedule,
+		scheduleSet:           scheduleSet,
+		clientSchedules:       clientScheds,
+		minInstances:          minInstances,
+		disableHiveScreenshot: noScreenshot,
+		mentionTeam:           mentionTeam,
+		remediationScript:     script,
+		compact:               compact,
+		recoveryChannel:       recoveryID,
+		registeredBy:          interactionUserID(i),
+	}
+
+	if preset != "" {
+		return c.handleRegisterPreset(s, i, network, channel.ID, preset, settings, runNow)
+	}
+
+	var offNetwork []string
+
+	if client != nil {
+		if unused := settings.unusedClientSchedules([]string{*client}); len(unused) > 0 {
+			return respondEphemeral(s, i, fmt.Sprintf(msgClientSchedulesUnused, formatClientList(unused)))
+		}
+
+		offNetwork = c.clientsNotOnNetwork(network, []string{*client})
+
+		if len(offNetwork) > 0 && c.config.EnforceNetworkClients {
+			return respondEphemeral(s, i, c.notOnNetworkRejection(network, offNetwork))
+		}
+	}
+
+	if err := c.registerAlert(context.Background(), network, channel.ID, guildID, client, settings); err != nil {
 		if alreadyRegistered, ok := err.(*store.AlertAlreadyRegisteredError); ok {
+			// Re-registering with a schedule moves the existing alert onto it.
+			if schedule, explicit := settings.scheduleFor(alreadyRegistered.Client); explicit {
+				rescheduled, rescheduleErr := c.rescheduleAlert(context.Background(), network, channel.ID, guildID, alreadyRegistered.Client, schedule)
+				if rescheduleErr != nil {
+					return fmt.Errorf("failed to reschedule alert: %w", rescheduleErr)
+				}
+
+				if rescheduled {
+					return respondEphemeral(s, i, fmt.Sprintf(msgRescheduledClient, alreadyRegistered.Client, network, channel.ID, schedule))
+				}
+			}
+
 			return s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 				Type: discordgo.InteractionResponseChannelMessageWithSource,
 				Data: &discordgo.InteractionResponseData{
@@ -101,26 +186,273 @@ func (c *ChecksCommand) handleRegister(
 		return fmt.Errorf("failed to register alert: %w", err)
 	}
 
-	var msg string
+	var (
+		msg           string
+		canaryClients []string
+	)
 
 	if client != nil {
 		msg = fmt.Sprintf(msgRegisteredClient, *client, network, channel.ID)
+		canaryClients = []string{*client}
 	} else {
 		msg = fmt.Sprintf(msgRegisteredAll, network, channel.ID)
 	}
 
-	return s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+	if len(offNetwork) > 0 {
+		msg += fmt.Sprintf(msgNotOnNetwork, formatClientList(offNetwork), network)
+	}
+
+	if runNow {
+		msg += msgCanaryStarted
+	}
+
+	if err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
 		Type: discordgo.InteractionResponseChannelMessageWithSource,
 		Data: &discordgo.InteractionResponseData{
 			Content: msg,
 			Flags:   discordgo.MessageFlagsEphemeral,
 		},
-	})
+	}); err != nil {
+		return err
+	}
+
+	if runNow {
+		go c.canaryRun(s, i, network, channel.ID, canaryClients)
+	}
+
+	return nil
+}
+
+// handleRegisterPreset registers each of the preset's clients, reporting which clients it expanded
+// to and which were already registered.
+func (c *ChecksCommand) handleRegisterPreset(
+	s *discordgo.Session,
+	i *discordgo.InteractionCreate,
+	network, channelID, preset string,
+	settings alertSettings,
+	runNow bool,
+) error {
+	presetClients, ok := c.clientPresets()[preset]
+	if !ok {
+		return respondEphemeral(s, i, fmt.Sprintf(msgUnknownPreset, preset))
+	}
+
+	// Check every client up front, rather than leave the preset half registered.
+	var unknown []string
+
+	for _, client := range presetClients {
+		if c.bot.GetCartographoor().GetClientType(client) == string(clients.ClientTypeAll) {
+			unknown = append(unknown, client)
+		}
+	}
+
+	if len(unknown) > 0 {
+		return respondEphemeral(s, i, fmt.Sprintf(msgPresetUnknownClients, preset, formatClientList(unknown)))
+	}
+
+	if unused := settings.unusedClientSchedules(presetClients); len(unused) > 0 {
+		return respondEphemeral(s, i, fmt.Sprintf(msgClientSchedulesUnused, formatClientList(unused)))
+	}
+
+	offNetwork := c.clientsNotOnNetwork(network, presetClients)
+	if len(offNetwork) > 0 && c.config.EnforceNetworkClients {
+		return respondEphemeral(s, i, c.notOnNetworkRejection(network, offNetwork))
+	}
+
+	var registered, rescheduled, skipped []string
+
+	for _, client := range presetClients {
+		err := c.registerAlert(context.Background(), network, channelID, i.GuildID, &client, settings)
+
+		var alreadyRegistered *store.AlertAlreadyRegisteredError
+
+		switch {
+		case err == nil:
+			registered = append(registered, client)
+		case errors.As(err, &alreadyRegistered):
+			schedule, explicit := settings.scheduleFor(client)
+			if !explicit {
+				skipped = append(skipped, client)
+
+				continue
+			}
+
+			updated, rescheduleErr := c.rescheduleAlert(context.Background(), network, channelID, i.GuildID, client, schedule)
+			if rescheduleErr != nil {
+				return fmt.Errorf("failed to reschedule %s from preset %s: %w", client, preset, rescheduleErr)
+			}
+
+			if updated {
+				rescheduled = append(rescheduled, client)
+			} else {
+				skipped = append(skipped, client)
+			}
+		default:
+			return fmt.Errorf("failed to register %s from preset %s: %w", client, preset, err)
+		}
+	}
+
+	c.log.WithFields(logrus.Fields{
+		"network":     network,
+		"preset":      preset,
+		"registered":  registered,
+		"rescheduled": rescheduled,
+		"skipped":     skipped,
+	}).Info("Registered client preset")
+
+	msg := fmt.Sprintf(msgRegisteredPreset, preset, network, channelID, formatClientList(registered))
+	if len(rescheduled) > 0 {
+		msg += fmt.Sprintf(msgPresetRescheduled, formatClientList(rescheduled))
+	}
+
+	if len(skipped) > 0 {
+		msg += fmt.Sprintf(msgPresetSkipped, formatClientList(skipped))
+	}
+
+	if len(offNetwork) > 0 {
+		msg += fmt.Sprintf(msgNotOnNetwork, formatClientList(offNetwork), network)
+	}
+
+	// Only the newly registered clients are run, the others are already monitored.
+	runNow = runNow && len(registered) > 0
+	if runNow {
+		msg += msgCanaryStarted
+	}
+
+	if err := respondEphemeral(s, i, msg); err != nil {
+		return err
+	}
+
+	if runNow {
+		go c.canaryRun(s, i, network, channelID, registered)
+	}
+
+	return nil
+}
+
+// clientsNotOnNetwork returns the clients cartographoor doesn't list as running on the network.
+// Cartographoor only knows which clients devnets run, so nothing is returned for other networks.
+func (c *ChecksCommand) clientsNotOnNetwork(network string, clients []string) []string {
+	networkClients := c.bot.GetCartographoor().GetNetworkClients(network)
+	if len(networkClients) == 0 {
+		return nil
+	}
+
+	var missing []string
+
+	for _, client := range clients {
+		if !slices.Contains(networkClients, client) {
+			missing = append(missing, client)
+		}
+	}
+
+	return missing
+}
+
+// notOnNetworkRejection explains why clients weren't registered on a network that doesn't run them.
+func (c *ChecksCommand) notOnNetworkRejection(network string, clients []string) string {
+	return fmt.Sprintf(
+		msgNotOnNetworkRejected,
+		formatClientList(clients),
+		network,
+		formatClientList(c.bot.GetCartographoor().GetNetworkClients(network)),
+	)
 }
 
-func (c *ChecksCommand) registerAlert(ctx context.Context, network, channelID, guildID string, specificClient *string, schedule string) error {
+// formatClientList formats clients as a comma-separated list.
+func formatClientList(clients []string) string {
+	if len(clients) == 0 {
+		return "none"
+	}
+
+	return "`" + strings.Join(clients, "`, `") + "`"
+}
+
+// validateAlertChannel returns a message explaining why alerts can't be registered in the channel,
+// or an empty string if they can.
+func validateAlertChannel(s *discordgo.Session, channel *discordgo.Channel) string {
+	// Check if it's a text channel.
+	if channel.Type != discordgo.ChannelTypeGuildText {
+		return "🚫 Alerts can only be registered in text channels"
+	}
+
+	// Check if channel is in the 'bots' category.
+	if parentChannel, err := s.Channel(channel.ParentID); err == nil {
+		if !strings.EqualFold(parentChannel.Name, "bots") && !strings.EqualFold(parentChannel.Name, "monitoring") {
+			return "🚫 Alerts can only be registered in channels under the `bots` or `monitoring` category"
+		}
+	}
+
+	return ""
+}
+
+// defaultSchedule returns the schedule for checks registered without one, preferring the guild's
+// override over DefaultCheckSchedule.
+func (c *ChecksCommand) defaultSchedule(ctx context.Context, guildID string) string {
+	overrides := common.LoadGuildOverrides(ctx, c.log, c.bot.GetGuildConfigRepo(), guildID)
+
+	return overrides.Get(common.GuildConfigChecksSchedule, DefaultCheckSchedule)
+}
+
+// alertSettings are the optional settings an alert is registered with.
+type alertSettings struct {
+	schedule              string
+	scheduleSet           bool              // Whether the schedule was chosen, rather than defaulted.
+	clientSchedules       map[string]string // Schedules for specific clients, overriding schedule.
+	minInstances          int
+	disableHiveScreenshot bool
+	mentionTeam           bool
+	remediationScript     bool
+	compact               bool
+	recoveryChannel       string
+	registeredBy          string
+}
+
+// apply applies the settings to an alert.
+func (s alertSettings) apply(alert *store.MonitorAlert) {
+	alert.Schedule, _ = s.scheduleFor(alert.Client)
+	alert.MinAffectedInstances = s.minInstances
+	alert.DisableHiveScreenshot = s.disableHiveScreenshot
+	alert.MentionTeam = s.mentionTeam
+	alert.RemediationScript = s.remediationScript
+	alert.Compact = s.compact
+	alert.RecoveryChannel = s.recoveryChannel
+	alert.RegisteredBy = s.registeredBy
+}
+
+// scheduleFor returns the schedule a client's alert runs on, and whether it was chosen rather than
+// defaulted.
+func (s alertSettings) scheduleFor(client string) (string, bool) {
+	if schedule, ok := s.clientSchedules[client]; ok {
+		return schedule, true
+	}
+
+	return s.schedule, s.scheduleSet
+}
+
+// unusedClientSchedules returns the clients given a schedule that aren't among those being registered.
+func (s alertSettings) unusedClientSchedules(registering []string) []string {
+	var unused []string
+
+	for client := range s.clientSchedules {
+		if !slices.Contains(registering, client) {
+			unused = append(unused, client)
+		}
+	}
+
+	slices.Sort(unused)
+
+	return unused
+}
+
+func (c *ChecksCommand) registerAlert(
+	ctx context.Context,
+	network, channelID, guildID string,
+	specificClient *string,
+	settings alertSettings,
+) error {
 	if specificClient == nil {
-		return c.registerAllClients(ctx, network, channelID, guildID, schedule)
+		return c.registerAllClients(ctx, network, channelID, guildID, settings)
 	}
 
 	// Check if this specific client is already registered.
@@ -146,7 +478,7 @@ func (c *ChecksCommand) registerAlert(ctx context.Context, network, channelID, g
 	}
 
 	alert := newMonitorAlert(network, *specificClient, clients.ClientType(clientType), channelID, guildID)
-	alert.Schedule = schedule
+	settings.apply(alert)
 
 	if err := c.scheduleAlert(ctx, alert); err != nil {
 		return fmt.Errorf("failed to schedule alert: %w", err)
@@ -156,11 +488,11 @@ func (c *ChecksCommand) registerAlert(ctx context.Context, network, channelID, g
 }
 
 // registerAllClients registers a monitor alert for all clients for a given network.
-func (c *ChecksCommand) registerAllClients(ctx context.Context, network, channelID, guildID string, schedule string) error {
+func (c *ChecksCommand) registerAllClients(ctx context.Context, network, channelID, guildID string, settings alertSettings) error {
 	// Register CL clients.
 	for _, client := range c.bot.GetCartographoor().GetCLClients() {
 		alert := newMonitorAlert(network, client, clients.ClientTypeCL, channelID, guildID)
-		alert.Schedule = schedule
+		settings.apply(alert)
 
 		if err := c.scheduleAlert(ctx, alert); err != nil {
 			return fmt.Errorf("failed to schedule CL alert: %w", err)
@@ -170,7 +502,7 @@ func (c *ChecksCommand) registerAllClients(ctx context.Context, network, channel
 	// Register EL clients.
 	for _, client := range c.bot.GetCartographoor().GetELClients() {
 		alert := newMonitorAlert(network, client, clients.ClientTypeEL, channelID, guildID)
-		alert.Schedule = schedule
+		settings.apply(alert)
 
 		if err := c.scheduleAlert(ctx, alert); err != nil {
 			return fmt.Errorf("failed to schedule EL alert: %w", err)
@@ -190,8 +522,9 @@ func (c *ChecksCommand) scheduleAlert(ctx context.Context, alert *store.MonitorA
 	jobName := c.bot.GetMonitorRepo().Key(alert)
 
 	c.log.WithFields(logrus.Fields{
-		"channel": alert.DiscordChannel,
-		"client":  alert.Client,
+		"channel":      alert.DiscordChannel,
+		"client":       alert.Client,
+		"registeredBy": alert.RegisteredBy,
 	}).Info("Registered alert")
 
 	// And secondly, schedule the alert to run on our schedule.
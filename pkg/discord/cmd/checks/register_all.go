@@ -0,0 +1,167 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/ethpandaops/panda-pulse/pkg/discord/cmd/common"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	msgNoActiveNetworks      = "ℹ️ No active networks found"
+	msgRegisterAllPreview    = "⚠️ This will register health checks for **all clients** on **%d** active networks in <#%s>:\n%s\n\nRe-run with `confirm: True` to proceed"
+	msgRegisterAllHeader     = "🧾 Registered health checks for all active networks in <#%s>"
+	msgRegisterAllSkipReason = "already registered"
+)
+
+// getRegisterAllCommandDefinition returns the '/checks register-all-networks' subcommand definition.
+func (c *ChecksCommand) getRegisterAllCommandDefinition() *discordgo.ApplicationCommandOption {
+	return &discordgo.ApplicationCommandOption{
+		Name:        "register-all-networks",
+		Description: "Register health checks for all clients on every active network (admin)",
+		Type:        discordgo.ApplicationCommandOptionSubCommand,
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Name:        "channel",
+				Description: "Channel to send alerts to",
+				Type:        discordgo.ApplicationCommandOptionChannel,
+				Required:    true,
+				ChannelTypes: []discordgo.ChannelType{
+					discordgo.ChannelTypeGuildText,
+				},
+			},
+			{
+				Name:        "confirm",
+				Description: "Confirm registering every network, omit to preview what would be registered",
+				Type:        discordgo.ApplicationCommandOptionBoolean,
+				Required:    false,
+			},
+		},
+	}
+}
+
+// handleRegisterAllNetworks handles the '/checks register-all-networks' command.
+func (c *ChecksCommand) handleRegisterAllNetworks(
+	s *discordgo.Session,
+	i *discordgo.InteractionCreate,
+	data *discordgo.ApplicationCommandInteractionDataOption,
+) error {
+	var (
+		ctx     = context.Background()
+		channel *discordgo.Channel
+		confirm bool
+		guildID = i.GuildID
+	)
+
+	for _, opt := range data.Options {
+		switch opt.Name {
+		case "channel":
+			channel = opt.ChannelValue(s)
+		case "confirm":
+			confirm = opt.BoolValue()
+		}
+	}
+
+	if msg := validateAlertChannel(s, channel); msg != "" {
+		return respondEphemeral(s, i, msg)
+	}
+
+	networks := c.bot.GetCartographoor().GetActiveNetworks()
+	if len(networks) == 0 {
+		return respondEphemeral(s, i, msgNoActiveNetworks)
+	}
+
+	registered, err := c.registeredNetworks(ctx, guildID)
+	if err != nil {
+		return err
+	}
+
+	if !confirm {
+		lines := make([]string, 0, len(networks))
+
+		for _, network := range networks {
+			if registered[network] {
+				lines = append(lines, fmt.Sprintf("- %s (%s, skipped)", network, msgRegisterAllSkipReason))
+
+				continue
+			}
+
+			lines = append(lines, fmt.Sprintf("- %s", network))
+		}
+
+		return respondEphemeral(s, i, fmt.Sprintf(msgRegisterAllPreview, len(networks), channel.ID, strings.Join(lines, "\n")))
+	}
+
+	// Registering every client on every network takes a while, so acknowledge the interaction first.
+	if respondErr := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Flags: discordgo.MessageFlagsEphemeral,
+		},
+	}); respondErr != nil {
+		return fmt.Errorf("failed to send deferred response: %w", respondErr)
+	}
+
+	var (
+		outcomes = make([]common.BulkOutcome, 0, len(networks))
+		schedule = c.defaultSchedule(ctx, guildID)
+	)
+
+	for _, network := range networks {
+		outcome := common.BulkOutcome{Network: network}
+
+		if registered[network] {
+			outcome.SkipReason = msgRegisterAllSkipReason
+		} else if regErr := c.registerAllClients(ctx, network, channel.ID, guildID, alertSettings{schedule: schedule, registeredBy: interactionUserID(i)}); regErr != nil {
+			outcome.Err = regErr
+		}
+
+		outcomes = append(outcomes, outcome)
+	}
+
+	c.log.WithFields(logrus.Fields{
+		"channel":  channel.ID,
+		"guild":    guildID,
+		"networks": len(networks),
+	}).Info("Registered health checks for all active networks")
+
+	if _, editErr := s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+		Content: new(common.FormatBulkOutcomes(fmt.Sprintf(msgRegisterAllHeader, channel.ID), outcomes)),
+	}); editErr != nil {
+		c.log.WithError(editErr).Error("Failed to edit deferred response")
+	}
+
+	return nil
+}
+
+// registeredNetworks returns the networks that already have health checks registered in the guild.
+func (c *ChecksCommand) registeredNetworks(ctx context.Context, guildID string) (map[string]bool, error) {
+	alerts, err := c.bot.GetMonitorRepo().List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list alerts: %w", err)
+	}
+
+	registered := make(map[string]bool)
+
+	for _, alert := range alerts {
+		if alert.DiscordGuildID == guildID {
+			registered[alert.Network] = true
+		}
+	}
+
+	return registered, nil
+}
+
+// respondEphemeral responds to the interaction with a message only the invoking user can see.
+func respondEphemeral(s *discordgo.Session, i *discordgo.InteractionCreate, msg string) error {
+	return s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: msg,
+			Flags:   discordgo.MessageFlagsEphemeral,
+		},
+	})
+}
@@ -0,0 +1,240 @@
+package checks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/ethpandaops/panda-pulse/pkg/analyzer"
+	"github.com/ethpandaops/panda-pulse/pkg/checks"
+	"github.com/ethpandaops/panda-pulse/pkg/discord/cmd/common"
+	"github.com/ethpandaops/panda-pulse/pkg/discord/message"
+	"github.com/ethpandaops/panda-pulse/pkg/store"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// alertArtifactType is the check artifact type the alert payload is persisted as.
+	alertArtifactType = "alert"
+
+	msgNoAlertStored = "ℹ️ No stored alert found for check ID: %s. Only alerts generated since replay support was added can be replayed"
+	msgAlertReplayed = "✅ Replayed the **%s** alert on **%s** from check `%s` to <#%s>"
+	msgReplayFailed  = "❌ Failed to replay the alert from check `%s`: %v"
+)
+
+// alertPayload is everything needed to render an alert again without re-running the check.
+type alertPayload struct {
+	Alert         *store.MonitorAlert      `json:"alert"`
+	CheckID       string                   `json:"checkId"`
+	Results       []*checks.Result         `json:"results"`
+	Analysis      *analyzer.AnalysisResult `json:"analysis"`
+	HiveAvailable bool                     `json:"hiveAvailable"`
+	HiveSuites    []string                 `json:"hiveSuites,omitempty"`
+	CreatedAt     time.Time                `json:"createdAt"`
+}
+
+// persistAlertPayload stores the data an alert was rendered from alongside the other check
+// artifacts, so it can be replayed later. Failures are logged, a replay is a nice to have.
+func (c *ChecksCommand) persistAlertPayload(ctx context.Context, payload *alertPayload) {
+	log := c.log.WithFields(logrus.Fields{
+		"network": payload.Alert.Network,
+		"client":  payload.Alert.Client,
+		"checkID": payload.CheckID,
+	})
+
+	content, err := json.Marshal(payload)
+	if err != nil {
+		log.WithError(err).Error("Failed to marshal alert payload")
+
+		return
+	}
+
+	if perr := c.bot.GetChecksRepo().Persist(ctx, &store.CheckArtifact{
+		Network:   payload.Alert.Network,
+		Client:    payload.Alert.Client,
+		CheckID:   payload.CheckID,
+		Type:      alertArtifactType,
+		CreatedAt: payload.CreatedAt,
+		UpdatedAt: payload.CreatedAt,
+		Content:   content,
+	}); perr != nil {
+		log.WithError(perr).Error("Failed to persist alert payload")
+	}
+}
+
+// getReplayCommandDefinition returns the '/checks replay' subcommand definition.
+func (c *ChecksCommand) getReplayCommandDefinition() *discordgo.ApplicationCommandOption {
+	return &discordgo.ApplicationCommandOption{
+		Name:        "replay",
+		Description: "Re-post a previously sent alert without re-running the check",
+		Type:        discordgo.ApplicationCommandOptionSubCommand,
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Name:        "id",
+				Description: "Check ID of the alert to replay",
+				Type:        discordgo.ApplicationCommandOptionString,
+				Required:    true,
+			},
+			{
+				Name:         "channel",
+				Description:  "Channel to post the alert to (optional, defaults to the alert's channel)",
+				Type:         discordgo.ApplicationCommandOptionChannel,
+				Required:     false,
+				ChannelTypes: []discordgo.ChannelType{discordgo.ChannelTypeGuildText},
+			},
+		},
+	}
+}
+
+// handleReplay handles the '/checks replay' command.
+func (c *ChecksCommand) handleReplay(
+	s *discordgo.Session,
+	i *discordgo.InteractionCreate,
+	data *discordgo.ApplicationCommandInteractionDataOption,
+) error {
+	var (
+		ctx              = context.Background()
+		checkID, channel string
+	)
+
+	for _, opt := range data.Options {
+		switch opt.Name {
+		case "id":
+			checkID = opt.StringValue()
+		case "channel":
+			channel = opt.ChannelValue(s).ID
+		}
+	}
+
+	// Finding the check means listing every artifact, so acknowledge the interaction first.
+	if err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Flags: discordgo.MessageFlagsEphemeral,
+		},
+	}); err != nil {
+		return fmt.Errorf("failed to send deferred response: %w", err)
+	}
+
+	content := c.replayAlert(ctx, i.GuildID, checkID, channel)
+
+	if _, err := s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+		Content: new(content),
+	}); err != nil {
+		c.log.WithError(err).Error("Failed to edit deferred response")
+	}
+
+	return nil
+}
+
+// replayAlert re-posts the alert stored for the check, returning the message to respond with.
+func (c *ChecksCommand) replayAlert(ctx context.Context, guildID, checkID, channel string) string {
+	payload, err := c.loadAlertPayload(ctx, checkID)
+	if err != nil {
+		c.log.WithError(err).WithField("checkID", checkID).Error("Failed to load alert payload")
+
+		return fmt.Sprintf(msgReplayFailed, checkID, err)
+	}
+
+	// Alerts registered by another guild aren't ours to replay.
+	if payload == nil || payload.Alert.DiscordGuildID != guildID {
+		return fmt.Sprintf(msgNoAlertStored, checkID)
+	}
+
+	alert := *payload.Alert
+	if channel != "" {
+		alert.DiscordChannel = channel
+	}
+
+	var (
+		overrides = common.LoadGuildOverrides(ctx, c.log, c.bot.GetGuildConfigRepo(), alert.DiscordGuildID)
+		builder   = c.newAlertMessageBuilder(&alert, checkID, payload.Results, payload.HiveAvailable, payload.Analysis, overrides)
+	)
+
+	// Reuse the screenshots taken at the time, if there were any.
+	var screenshots []message.HiveScreenshot
+	if payload.HiveAvailable {
+		suites := payload.HiveSuites
+		if len(suites) == 0 {
+			suites = []string{""}
+		}
+
+		for _, suite := range suites {
+			artifact, aerr := c.bot.GetChecksRepo().GetArtifact(ctx, alert.Network, alert.Client, checkID, hiveArtifactType(suite))
+			if aerr == nil {
+				screenshots = append(screenshots, message.HiveScreenshot{Suite: suite, Content: artifact.Content})
+			}
+		}
+	}
+
+	// Mentions are left out, the people responsible were pinged when the alert was first sent.
+	var derr error
+	if alert.Compact {
+		_, derr = c.deliverCompactAlert(&alert, builder, nil)
+	} else {
+		_, derr = c.deliverAlert(&alert, checkID, payload.Results, builder, screenshots, nil)
+	}
+
+	if derr != nil {
+		c.log.WithError(derr).WithField("checkID", checkID).Error("Failed to replay alert")
+
+		return fmt.Sprintf(msgReplayFailed, checkID, derr)
+	}
+
+	c.log.WithFields(logrus.Fields{
+		"network": alert.Network,
+		"client":  alert.Client,
+		"checkID": checkID,
+		"channel": alert.DiscordChannel,
+	}).Info("Replayed alert")
+
+	return fmt.Sprintf(msgAlertReplayed, alert.Client, alert.Network, checkID, alert.DiscordChannel)
+}
+
+// loadAlertPayload finds the check and loads its stored alert payload. Returns nil if the check
+// doesn't exist or no alert was stored for it.
+func (c *ChecksCommand) loadAlertPayload(ctx context.Context, checkID string) (*alertPayload, error) {
+	repo := c.bot.GetChecksRepo()
+
+	// The network and client make up the artifact's key, so find them from the check's log.
+	artifacts, err := repo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list artifacts: %w", err)
+	}
+
+	var match *store.CheckArtifact
+
+	for _, artifact := range artifacts {
+		if artifact.CheckID == checkID {
+			match = artifact
+
+			break
+		}
+	}
+
+	if match == nil {
+		return nil, nil
+	}
+
+	artifact, err := repo.GetArtifact(ctx, match.Network, match.Client, checkID, alertArtifactType)
+	if err != nil {
+		// Checks that never alerted, or ran before payloads were stored, won't have one.
+		c.log.WithError(err).WithField("checkID", checkID).Debug("No alert payload stored")
+
+		return nil, nil
+	}
+
+	var payload alertPayload
+	if uerr := json.Unmarshal(artifact.Content, &payload); uerr != nil {
+		return nil, fmt.Errorf("failed to decode alert payload: %w", uerr)
+	}
+
+	if payload.Alert == nil || payload.Analysis == nil {
+		return nil, errors.New("alert payload is incomplete")
+	}
+
+	return &payload, nil
+}
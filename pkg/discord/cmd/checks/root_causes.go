@@ -0,0 +1,184 @@
+package checks
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/ethpandaops/panda-pulse/pkg/store"
+)
+
+const (
+	// defaultRootCauseDays is how far back '/checks root-causes' looks by default.
+	defaultRootCauseDays = 30
+	// maxRootCauseDays bounds the lookback, each day of history is a separate S3 listing.
+	maxRootCauseDays = 90
+
+	msgNoRootCauses    = "ℹ️ No clients were flagged as a root cause on **%s** in the last %d days"
+	msgRootCauseHeader = "🧭 Root causes on **%s** over the last %d days\n"
+	msgRootCauseLine   = "%d. **%s**, flagged in %d check runs across %d incidents\n"
+)
+
+// rootCauseCount is how often a client was flagged as a root cause.
+type rootCauseCount struct {
+	client    string
+	runs      int
+	incidents int
+}
+
+// getRootCausesCommandDefinition returns the '/checks root-causes' subcommand definition.
+func (c *ChecksCommand) getRootCausesCommandDefinition() *discordgo.ApplicationCommandOption {
+	return &discordgo.ApplicationCommandOption{
+		Name:        "root-causes",
+		Description: "Rank the clients most often flagged as a root cause on a network",
+		Type:        discordgo.ApplicationCommandOptionSubCommand,
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Name:         "network",
+				Description:  "Network to report on",
+				Type:         discordgo.ApplicationCommandOptionString,
+				Required:     true,
+				Autocomplete: true,
+			},
+			{
+				Name:        "days",
+				Description: fmt.Sprintf("How many days to look back (default %d, max %d)", defaultRootCauseDays, maxRootCauseDays),
+				Type:        discordgo.ApplicationCommandOptionInteger,
+				Required:    false,
+				MinValue:    new(float64(1)),
+				MaxValue:    maxRootCauseDays,
+			},
+		},
+	}
+}
+
+// handleRootCauses handles the '/checks root-causes' command.
+func (c *ChecksCommand) handleRootCauses(
+	s *discordgo.Session,
+	i *discordgo.InteractionCreate,
+	data *discordgo.ApplicationCommandInteractionDataOption,
+) error {
+	var (
+		network string
+		days    = defaultRootCauseDays
+	)
+
+	for _, opt := range data.Options {
+		switch opt.Name {
+		case "network":
+			network = opt.StringValue()
+		case "days":
+			days = min(max(int(opt.IntValue()), 1), maxRootCauseDays)
+		}
+	}
+
+	// Reading back the history can take a while, so acknowledge the interaction first.
+	if err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Flags: discordgo.MessageFlagsEphemeral,
+		},
+	}); err != nil {
+		return fmt.Errorf("failed to send deferred response: %w", err)
+	}
+
+	counts, err := c.rootCauseCounts(context.Background(), network, time.Now().AddDate(0, 0, -days))
+	if err != nil {
+		c.log.WithError(err).WithField("network", network).Error("Failed to count root causes")
+
+		if _, editErr := s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+			Content: new(fmt.Sprintf("❌ Failed to read the incident history for **%s**: %v", network, err)),
+		}); editErr != nil {
+			c.log.WithError(editErr).Error("Failed to edit deferred response")
+		}
+
+		return nil
+	}
+
+	content := fmt.Sprintf(msgNoRootCauses, network, days)
+	if len(counts) > 0 {
+		content = formatRootCauses(network, days, counts)
+	}
+
+	if _, err := s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+		Content: new(content),
+	}); err != nil {
+		c.log.WithError(err).Error("Failed to edit deferred response")
+	}
+
+	return nil
+}
+
+// rootCauseCounts counts how often each client was flagged as a root cause on the network since the
+// given time, across both archived incidents and those still open. Clients are ranked by the number
+// of check runs that flagged them.
+func (c *ChecksCommand) rootCauseCounts(ctx context.Context, network string, since time.Time) ([]rootCauseCount, error) {
+	repo := c.bot.GetIncidentsRepo()
+
+	incidents, err := repo.ListHistory(ctx, network, since)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := repo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list incidents: %w", err)
+	}
+
+	// Resolved incidents are already in the history.
+	for _, incident := range current {
+		if incident.Network == network && incident.IsOpen() {
+			incidents = append(incidents, incident)
+		}
+	}
+
+	return countRootCauses(incidents), nil
+}
+
+// countRootCauses aggregates the root cause runs of each client's incidents, most flagged first.
+func countRootCauses(incidents []*store.Incident) []rootCauseCount {
+	byClient := make(map[string]*rootCauseCount)
+
+	for _, incident := range incidents {
+		if incident.RootCauseRuns == 0 {
+			continue
+		}
+
+		count, ok := byClient[incident.Client]
+		if !ok {
+			count = &rootCauseCount{client: incident.Client}
+			byClient[incident.Client] = count
+		}
+
+		count.runs += incident.RootCauseRuns
+		count.incidents++
+	}
+
+	counts := make([]rootCauseCount, 0, len(byClient))
+	for _, count := range byClient {
+		counts = append(counts, *count)
+	}
+
+	slices.SortFunc(counts, func(a, b rootCauseCount) int {
+		return cmp.Or(cmp.Compare(b.runs, a.runs), cmp.Compare(b.incidents, a.incidents), cmp.Compare(a.client, b.client))
+	})
+
+	return counts
+}
+
+// formatRootCauses renders the ranked root cause counts.
+func formatRootCauses(network string, days int, counts []rootCauseCount) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, msgRootCauseHeader, network, days)
+
+	for idx, count := range counts {
+		fmt.Fprintf(&sb, msgRootCauseLine, idx+1, count.client, count.runs, count.incidents)
+	}
+
+	return sb.String()
+}
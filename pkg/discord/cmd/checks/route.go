@@ -0,0 +1,327 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/ethpandaops/panda-pulse/pkg/checks"
+	"github.com/ethpandaops/panda-pulse/pkg/store"
+)
+
+const (
+	msgRouteAdded      = "✅ Routing **%s** alerts on **%s** to <#%s>"
+	msgRouteRemoved    = "✅ Removed route **%s** on **%s**"
+	msgRouteNotFound   = "ℹ️ No route **%s** exists on **%s**"
+	msgNoRoutes        = "ℹ️ No alert routes are configured%s"
+	msgRoutesHeader    = "🧭 Alert routes\n"
+	msgRouteUnknownCmd = "unknown route subcommand: %s"
+)
+
+// getRouteCommandDefinition returns the '/checks route' subcommand group definition.
+func (c *ChecksCommand) getRouteCommandDefinition(clientChoices []*discordgo.ApplicationCommandOptionChoice) *discordgo.ApplicationCommandOption {
+	var (
+		categoryChoices = make([]*discordgo.ApplicationCommandOptionChoice, 0, len(orderedCategories))
+		severityChoices = []*discordgo.ApplicationCommandOptionChoice{
+			{Name: store.RouteSeverityCritical, Value: store.RouteSeverityCritical},
+			{Name: store.RouteSeverityWarning, Value: store.RouteSeverityWarning},
+		}
+	)
+
+	for _, category := range orderedCategories {
+		categoryChoices = append(categoryChoices, &discordgo.ApplicationCommandOptionChoice{
+			Name:  category.String(),
+			Value: string(category),
+		})
+	}
+
+	matchers := func(verb string) []*discordgo.ApplicationCommandOption {
+		return []*discordgo.ApplicationCommandOption{
+			{
+				Name:        "category",
+				Description: fmt.Sprintf("Check category to %s (optional, defaults to any)", verb),
+				Type:        discordgo.ApplicationCommandOptionString,
+				Required:    false,
+				Choices:     categoryChoices,
+			},
+			{
+				Name:        "client",
+				Description: fmt.Sprintf("Client to %s (optional, defaults to any)", verb),
+				Type:        discordgo.ApplicationCommandOptionString,
+				Required:    false,
+				Choices:     clientChoices,
+			},
+			{
+				Name:        "severity",
+				Description: fmt.Sprintf("Severity to %s (optional, defaults to any)", verb),
+				Type:        discordgo.ApplicationCommandOptionString,
+				Required:    false,
+				Choices:     severityChoices,
+			},
+		}
+	}
+
+	return &discordgo.ApplicationCommandOption{
+		Name:        "route",
+		Description: "Manage where alerts are delivered based on what's failing",
+		Type:        discordgo.ApplicationCommandOptionSubCommandGroup,
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Name:        "add",
+				Description: "Route matching alerts to a channel",
+				Type:        discordgo.ApplicationCommandOptionSubCommand,
+				Options: append([]*discordgo.ApplicationCommandOption{
+					{
+						Name:         "network",
+						Description:  "Network to route alerts for",
+						Type:         discordgo.ApplicationCommandOptionString,
+						Required:     true,
+						Autocomplete: true,
+					},
+					{
+						Name:        "channel",
+						Description: "Channel to route matching alerts to",
+						Type:        discordgo.ApplicationCommandOptionChannel,
+						Required:    true,
+						ChannelTypes: []discordgo.ChannelType{
+							discordgo.ChannelTypeGuildText,
+						},
+					},
+				}, matchers("route")...),
+			},
+			{
+				Name:        "remove",
+				Description: "Remove an alert route",
+				Type:        discordgo.ApplicationCommandOptionSubCommand,
+				Options: append([]*discordgo.ApplicationCommandOption{
+					{
+						Name:         "network",
+						Description:  "Network to remove the route from",
+						Type:         discordgo.ApplicationCommandOptionString,
+						Required:     true,
+						Autocomplete: true,
+					},
+				}, matchers("match")...),
+			},
+			{
+				Name:        "list",
+				Description: "List alert routes",
+				Type:        discordgo.ApplicationCommandOptionSubCommand,
+				Options: []*discordgo.ApplicationCommandOption{
+					{
+						Name:         "network",
+						Description:  "Network to list routes for (optional)",
+						Type:         discordgo.ApplicationCommandOptionString,
+						Required:     false,
+						Autocomplete: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// handleRoute handles the '/checks route' subcommand group.
+func (c *ChecksCommand) handleRoute(
+	s *discordgo.Session,
+	i *discordgo.InteractionCreate,
+	data *discordgo.ApplicationCommandInteractionDataOption,
+) error {
+	if len(data.Options) == 0 {
+		return fmt.Errorf(msgRouteUnknownCmd, "none")
+	}
+
+	var (
+		sub     = data.Options[0]
+		options = make(map[string]*discordgo.ApplicationCommandInteractionDataOption, len(sub.Options))
+	)
+
+	for _, opt := range sub.Options {
+		options[opt.Name] = opt
+	}
+
+	optString := func(name string) string {
+		if opt, ok := options[name]; ok {
+			return opt.StringValue()
+		}
+
+		return ""
+	}
+
+	var (
+		ctx     = context.Background()
+		network = optString("network")
+		msg     string
+	)
+
+	switch sub.Name {
+	case "add":
+		now := time.Now()
+		rule := &store.RouteRule{
+			Network:        network,
+			Category:       optString("category"),
+			Client:         optString("client"),
+			Severity:       optString("severity"),
+			DiscordChannel: options["channel"].ChannelValue(s).ID,
+			DiscordGuildID: i.GuildID,
+			CreatedAt:      now,
+			UpdatedAt:      now,
+		}
+
+		if err := c.bot.GetRoutesRepo().Persist(ctx, rule); err != nil {
+			return fmt.Errorf("failed to persist route: %w", err)
+		}
+
+		msg = fmt.Sprintf(msgRouteAdded, rule.ID(), network, rule.DiscordChannel)
+	case "remove":
+		id := store.RouteID(optString("category"), optString("client"), optString("severity"))
+
+		rules, err := c.bot.GetRoutesRepo().ListNetwork(ctx, network)
+		if err != nil {
+			return fmt.Errorf("failed to list routes: %w", err)
+		}
+
+		msg = fmt.Sprintf(msgRouteNotFound, id, network)
+
+		for _, rule := range rules {
+			if rule.Network != network || rule.DiscordGuildID != i.GuildID || rule.ID() != id {
+				continue
+			}
+
+			if err := c.bot.GetRoutesRepo().Purge(ctx, network, i.GuildID, id); err != nil {
+				return fmt.Errorf("failed to remove route: %w", err)
+			}
+
+			msg = fmt.Sprintf(msgRouteRemoved, id, network)
+
+			break
+		}
+	case "list":
+		var (
+			rules []*store.RouteRule
+			err   error
+		)
+
+		if network != "" {
+			rules, err = c.bot.GetRoutesRepo().ListNetwork(ctx, network)
+		} else {
+			rules, err = c.bot.GetRoutesRepo().List(ctx)
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to list routes: %w", err)
+		}
+
+		msg = buildRouteList(rules, i.GuildID, network)
+	default:
+		return fmt.Errorf(msgRouteUnknownCmd, sub.Name)
+	}
+
+	return s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: msg,
+			Flags:   discordgo.MessageFlagsEphemeral,
+		},
+	})
+}
+
+// buildRouteList formats the routes for a guild, optionally filtered by network.
+func buildRouteList(rules []*store.RouteRule, guildID, network string) string {
+	filtered := make([]*store.RouteRule, 0, len(rules))
+
+	for _, rule := range rules {
+		if rule.DiscordGuildID != guildID || (network != "" && rule.Network != network) {
+			continue
+		}
+
+		filtered = append(filtered, rule)
+	}
+
+	if len(filtered) == 0 {
+		suffix := ""
+		if network != "" {
+			suffix = fmt.Sprintf(" for **%s**", network)
+		}
+
+		return fmt.Sprintf(msgNoRoutes, suffix)
+	}
+
+	sort.Slice(filtered, func(a, b int) bool {
+		if filtered[a].Network != filtered[b].Network {
+			return filtered[a].Network < filtered[b].Network
+		}
+
+		return filtered[a].ID() < filtered[b].ID()
+	})
+
+	var msg strings.Builder
+
+	msg.WriteString(msgRoutesHeader)
+
+	for _, rule := range filtered {
+		fmt.Fprintf(&msg, "- **%s** `%s` → <#%s>\n", rule.Network, rule.ID(), rule.DiscordChannel)
+	}
+
+	return msg.String()
+}
+
+// alertDelivery is a destination channel and the subset of results that should be delivered there.
+type alertDelivery struct {
+	channelID string
+	results   []*checks.Result
+}
+
+// routeResults splits the failing results of a run across destination channels using the
+// configured routing rules. Anything without a matching rule goes to the alert's own channel.
+func (c *ChecksCommand) routeResults(ctx context.Context, alert *store.MonitorAlert, results []*checks.Result, severity string) []*alertDelivery {
+	var (
+		deliveries = make([]*alertDelivery, 0, 1)
+		byChannel  = make(map[string]*alertDelivery)
+		rules      []*store.RouteRule
+	)
+
+	if repo := c.bot.GetRoutesRepo(); repo != nil {
+		var err error
+
+		// Only the alert's network is listed, this runs on every delivery.
+		rules, err = repo.ListNetwork(ctx, alert.Network)
+		if err != nil {
+			c.log.WithError(err).Warn("Failed to list alert routes, using default channel")
+		}
+	}
+
+	for _, result := range results {
+		// Only failing checks are alerted on, errored checks are recorded in the check log.
+		if result.Status != checks.StatusFail {
+			continue
+		}
+
+		channelID := alert.DiscordChannel
+
+		if rule := store.MatchRoute(
+			rules,
+			alert.Network,
+			alert.DiscordGuildID,
+			string(result.Category),
+			alert.Client,
+			severity,
+		); rule != nil {
+			channelID = rule.DiscordChannel
+		}
+
+		delivery, ok := byChannel[channelID]
+		if !ok {
+			delivery = &alertDelivery{channelID: channelID}
+			byChannel[channelID] = delivery
+			deliveries = append(deliveries, delivery)
+		}
+
+		delivery.results = append(delivery.results, result)
+	}
+
+	return deliveries
+}
@@ -3,8 +3,10 @@ package checks
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/bwmarrin/discordgo"
+	"github.com/ethpandaops/panda-pulse/pkg/checks"
 	"github.com/ethpandaops/panda-pulse/pkg/store"
 )
 
@@ -13,6 +15,10 @@ const (
 	msgRunningCheck   = "🔄 Running manual check for **%s** on **%s**..."
 	msgChecksPassed   = "✅ All checks passed for **%s** on **%s**"
 	msgIssuesDetected = "ℹ️ Issues detected for **%s** on **%s**, see below for details"
+	msgCooldown       = "⏳ Issues detected for **%s** on **%s**, but a notification was already sent within the last %s. Re-run with `force: True` to notify anyway"
+	msgRunNetworkDown = "🚨 **%s** looks to be down as a whole, so checks for **%s** were skipped in favour of a network-wide alert"
+	msgChecksRan      = "\nChecks run: %s"
+	msgNoChecksRan    = "\n⚠️ No checks ran"
 )
 
 // handleRun handles the '/checks run' command.
@@ -21,7 +27,15 @@ func (c *ChecksCommand) handleRun(
 	i *discordgo.InteractionCreate,
 	data *discordgo.ApplicationCommandInteractionDataOption,
 ) error {
-	network, client := extractOptions(data)
+	network, client, force := extractOptions(data)
+
+	details := c.config.ManualRunDetails
+
+	for _, opt := range data.Options {
+		if opt.Name == "details" {
+			details = opt.BoolValue()
+		}
+	}
 
 	guildID := i.GuildID
 
@@ -38,20 +52,47 @@ func (c *ChecksCommand) handleRun(
 
 	// Run the check using the service. We don't need to use the queue here, as
 	// its just a once-off.
-	alertSent, err := c.RunChecks(context.Background(), &store.MonitorAlert{
+	outcome, results, err := c.runChecks(context.Background(), &store.MonitorAlert{
 		Network:        network,
 		Client:         client,
 		DiscordChannel: i.ChannelID,
 		DiscordGuildID: guildID,
-	})
+	}, force)
 	if err != nil {
 		return fmt.Errorf("failed to run checks: %w", err)
 	}
 
+	// The issues were recently notified about, let the user know rather than claim all is well.
+	if outcome == outcomeCooldown {
+		if _, err = s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+			Content: stringPtr(fmt.Sprintf(msgCooldown, client, network, c.config.NotificationCooldown)),
+		}); err != nil {
+			c.log.Errorf("Failed to edit initial response: %v", err)
+		}
+
+		return nil
+	}
+
+	// Per-client results mean little while the whole network is down.
+	if outcome == outcomeNetworkDown {
+		if _, err = s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+			Content: stringPtr(fmt.Sprintf(msgRunNetworkDown, network, client)),
+		}); err != nil {
+			c.log.Errorf("Failed to edit initial response: %v", err)
+		}
+
+		return nil
+	}
+
 	// If no alert was sent, everything is good.
-	if !alertSent {
+	if outcome != outcomeSent {
+		msg := fmt.Sprintf(msgChecksPassed, client, network)
+		if details {
+			msg += formatChecksRan(results)
+		}
+
 		if _, err = s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
-			Content: stringPtr(fmt.Sprintf(msgChecksPassed, client, network)),
+			Content: stringPtr(msg),
 		}); err != nil {
 			c.log.Errorf("Failed to edit initial response: %v", err)
 		}
@@ -70,8 +111,42 @@ func (c *ChecksCommand) handleRun(
 }
 
 // extractOptions extracts command options into a structured format.
-func extractOptions(data *discordgo.ApplicationCommandInteractionDataOption) (network, client string) {
-	options := data.Options
+func extractOptions(data *discordgo.ApplicationCommandInteractionDataOption) (network, client string, force bool) {
+	for _, opt := range data.Options {
+		switch opt.Name {
+		case "network":
+			network = opt.StringValue()
+		case "client":
+			client = opt.StringValue()
+		case "force":
+			force = opt.BoolValue()
+		}
+	}
+
+	return network, client, force
+}
+
+// formatChecksRan lists the checks that ran with their status, so a passing run shows what was
+// actually checked, and a check that silently didn't run stands out.
+func formatChecksRan(results []*checks.Result) string {
+	if len(results) == 0 {
+		return msgNoChecksRan
+	}
+
+	ran := make([]string, 0, len(results))
+
+	for _, result := range results {
+		icon := "✅"
+
+		switch result.Status {
+		case checks.StatusFail:
+			icon = "❌"
+		case checks.StatusError:
+			icon = "⚠️"
+		}
+
+		ran = append(ran, fmt.Sprintf("`%s` %s", result.Name, icon))
+	}
 
-	return options[0].StringValue(), options[1].StringValue()
+	return fmt.Sprintf(msgChecksRan, strings.Join(ran, ", "))
 }
@@ -0,0 +1,153 @@
+package checks
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// weekdayNames are the days a screenshot hours window can be limited to.
+var weekdayNames = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// ScreenshotHours is the window Hive screenshots are taken in, outside it alerts just link to Hive.
+// A nil window takes them at any time.
+type ScreenshotHours struct {
+	days     [7]bool // Indexed by time.Weekday, the day the window starts on.
+	start    time.Duration
+	end      time.Duration // Before start for windows running past midnight.
+	location *time.Location
+}
+
+// ParseScreenshotHours parses a window of the day, optionally limited to a range of weekdays and in
+// a timezone, such as "09:00-17:00", "Mon-Fri 09:00-17:00" or "Mon-Fri 09:00-17:00 Europe/Berlin".
+// Windows may run past midnight, such as "22:00-02:00". Times are UTC unless a timezone is given. An
+// empty value returns nil, taking screenshots at any time.
+func ParseScreenshotHours(value string) (*ScreenshotHours, error) {
+	fields := strings.Fields(value)
+	if len(fields) == 0 {
+		return nil, nil //nolint:nilnil // No window is valid, and takes screenshots at any time.
+	}
+
+	hours := &ScreenshotHours{location: time.UTC}
+
+	// Every day, unless the window starts with a range of them rather than the times.
+	if strings.Contains(fields[0], ":") {
+		for day := range hours.days {
+			hours.days[day] = true
+		}
+	} else {
+		days, err := parseWeekdayRange(fields[0])
+		if err != nil {
+			return nil, err
+		}
+
+		hours.days = days
+		fields = fields[1:]
+	}
+
+	if len(fields) == 0 || len(fields) > 2 {
+		return nil, fmt.Errorf("invalid screenshot hours %q, expected eg \"Mon-Fri 09:00-17:00 Europe/Berlin\"", value)
+	}
+
+	times, err := parseTimeRange(fields[0])
+	if err != nil {
+		return nil, err
+	}
+
+	hours.start, hours.end = times[0], times[1]
+
+	if len(fields) == 2 {
+		if hours.location, err = time.LoadLocation(fields[1]); err != nil {
+			return nil, fmt.Errorf("invalid timezone %q: %w", fields[1], err)
+		}
+	}
+
+	return hours, nil
+}
+
+// Contains returns true if screenshots are taken at the given time. A window running past midnight
+// belongs to the day it starts on.
+func (h *ScreenshotHours) Contains(t time.Time) bool {
+	if h == nil {
+		return true
+	}
+
+	var (
+		local     = t.In(h.location)
+		sinceDay  = time.Duration(local.Hour())*time.Hour + time.Duration(local.Minute())*time.Minute
+		overnight = h.end <= h.start
+	)
+
+	switch {
+	case !overnight:
+		return h.days[local.Weekday()] && sinceDay >= h.start && sinceDay < h.end
+	case sinceDay >= h.start:
+		return h.days[local.Weekday()]
+	case sinceDay < h.end:
+		return h.days[local.AddDate(0, 0, -1).Weekday()]
+	default:
+		return false
+	}
+}
+
+// parseTimeRange parses a range of times of day such as "09:00-17:00", returning each as the time
+// since midnight.
+func parseTimeRange(value string) ([2]time.Duration, error) {
+	var times [2]time.Duration
+
+	from, to, ok := strings.Cut(value, "-")
+	if !ok {
+		return times, fmt.Errorf("invalid time range %q, expected eg \"09:00-17:00\"", value)
+	}
+
+	for idx, part := range []string{from, to} {
+		parsed, err := time.Parse("15:04", part)
+		if err != nil {
+			return times, fmt.Errorf("invalid time %q, expected eg \"09:00\"", part)
+		}
+
+		times[idx] = time.Duration(parsed.Hour())*time.Hour + time.Duration(parsed.Minute())*time.Minute
+	}
+
+	if times[0] == times[1] {
+		return times, fmt.Errorf("invalid time range %q, it starts and ends at the same time", value)
+	}
+
+	return times, nil
+}
+
+// parseWeekdayRange parses a range of weekdays such as "Mon-Fri", or a single day such as "Sat".
+// Ranges may wrap around the week, such as "Sat-Sun".
+func parseWeekdayRange(value string) ([7]bool, error) {
+	var days [7]bool
+
+	from, to, ok := strings.Cut(strings.ToLower(value), "-")
+	if !ok {
+		to = from
+	}
+
+	start, sok := weekdayNames[from]
+	end, eok := weekdayNames[to]
+
+	if !sok || !eok {
+		return days, fmt.Errorf("invalid days %q, expected eg \"Mon-Fri\"", value)
+	}
+
+	for day := start; ; day = (day + 1) % 7 {
+		days[day] = true
+
+		if day == end {
+			break
+		}
+	}
+
+	return days, nil
+}
@@ -0,0 +1,79 @@
+package checks
+
+import (
+	"context"
+
+	"github.com/ethpandaops/panda-pulse/pkg/checks"
+	"github.com/ethpandaops/panda-pulse/pkg/discord/message"
+	"github.com/ethpandaops/panda-pulse/pkg/slack"
+	"github.com/ethpandaops/panda-pulse/pkg/store"
+	"github.com/sirupsen/logrus"
+)
+
+// deliverSlack posts the alert to Slack, with the breakdown threaded beneath the main message when
+// posting via the API. Returns true if the main message went out, false if Slack isn't configured,
+// has no channel for the alert or the post failed.
+func (c *ChecksCommand) deliverSlack(
+	ctx context.Context,
+	alert *store.MonitorAlert,
+	checkID string,
+	results []*checks.Result,
+	builder *message.AlertMessageBuilder,
+) bool {
+	client := c.bot.GetSlack()
+	if client == nil {
+		return false
+	}
+
+	log := c.log.WithFields(logrus.Fields{
+		"network": alert.Network,
+		"client":  alert.Client,
+		"checkID": checkID,
+	})
+
+	channel, ok := client.Channel(alert.Network, alert.Client)
+	if !ok {
+		log.Debug("No Slack channel mapped, skipped Slack notification")
+
+		return false
+	}
+
+	log = log.WithField("slackChannel", channel)
+
+	// Slack's mrkdwn differs from Discord's Markdown, and allows longer messages, so the breakdown
+	// is converted and packed into as few replies as fit.
+	var (
+		main    = slack.Pack([]string{slack.Mrkdwn(builder.BuildMarkdown())}, slack.MaxMessageLength)
+		details = make([]string, 0)
+		ts      string
+	)
+
+	for idx, msg := range main {
+		msgTS, err := client.PostMessage(ctx, channel, msg, ts)
+		if err != nil {
+			log.WithError(err).Error("Failed to send Slack message")
+
+			return idx > 0
+		}
+
+		if idx == 0 {
+			ts = msgTS
+		}
+	}
+
+	for _, msg := range buildThreadMessages(results, builder) {
+		details = append(details, slack.Mrkdwn(msg))
+	}
+
+	for _, msg := range slack.Pack(details, slack.MaxMessageLength) {
+		if _, err := client.PostMessage(ctx, channel, msg, ts); err != nil {
+			log.WithError(err).Error("Failed to send Slack breakdown")
+
+			break
+		}
+	}
+
+	log.Info("Sent Slack notification")
+
+	return true
+}
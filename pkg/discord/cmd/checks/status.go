@@ -0,0 +1,154 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/ethpandaops/panda-pulse/pkg/store"
+)
+
+const (
+	msgStatusHeader     = "📊 Last known state of **%s** on **%s**\n"
+	msgStatusFailing    = "- 🔴 **Failing** since <t:%d:f> (%s, %d runs)\n"
+	msgStatusRecovered  = "- 🟢 **Healthy**, recovered <t:%d:R> after failing for %s\n"
+	msgStatusHealthy    = "- 🟢 **Healthy**, no failures recorded\n"
+	msgStatusAffected   = "- Affected instances: **%d**\n"
+	msgStatusRootCause  = "- Root cause: **%s** (flagged in %d of %d runs)\n"
+	msgStatusLastCheck  = "- Last failing check: `%s`\n"
+	msgStatusLastRun    = "- Last scheduled run: <t:%d:R>\n"
+	msgStatusNoRun      = "- Last scheduled run: never recorded\n"
+	msgStatusNotReg     = "- Not registered in this server, so not run on a schedule\n"
+	msgStatusLastNotify = "- Last notified: <t:%d:R>\n"
+	msgStatusFooter     = "\nThis is the state as of the last run, use `/checks run` to check again now."
+)
+
+// getStatusCommandDefinition returns the '/checks status' subcommand definition.
+func (c *ChecksCommand) getStatusCommandDefinition(clientChoices []*discordgo.ApplicationCommandOptionChoice) *discordgo.ApplicationCommandOption {
+	return &discordgo.ApplicationCommandOption{
+		Name:        "status",
+		Description: "Show the last known state of a client, without running the checks again",
+		Type:        discordgo.ApplicationCommandOptionSubCommand,
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Name:         "network",
+				Description:  "Network to show the state on",
+				Type:         discordgo.ApplicationCommandOptionString,
+				Required:     true,
+				Autocomplete: true,
+			},
+			{
+				Name:        "client",
+				Description: "Client to show the state of",
+				Type:        discordgo.ApplicationCommandOptionString,
+				Required:    true,
+				Choices:     clientChoices,
+			},
+		},
+	}
+}
+
+// handleStatus handles the '/checks status' command. Everything is read from what earlier runs
+// persisted, Grafana isn't queried.
+func (c *ChecksCommand) handleStatus(
+	s *discordgo.Session,
+	i *discordgo.InteractionCreate,
+	data *discordgo.ApplicationCommandInteractionDataOption,
+) error {
+	var (
+		ctx             = context.Background()
+		network, client string
+	)
+
+	for _, opt := range data.Options {
+		switch opt.Name {
+		case "network":
+			network = opt.StringValue()
+		case "client":
+			client = opt.StringValue()
+		}
+	}
+
+	incident, err := c.bot.GetIncidentsRepo().Get(ctx, network, client)
+	if err != nil {
+		return fmt.Errorf("failed to get incident: %w", err)
+	}
+
+	alerts, err := c.listAlerts(ctx, i.GuildID, &network)
+	if err != nil {
+		return err
+	}
+
+	// When the client last ran on its schedule, only known if this guild registered it.
+	var (
+		alert   = c.getExistingAlert(alerts, network, client)
+		lastRun *store.JobRun
+	)
+
+	if alert != nil {
+		if lastRun, err = c.bot.GetJobRunsRepo().Get(ctx, c.bot.GetMonitorRepo().Key(alert)); err != nil {
+			return fmt.Errorf("failed to get last run: %w", err)
+		}
+	}
+
+	lastNotified, err := c.bot.GetNotificationsRepo().Get(ctx, network, client)
+	if err != nil {
+		return fmt.Errorf("failed to get last notification: %w", err)
+	}
+
+	return respondEphemeral(s, i, formatStatus(network, client, incident, alert != nil, lastRun, lastNotified, time.Now()))
+}
+
+// formatStatus formats the last known state of a client.
+func formatStatus(
+	network, client string,
+	incident *store.Incident,
+	registered bool,
+	lastRun *store.JobRun,
+	lastNotified *store.LastNotification,
+	now time.Time,
+) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, msgStatusHeader, client, network)
+
+	switch {
+	case incident == nil:
+		sb.WriteString(msgStatusHealthy)
+	case incident.IsOpen():
+		fmt.Fprintf(&sb, msgStatusFailing, incident.StartedAt.Unix(), incident.Duration(now).Truncate(time.Minute), incident.Runs)
+		fmt.Fprintf(&sb, msgStatusAffected, len(incident.AffectedInstances))
+
+		rootCause := "no"
+		if incident.IsRootCause {
+			rootCause = "yes"
+		}
+
+		fmt.Fprintf(&sb, msgStatusRootCause, rootCause, incident.RootCauseRuns, incident.Runs)
+
+		if incident.LastCheckID != "" {
+			fmt.Fprintf(&sb, msgStatusLastCheck, incident.LastCheckID)
+		}
+	default:
+		fmt.Fprintf(&sb, msgStatusRecovered, incident.ResolvedAt.Unix(), incident.Duration(now).Truncate(time.Minute))
+	}
+
+	switch {
+	case !registered:
+		sb.WriteString(msgStatusNotReg)
+	case lastRun == nil:
+		sb.WriteString(msgStatusNoRun)
+	default:
+		fmt.Fprintf(&sb, msgStatusLastRun, lastRun.LastSuccess.Unix())
+	}
+
+	if lastNotified != nil {
+		fmt.Fprintf(&sb, msgStatusLastNotify, lastNotified.NotifiedAt.Unix())
+	}
+
+	sb.WriteString(msgStatusFooter)
+
+	return sb.String()
+}
@@ -0,0 +1,221 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/ethpandaops/panda-pulse/pkg/checks"
+	"github.com/ethpandaops/panda-pulse/pkg/store"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	msgThresholdSet      = "✅ Set **%s** to **%s** on **%s**\n\n%s"
+	msgThresholdCleared  = "✅ Cleared the **%s** override on **%s**\n\n%s"
+	msgThresholdNotSet   = "ℹ️ **%s** has no **%s** override\n\n%s"
+	msgThresholdInvalid  = "🚫 %v"
+	msgThresholdsHeader  = "🎚️ Effective thresholds for **%s**\n"
+	msgThresholdOverride = "- %s: **%s** (override)"
+	msgThresholdDefault  = "- %s: %s"
+)
+
+// getSetThresholdCommandDefinition returns the '/checks set-threshold' subcommand definition.
+func (c *ChecksCommand) getSetThresholdCommandDefinition() *discordgo.ApplicationCommandOption {
+	names := checks.ThresholdNames()
+	choices := make([]*discordgo.ApplicationCommandOptionChoice, 0, len(names))
+
+	for _, name := range names {
+		choices = append(choices, &discordgo.ApplicationCommandOptionChoice{
+			Name:  name,
+			Value: name,
+		})
+	}
+
+	return &discordgo.ApplicationCommandOption{
+		Name:        "set-threshold",
+		Description: "Override a check threshold for a network (admin)",
+		Type:        discordgo.ApplicationCommandOptionSubCommand,
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Name:         "network",
+				Description:  "Network to override the threshold for",
+				Type:         discordgo.ApplicationCommandOptionString,
+				Required:     true,
+				Autocomplete: true,
+			},
+			{
+				Name:        "threshold",
+				Description: "Threshold to override",
+				Type:        discordgo.ApplicationCommandOptionString,
+				Required:    true,
+				Choices:     choices,
+			},
+			{
+				Name:        "value",
+				Description: "New value, a whole number or a duration such as 10m (omit to clear the override)",
+				Type:        discordgo.ApplicationCommandOptionString,
+				Required:    false,
+			},
+		},
+	}
+}
+
+// handleSetThreshold handles the '/checks set-threshold' command.
+func (c *ChecksCommand) handleSetThreshold(
+	s *discordgo.Session,
+	i *discordgo.InteractionCreate,
+	data *discordgo.ApplicationCommandInteractionDataOption,
+) error {
+	var (
+		ctx                  = context.Background()
+		network, name, value string
+		repo                 = c.bot.GetThresholdsRepo()
+	)
+
+	for _, opt := range data.Options {
+		switch opt.Name {
+		case "network":
+			network = opt.StringValue()
+		case "threshold":
+			name = opt.StringValue()
+		case "value":
+			value = strings.TrimSpace(opt.StringValue())
+		}
+	}
+
+	// Validate up front, an override that can't be applied would otherwise be silently ignored.
+	if value != "" {
+		if _, err := (checks.Thresholds{}).WithOverride(name, value); err != nil {
+			return respondEphemeral(s, i, fmt.Sprintf(msgThresholdInvalid, err))
+		}
+	}
+
+	record, err := repo.Get(ctx, network)
+	if err != nil {
+		return fmt.Errorf("failed to get thresholds: %w", err)
+	}
+
+	if record == nil {
+		record = &store.NetworkThresholds{Network: network}
+	}
+
+	if record.Overrides == nil {
+		record.Overrides = make(map[string]string)
+	}
+
+	if value == "" {
+		if _, ok := record.Overrides[name]; !ok {
+			return respondEphemeral(s, i, fmt.Sprintf(msgThresholdNotSet, network, name, c.formatThresholds(network, record.Overrides)))
+		}
+
+		delete(record.Overrides, name)
+	} else {
+		record.Overrides[name] = value
+	}
+
+	record.UpdatedAt = time.Now()
+
+	if i.Member != nil && i.Member.User != nil {
+		record.UpdatedBy = i.Member.User.ID
+	}
+
+	// Drop the record entirely once the last override is cleared.
+	if len(record.Overrides) == 0 {
+		if perr := repo.Purge(ctx, network); perr != nil {
+			return fmt.Errorf("failed to purge thresholds: %w", perr)
+		}
+	} else if perr := repo.Persist(ctx, record); perr != nil {
+		return fmt.Errorf("failed to persist thresholds: %w", perr)
+	}
+
+	c.log.WithFields(logrus.Fields{
+		"network":   network,
+		"threshold": name,
+		"value":     value,
+	}).Info("Updated check threshold")
+
+	summary := c.formatThresholds(network, record.Overrides)
+
+	if value == "" {
+		return respondEphemeral(s, i, fmt.Sprintf(msgThresholdCleared, name, network, summary))
+	}
+
+	return respondEphemeral(s, i, fmt.Sprintf(msgThresholdSet, name, value, network, summary))
+}
+
+// networkThresholds returns the thresholds to run a network's checks with, the configured defaults
+// with the network's overrides applied. Lookup failures and invalid overrides are logged and fall
+// back to the defaults, a stale threshold is better than a skipped run.
+func (c *ChecksCommand) networkThresholds(ctx context.Context, network string) checks.Thresholds {
+	thresholds := c.defaultThresholds()
+
+	repo := c.bot.GetThresholdsRepo()
+	if repo == nil {
+		return thresholds
+	}
+
+	record, err := repo.Get(ctx, network)
+	if err != nil {
+		c.log.WithError(err).WithField("network", network).Warn("Failed to get threshold overrides, using defaults")
+
+		return thresholds
+	}
+
+	if record == nil {
+		return thresholds
+	}
+
+	return c.applyOverrides(network, thresholds, record.Overrides)
+}
+
+// defaultThresholds returns the thresholds to use when a network has no overrides.
+func (c *ChecksCommand) defaultThresholds() checks.Thresholds {
+	return checks.Thresholds{
+		Flapping: checks.FlappingConfig{
+			Window:    c.config.FlappingWindow,
+			Threshold: c.config.FlappingThreshold,
+		},
+	}
+}
+
+// applyOverrides applies overrides on top of thresholds, skipping any that are invalid.
+func (c *ChecksCommand) applyOverrides(network string, thresholds checks.Thresholds, overrides map[string]string) checks.Thresholds {
+	for _, name := range slices.Sorted(maps.Keys(overrides)) {
+		updated, err := thresholds.WithOverride(name, overrides[name])
+		if err != nil {
+			c.log.WithError(err).WithField("network", network).Warn("Ignoring invalid threshold override")
+
+			continue
+		}
+
+		thresholds = updated
+	}
+
+	return thresholds
+}
+
+// formatThresholds describes the effective thresholds for a network, marking which are overridden.
+func (c *ChecksCommand) formatThresholds(network string, overrides map[string]string) string {
+	var (
+		sb         strings.Builder
+		thresholds = c.applyOverrides(network, c.defaultThresholds(), overrides)
+	)
+
+	sb.WriteString(fmt.Sprintf(msgThresholdsHeader, network))
+
+	for _, name := range checks.ThresholdNames() {
+		format := msgThresholdDefault
+		if _, ok := overrides[name]; ok {
+			format = msgThresholdOverride
+		}
+
+		sb.WriteString(fmt.Sprintf(format, name, thresholds.Value(name)) + "\n")
+	}
+
+	return sb.String()
+}
@@ -15,6 +15,7 @@ type categoryResults struct {
 var orderedCategories = []checks.Category{
 	checks.CategoryGeneral,
 	checks.CategorySync,
+	checks.CategoryConsensus,
 }
 
 // Helper to create string pointer.
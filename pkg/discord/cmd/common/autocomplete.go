@@ -59,6 +59,12 @@ func (h *AutocompleteHandler) HandleNetworkAutocomplete(s *discordgo.Session, i
 // findFocusedOption finds the currently focused option in the interaction data.
 func (h *AutocompleteHandler) findFocusedOption(options []*discordgo.ApplicationCommandInteractionDataOption) *discordgo.ApplicationCommandInteractionDataOption {
 	for _, option := range options {
+		if option.Type == discordgo.ApplicationCommandOptionSubCommandGroup {
+			if focused := h.findFocusedOption(option.Options); focused != nil {
+				return focused
+			}
+		}
+
 		if option.Type == discordgo.ApplicationCommandOptionSubCommand {
 			for _, subOption := range option.Options {
 				if subOption.Focused {
@@ -0,0 +1,108 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// maxMessageLength is Discord's limit on the length of a message's content.
+const maxMessageLength = 2000
+
+// BulkOutcome is the result of registering a single network as part of a bulk registration.
+type BulkOutcome struct {
+	Network    string
+	SkipReason string // Set if the network was skipped.
+	Err        error  // Set if registering the network failed.
+}
+
+// FormatBulkOutcomes formats the per-network outcomes of a bulk registration, keeping the message
+// within Discord's length limit.
+func FormatBulkOutcomes(header string, outcomes []BulkOutcome) string {
+	var (
+		msg                         strings.Builder
+		registered, skipped, failed int
+	)
+
+	lines := make([]string, 0, len(outcomes))
+
+	for _, outcome := range outcomes {
+		switch {
+		case outcome.Err != nil:
+			failed++
+
+			lines = append(lines, fmt.Sprintf("❌ **%s**: %v", outcome.Network, outcome.Err))
+		case outcome.SkipReason != "":
+			skipped++
+
+			lines = append(lines, fmt.Sprintf("⏭️ **%s**: %s", outcome.Network, outcome.SkipReason))
+		default:
+			registered++
+
+			lines = append(lines, fmt.Sprintf("✅ **%s**", outcome.Network))
+		}
+	}
+
+	summary := fmt.Sprintf("\n%d registered, %d skipped, %d failed", registered, skipped, failed)
+
+	msg.WriteString(header)
+	msg.WriteString("\n")
+
+	for idx, line := range lines {
+		// Leave room for the summary and a truncation note.
+		if msg.Len()+len(line)+len(summary)+32 > maxMessageLength {
+			fmt.Fprintf(&msg, "… and %d more\n", len(lines)-idx)
+
+			break
+		}
+
+		msg.WriteString(line)
+		msg.WriteString("\n")
+	}
+
+	msg.WriteString(summary)
+
+	return msg.String()
+}
+
+// RunBulk runs fn for each network using at most concurrency workers, returning the outcomes in
+// the order of networks. If ctx is cancelled, in-flight calls are left to wind down through ctx and
+// networks that haven't started are reported as failed, so partial results can still be reported.
+func RunBulk(
+	ctx context.Context,
+	networks []string,
+	concurrency int,
+	fn func(ctx context.Context, network string) BulkOutcome,
+) []BulkOutcome {
+	var (
+		outcomes = make([]BulkOutcome, len(networks))
+		jobs     = make(chan int)
+		wg       sync.WaitGroup
+	)
+
+	for range max(1, min(concurrency, len(networks))) {
+		wg.Go(func() {
+			for idx := range jobs {
+				outcomes[idx] = fn(ctx, networks[idx])
+			}
+		})
+	}
+
+	for idx, network := range networks {
+		if ctx.Err() == nil {
+			select {
+			case jobs <- idx:
+				continue
+			case <-ctx.Done():
+			}
+		}
+
+		outcomes[idx] = BulkOutcome{Network: network, Err: fmt.Errorf("not started: %w", ctx.Err())}
+	}
+
+	close(jobs)
+	wg.Wait()
+
+	return outcomes
+}
@@ -0,0 +1,104 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatBulkOutcomes(t *testing.T) {
+	t.Run("reports each outcome", func(t *testing.T) {
+		msg := FormatBulkOutcomes("header", []BulkOutcome{
+			{Network: "devnet-0"},
+			{Network: "devnet-1", SkipReason: "already registered"},
+			{Network: "devnet-2", Err: errors.New("boom")},
+		})
+
+		assert.True(t, strings.HasPrefix(msg, "header\n"))
+		assert.Contains(t, msg, "✅ **devnet-0**")
+		assert.Contains(t, msg, "⏭️ **devnet-1**: already registered")
+		assert.Contains(t, msg, "❌ **devnet-2**: boom")
+		assert.Contains(t, msg, "1 registered, 1 skipped, 1 failed")
+	})
+
+	t.Run("truncates to the discord message limit", func(t *testing.T) {
+		outcomes := make([]BulkOutcome, 0, 200)
+		for i := range 200 {
+			outcomes = append(outcomes, BulkOutcome{Network: fmt.Sprintf("some-long-devnet-name-%d", i)})
+		}
+
+		msg := FormatBulkOutcomes("header", outcomes)
+
+		assert.LessOrEqual(t, len(msg), maxMessageLength)
+		assert.Contains(t, msg, "more")
+		assert.Contains(t, msg, "200 registered, 0 skipped, 0 failed")
+	})
+}
+
+func TestRunBulk(t *testing.T) {
+	networks := []string{"devnet-0", "devnet-1", "devnet-2", "devnet-3", "devnet-4", "devnet-5"}
+
+	t.Run("keeps network order and limits concurrency", func(t *testing.T) {
+		var active, peak atomic.Int32
+
+		outcomes := RunBulk(context.Background(), networks, 2, func(_ context.Context, network string) BulkOutcome {
+			n := active.Add(1)
+			defer active.Add(-1)
+
+			for {
+				p := peak.Load()
+				if n <= p || peak.CompareAndSwap(p, n) {
+					break
+				}
+			}
+
+			time.Sleep(10 * time.Millisecond)
+
+			return BulkOutcome{Network: network}
+		})
+
+		require.Len(t, outcomes, len(networks))
+
+		for idx, outcome := range outcomes {
+			assert.Equal(t, networks[idx], outcome.Network)
+			assert.NoError(t, outcome.Err)
+		}
+
+		assert.LessOrEqual(t, peak.Load(), int32(2))
+	})
+
+	t.Run("reports partial results when cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		outcomes := RunBulk(ctx, networks, 1, func(_ context.Context, network string) BulkOutcome {
+			if network == "devnet-1" {
+				cancel()
+			}
+
+			return BulkOutcome{Network: network}
+		})
+
+		require.Len(t, outcomes, len(networks))
+		assert.NoError(t, outcomes[0].Err)
+		assert.NoError(t, outcomes[1].Err)
+
+		for _, outcome := range outcomes[3:] {
+			require.Error(t, outcome.Err)
+			assert.ErrorIs(t, outcome.Err, context.Canceled)
+		}
+	})
+
+	t.Run("no networks", func(t *testing.T) {
+		assert.Empty(t, RunBulk(context.Background(), nil, 3, func(_ context.Context, network string) BulkOutcome {
+			return BulkOutcome{Network: network}
+		}))
+	})
+}
@@ -0,0 +1,26 @@
+package common
+
+import (
+	"errors"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// ChannelProblem classifies an error from looking up a channel, returning why alerts can't be
+// delivered to it. Errors that don't say anything about the channel itself, such as timeouts,
+// return an empty string so the alert isn't flagged on a transient failure.
+func ChannelProblem(err error) string {
+	var restErr *discordgo.RESTError
+	if !errors.As(err, &restErr) || restErr.Message == nil {
+		return ""
+	}
+
+	switch restErr.Message.Code {
+	case discordgo.ErrCodeUnknownChannel:
+		return "channel deleted"
+	case discordgo.ErrCodeMissingAccess, discordgo.ErrCodeMissingPermissions:
+		return "no access to channel"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,38 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/assert"
+)
+
+// restError builds a discordgo REST error carrying the given Discord error code.
+func restError(code int) error {
+	return &discordgo.RESTError{Message: &discordgo.APIErrorMessage{Code: code}}
+}
+
+func TestChannelProblem(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{name: "no error", err: nil, want: ""},
+		{name: "unknown channel", err: restError(discordgo.ErrCodeUnknownChannel), want: "channel deleted"},
+		{name: "missing access", err: restError(discordgo.ErrCodeMissingAccess), want: "no access to channel"},
+		{name: "missing permissions", err: restError(discordgo.ErrCodeMissingPermissions), want: "no access to channel"},
+		{name: "wrapped unknown channel", err: fmt.Errorf("lookup: %w", restError(discordgo.ErrCodeUnknownChannel)), want: "channel deleted"},
+		{name: "other rest error", err: restError(discordgo.ErrCodeUnknownGuild), want: ""},
+		{name: "rest error without message", err: &discordgo.RESTError{}, want: ""},
+		{name: "transient error", err: errors.New("context deadline exceeded"), want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ChannelProblem(tt.err))
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/ethpandaops/panda-pulse/pkg/store"
+	"github.com/robfig/cron/v3"
+	"github.com/sirupsen/logrus"
+)
+
+// Guild config settings that can be overridden per guild.
+const (
+	GuildConfigGrafanaURL     = "grafana-url"
+	GuildConfigSSHTemplate    = "ssh-template"
+	GuildConfigChecksSchedule = "checks-schedule"
+	GuildConfigHiveSchedule   = "hive-schedule"
+)
+
+// guildConfigKeys is the order guild config settings are listed in.
+var guildConfigKeys = []string{
+	GuildConfigGrafanaURL,
+	GuildConfigSSHTemplate,
+	GuildConfigChecksSchedule,
+	GuildConfigHiveSchedule,
+}
+
+// GuildConfigKeys returns the names of the settings that can be overridden per guild.
+func GuildConfigKeys() []string {
+	return append([]string(nil), guildConfigKeys...)
+}
+
+// ValidateGuildConfig checks value is usable for the named setting.
+func ValidateGuildConfig(key, value string) error {
+	switch key {
+	case GuildConfigGrafanaURL:
+		u, err := url.Parse(value)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("%s must be an http(s) URL", key)
+		}
+	case GuildConfigSSHTemplate:
+		if !strings.Contains(value, "{instance}") {
+			return fmt.Errorf("%s must include the {instance} placeholder", key)
+		}
+	case GuildConfigChecksSchedule, GuildConfigHiveSchedule:
+		if _, err := cron.ParseStandard(value); err != nil {
+			return fmt.Errorf("%s must be a cron schedule: %w", key, err)
+		}
+	default:
+		return fmt.Errorf("unknown guild config setting: %s", key)
+	}
+
+	return nil
+}
+
+// GuildOverrides are a guild's overrides of the global config, keyed by setting name.
+type GuildOverrides map[string]string
+
+// Get returns the guild's override for the setting, or fallback if it has none.
+func (o GuildOverrides) Get(key, fallback string) string {
+	if value, ok := o[key]; ok && value != "" {
+		return value
+	}
+
+	return fallback
+}
+
+// LoadGuildOverrides returns a guild's config overrides. Lookup failures are logged and treated as
+// no overrides, the global config is always a usable fallback.
+func LoadGuildOverrides(ctx context.Context, log logrus.FieldLogger, repo *store.GuildConfigRepo, guildID string) GuildOverrides {
+	if repo == nil || guildID == "" {
+		return nil
+	}
+
+	record, err := repo.Get(ctx, guildID)
+	if err != nil {
+		log.WithError(err).WithField("guild", guildID).Warn("Failed to get guild config, using global config")
+
+		return nil
+	}
+
+	if record == nil {
+		return nil
+	}
+
+	return record.Overrides
+}
@@ -0,0 +1,51 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateGuildConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		key     string
+		value   string
+		wantErr string
+	}{
+		{name: "grafana url", key: GuildConfigGrafanaURL, value: "https://grafana.example.com"},
+		{name: "grafana url without scheme", key: GuildConfigGrafanaURL, value: "grafana.example.com", wantErr: "http(s) URL"},
+		{name: "ssh template", key: GuildConfigSSHTemplate, value: "ssh root@{instance}.{network}.example.com"},
+		{name: "ssh template without instance", key: GuildConfigSSHTemplate, value: "ssh root@{network}.example.com", wantErr: "{instance}"},
+		{name: "checks schedule", key: GuildConfigChecksSchedule, value: "0 9 * * *"},
+		{name: "invalid hive schedule", key: GuildConfigHiveSchedule, value: "daily", wantErr: "cron schedule"},
+		{name: "unknown setting", key: "theme", value: "dark", wantErr: "unknown guild config setting"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateGuildConfig(tt.key, tt.value)
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+
+				return
+			}
+
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestGuildOverrides_Get(t *testing.T) {
+	overrides := GuildOverrides{
+		GuildConfigGrafanaURL:     "https://grafana.example.com",
+		GuildConfigChecksSchedule: "",
+	}
+
+	assert.Equal(t, "https://grafana.example.com", overrides.Get(GuildConfigGrafanaURL, "https://default"))
+	assert.Equal(t, "0 7 * * *", overrides.Get(GuildConfigChecksSchedule, "0 7 * * *"))
+	assert.Equal(t, "fallback", overrides.Get(GuildConfigSSHTemplate, "fallback"))
+	assert.Equal(t, "fallback", GuildOverrides(nil).Get(GuildConfigSSHTemplate, "fallback"))
+}
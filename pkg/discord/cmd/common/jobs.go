@@ -0,0 +1,31 @@
+package common
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethpandaops/panda-pulse/pkg/store"
+	"github.com/sirupsen/logrus"
+)
+
+// jobRunPersistTimeout bounds recording a job run, detached from the run itself.
+const jobRunPersistTimeout = 30 * time.Second
+
+// RecordJobRun records that a scheduled job just completed successfully, so runs missed while the
+// bot was down can be caught up on startup. Failures are logged, not returned, the run itself
+// already succeeded.
+func RecordJobRun(ctx context.Context, log logrus.FieldLogger, repo *store.JobRunsRepo, job string) {
+	if repo == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), jobRunPersistTimeout)
+	defer cancel()
+
+	if err := repo.Persist(ctx, &store.JobRun{
+		Job:         job,
+		LastSuccess: time.Now().UTC(),
+	}); err != nil {
+		log.WithError(err).WithField("job", job).Warn("Failed to record job run")
+	}
+}
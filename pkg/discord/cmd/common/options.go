@@ -0,0 +1,96 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// msgOutOfDate is appended to option errors, the usual cause being a command whose definition
+// failed to refresh and so is out of step with what the handlers expect.
+const msgOutOfDate = ", the command may be out of date. Try again in a minute"
+
+// ErrNoSubcommand is returned by ValidateOptions when the interaction has no subcommand.
+var ErrNoSubcommand = errors.New("🚫 No subcommand was given" + msgOutOfDate)
+
+// MissingOptionsError is returned by ValidateOptions when required options weren't given.
+type MissingOptionsError struct {
+	Subcommand string
+	Options    []string
+}
+
+// Error implements error.
+func (e *MissingOptionsError) Error() string {
+	return fmt.Sprintf("🚫 `%s` is missing the `%s` option%s", e.Subcommand, strings.Join(e.Options, "`, `"), msgOutOfDate)
+}
+
+// ValidateOptions checks the interaction has a subcommand, and every option the command's
+// definition marks required for it, so handlers can rely on them being there. The error is fit to
+// show the user. Subcommands the definition doesn't know of are left to the handler.
+func ValidateOptions(definition *discordgo.ApplicationCommand, data *discordgo.ApplicationCommandInteractionData) error {
+	if data == nil || len(data.Options) == 0 || data.Options[0] == nil {
+		return ErrNoSubcommand
+	}
+
+	return validateSubcommand(definition.Options, data.Options[0], data.Options[0].Name)
+}
+
+// validateSubcommand checks the sent subcommand against its definition among defined, descending
+// into subcommand groups.
+func validateSubcommand(
+	defined []*discordgo.ApplicationCommandOption,
+	sent *discordgo.ApplicationCommandInteractionDataOption,
+	path string,
+) error {
+	var definition *discordgo.ApplicationCommandOption
+
+	for _, option := range defined {
+		if option.Name == sent.Name {
+			definition = option
+
+			break
+		}
+	}
+
+	if definition == nil {
+		return nil
+	}
+
+	if definition.Type == discordgo.ApplicationCommandOptionSubCommandGroup {
+		if len(sent.Options) == 0 || sent.Options[0] == nil {
+			return ErrNoSubcommand
+		}
+
+		return validateSubcommand(definition.Options, sent.Options[0], path+" "+sent.Options[0].Name)
+	}
+
+	missing := make([]string, 0)
+
+	for _, option := range definition.Options {
+		if option.Required && FindOption(sent.Options, option.Name) == nil {
+			missing = append(missing, option.Name)
+		}
+	}
+
+	if len(missing) > 0 {
+		return &MissingOptionsError{Subcommand: path, Options: missing}
+	}
+
+	return nil
+}
+
+// FindOption returns the named option, or nil if it wasn't given.
+func FindOption(
+	options []*discordgo.ApplicationCommandInteractionDataOption,
+	name string,
+) *discordgo.ApplicationCommandInteractionDataOption {
+	for _, option := range options {
+		if option != nil && option.Name == name {
+			return option
+		}
+	}
+
+	return nil
+}
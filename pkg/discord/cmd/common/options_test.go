@@ -0,0 +1,93 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateOptions(t *testing.T) {
+	definition := &discordgo.ApplicationCommand{
+		Name: "checks",
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Name: "register",
+				Type: discordgo.ApplicationCommandOptionSubCommand,
+				Options: []*discordgo.ApplicationCommandOption{
+					{Name: "network", Type: discordgo.ApplicationCommandOptionString, Required: true},
+					{Name: "channel", Type: discordgo.ApplicationCommandOptionChannel, Required: true},
+					{Name: "client", Type: discordgo.ApplicationCommandOptionString},
+				},
+			},
+			{
+				Name: "route",
+				Type: discordgo.ApplicationCommandOptionSubCommandGroup,
+				Options: []*discordgo.ApplicationCommandOption{
+					{
+						Name: "add",
+						Type: discordgo.ApplicationCommandOptionSubCommand,
+						Options: []*discordgo.ApplicationCommandOption{
+							{Name: "network", Type: discordgo.ApplicationCommandOptionString, Required: true},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	option := func(name string, options ...*discordgo.ApplicationCommandInteractionDataOption) *discordgo.ApplicationCommandInteractionDataOption {
+		return &discordgo.ApplicationCommandInteractionDataOption{Name: name, Options: options}
+	}
+
+	data := func(options ...*discordgo.ApplicationCommandInteractionDataOption) *discordgo.ApplicationCommandInteractionData {
+		return &discordgo.ApplicationCommandInteractionData{Name: "checks", Options: options}
+	}
+
+	t.Run("complete", func(t *testing.T) {
+		require.NoError(t, ValidateOptions(definition, data(option("register", option("network"), option("channel")))))
+		require.NoError(t, ValidateOptions(definition, data(option("register", option("channel"), option("network"), option("client")))))
+		require.NoError(t, ValidateOptions(definition, data(option("route", option("add", option("network"))))))
+	})
+
+	t.Run("no subcommand", func(t *testing.T) {
+		require.ErrorIs(t, ValidateOptions(definition, nil), ErrNoSubcommand)
+		require.ErrorIs(t, ValidateOptions(definition, data()), ErrNoSubcommand)
+		require.ErrorIs(t, ValidateOptions(definition, data(option("route"))), ErrNoSubcommand)
+	})
+
+	t.Run("truncated options", func(t *testing.T) {
+		var missing *MissingOptionsError
+
+		err := ValidateOptions(definition, data(option("register", option("network"))))
+		require.ErrorAs(t, err, &missing)
+		assert.Equal(t, []string{"channel"}, missing.Options)
+
+		err = ValidateOptions(definition, data(option("register")))
+		require.ErrorAs(t, err, &missing)
+		assert.Equal(t, "register", missing.Subcommand)
+		assert.Equal(t, []string{"network", "channel"}, missing.Options)
+		assert.Contains(t, err.Error(), "`network`, `channel`")
+
+		err = ValidateOptions(definition, data(option("route", option("add"))))
+		require.ErrorAs(t, err, &missing)
+		assert.Equal(t, "route add", missing.Subcommand)
+	})
+
+	t.Run("unknown subcommand", func(t *testing.T) {
+		require.NoError(t, ValidateOptions(definition, data(option("unknown"))))
+	})
+}
+
+func TestFindOption(t *testing.T) {
+	options := []*discordgo.ApplicationCommandInteractionDataOption{
+		nil,
+		{Name: "network", Type: discordgo.ApplicationCommandOptionString, Value: "devnet-0"},
+	}
+
+	require.NotNil(t, FindOption(options, "network"))
+	assert.Equal(t, "devnet-0", FindOption(options, "network").StringValue())
+	assert.Nil(t, FindOption(options, "client"))
+	assert.Nil(t, FindOption(nil, "client"))
+}
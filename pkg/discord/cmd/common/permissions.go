@@ -0,0 +1,122 @@
+package common
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// PermissionLevel is who may run a command.
+type PermissionLevel string
+
+const (
+	// PermissionEveryone lets anyone run the command.
+	PermissionEveryone PermissionLevel = "everyone"
+	// PermissionTeam lets admins, or the client's team when the command takes a client, run the command.
+	PermissionTeam PermissionLevel = "team"
+	// PermissionAdmin lets only admins run the command, even when it takes a client.
+	PermissionAdmin PermissionLevel = "admin"
+)
+
+// defaultPermissions are the read-only subcommands anyone can run. Anything not listed mutates
+// state or posts to channels, so falls back to PermissionTeam.
+var defaultPermissions = map[string]PermissionLevel{
+	"checks list":        PermissionEveryone,
+	"checks debug":       PermissionEveryone,
+	"checks incidents":   PermissionEveryone,
+	"checks root-causes": PermissionEveryone,
+	"checks status":      PermissionEveryone,
+	"checks overview":    PermissionEveryone,
+	"build info":         PermissionEveryone,
+	"hive list":          PermissionEveryone,
+	"hive regressions":   PermissionEveryone,
+	"hive problems":      PermissionEveryone,
+	"hive failures":      PermissionEveryone,
+	"hive check-mapping": PermissionEveryone,
+	"mentions list":      PermissionEveryone,
+}
+
+// DefaultPermissions returns the default permission level of each subcommand that differs from
+// PermissionTeam, keyed by "command subcommand".
+func DefaultPermissions() map[string]PermissionLevel {
+	return maps.Clone(defaultPermissions)
+}
+
+// ParsePermissions parses a comma-separated list of "command subcommand=level" overrides, such as
+// "checks debug=admin,hive run=everyone". A bare command applies to all of its subcommands.
+func ParsePermissions(value string) (map[string]PermissionLevel, error) {
+	permissions := make(map[string]PermissionLevel)
+
+	for entry := range strings.SplitSeq(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		key, level, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid permission %q, expected command subcommand=level", entry)
+		}
+
+		key = strings.Join(strings.Fields(strings.ToLower(key)), " ")
+		if key == "" {
+			return nil, fmt.Errorf("invalid permission %q, missing command", entry)
+		}
+
+		permission := PermissionLevel(strings.ToLower(strings.TrimSpace(level)))
+		if !slices.Contains([]PermissionLevel{PermissionEveryone, PermissionTeam, PermissionAdmin}, permission) {
+			return nil, fmt.Errorf("invalid permission level %q for %s, expected everyone, team or admin", level, key)
+		}
+
+		permissions[key] = permission
+	}
+
+	return permissions, nil
+}
+
+// RequiredPermission returns who may run the subcommand, preferring an override for the
+// subcommand, then one for the whole command, then the default.
+func (c *RoleConfig) RequiredPermission(command, subcommand string) PermissionLevel {
+	key := command + " " + subcommand
+
+	if level, ok := c.Permissions[key]; ok {
+		return level
+	}
+
+	if level, ok := c.Permissions[command]; ok {
+		return level
+	}
+
+	if level, ok := defaultPermissions[key]; ok {
+		return level
+	}
+
+	return PermissionTeam
+}
+
+// IsAllowed checks if a member may run the command, based on the permission level its subcommand requires.
+func IsAllowed(
+	member *discordgo.Member,
+	session *discordgo.Session,
+	guildID string,
+	config *RoleConfig,
+	command string,
+	cmdData *discordgo.ApplicationCommandInteractionData,
+) bool {
+	var subcommand string
+	if cmdData != nil && len(cmdData.Options) > 0 {
+		subcommand = cmdData.Options[0].Name
+	}
+
+	switch config.RequiredPermission(command, subcommand) {
+	case PermissionEveryone:
+		return true
+	case PermissionAdmin:
+		return member != nil && isAdmin(member, session, guildID, config)
+	default:
+		return member != nil && HasPermission(member, session, guildID, config, cmdData)
+	}
+}
@@ -0,0 +1,139 @@
+package common
+
+import (
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newCmdData creates interaction data for a subcommand without a client option.
+func newCmdData(subcommand string) *discordgo.ApplicationCommandInteractionData {
+	return &discordgo.ApplicationCommandInteractionData{
+		Options: []*discordgo.ApplicationCommandInteractionDataOption{
+			{Name: subcommand},
+		},
+	}
+}
+
+func TestParsePermissions(t *testing.T) {
+	permissions, err := ParsePermissions(" checks debug=Admin, HIVE  run=everyone,,mentions=team ")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]PermissionLevel{
+		"checks debug": PermissionAdmin,
+		"hive run":     PermissionEveryone,
+		"mentions":     PermissionTeam,
+	}, permissions)
+
+	permissions, err = ParsePermissions("")
+	require.NoError(t, err)
+	assert.Empty(t, permissions)
+
+	for _, invalid := range []string{"checks debug", "=admin", "checks debug=owner"} {
+		_, err = ParsePermissions(invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestRequiredPermission(t *testing.T) {
+	config := &RoleConfig{
+		Permissions: map[string]PermissionLevel{
+			"checks debug": PermissionAdmin,
+			"hive":         PermissionEveryone,
+			"hive run":     PermissionTeam,
+		},
+	}
+
+	tests := []struct {
+		command, subcommand string
+		want                PermissionLevel
+	}{
+		{command: "checks", subcommand: "list", want: PermissionEveryone},
+		{command: "checks", subcommand: "register", want: PermissionTeam},
+		{command: "checks", subcommand: "debug", want: PermissionAdmin},
+		{command: "hive", subcommand: "register", want: PermissionEveryone},
+		{command: "hive", subcommand: "run", want: PermissionTeam},
+		{command: "admin", subcommand: "maintenance", want: PermissionTeam},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, config.RequiredPermission(tt.command, tt.subcommand), tt.command+" "+tt.subcommand)
+	}
+
+	for key, level := range DefaultPermissions() {
+		assert.Equal(t, PermissionEveryone, level, key)
+	}
+}
+
+func TestDefaultPermissions_ReadOnly(t *testing.T) {
+	// Every registered read-only subcommand. A new one belongs here and in defaultPermissions,
+	// otherwise it silently falls back to PermissionTeam.
+	readOnly := []string{
+		"build info",
+		"checks debug",
+		"checks incidents",
+		"checks list",
+		"checks overview",
+		"checks root-causes",
+		"checks status",
+		"hive check-mapping",
+		"hive failures",
+		"hive list",
+		"hive problems",
+		"hive regressions",
+		"mentions list",
+	}
+
+	config := &RoleConfig{}
+
+	for _, key := range readOnly {
+		command, subcommand, _ := strings.Cut(key, " ")
+		assert.Equal(t, PermissionEveryone, config.RequiredPermission(command, subcommand), key)
+	}
+
+	assert.ElementsMatch(t, readOnly, slices.Collect(maps.Keys(DefaultPermissions())))
+}
+
+func TestIsAllowed(t *testing.T) {
+	config := &RoleConfig{
+		AdminRoles:  map[string]bool{"ef": true},
+		ClientRoles: map[string][]string{"lighthouse": {"sigmaprime"}},
+		Permissions: map[string]PermissionLevel{"checks run": PermissionAdmin},
+	}
+
+	session := newTestSession(t, []*discordgo.Role{
+		{ID: "role-admin", Name: "EF"},
+		{ID: "role-team", Name: "sigmaprime"},
+	})
+
+	var (
+		admin    = newMember("role-admin")
+		team     = newMember("role-team")
+		everyone = newMember()
+	)
+
+	t.Run("read-only subcommands are open to everyone", func(t *testing.T) {
+		assert.True(t, IsAllowed(everyone, session, testGuildID, config, "checks", newCmdData("list")))
+		assert.True(t, IsAllowed(nil, session, testGuildID, config, "checks", newCmdData("debug")))
+	})
+
+	t.Run("mutating subcommands keep the role checks", func(t *testing.T) {
+		assert.False(t, IsAllowed(everyone, session, testGuildID, config, "checks", newCmdData("register")))
+		assert.False(t, IsAllowed(nil, session, testGuildID, config, "checks", newCmdData("register")))
+		assert.True(t, IsAllowed(admin, session, testGuildID, config, "checks", newCmdData("register")))
+
+		cmdData := newCmdDataWithClient("lighthouse")
+		cmdData.Options[0].Name = "register"
+		assert.True(t, IsAllowed(team, session, testGuildID, config, "checks", cmdData))
+	})
+
+	t.Run("admin only subcommands ignore team roles", func(t *testing.T) {
+		cmdData := newCmdDataWithClient("lighthouse")
+		assert.False(t, IsAllowed(team, session, testGuildID, config, "checks", cmdData))
+		assert.True(t, IsAllowed(admin, session, testGuildID, config, "checks", cmdData))
+	})
+}
@@ -0,0 +1,78 @@
+package common
+
+import (
+	"sync"
+	"time"
+)
+
+// ChannelRateLimiter caps how many messages are posted to each channel, using a token bucket per
+// channel that holds a minute's worth of messages and refills continuously. Messages over the cap
+// are counted so they can be summarised once the channel has room again.
+type ChannelRateLimiter struct {
+	mu        sync.Mutex
+	perMinute int
+	buckets   map[string]*channelBucket
+	now       func() time.Time
+}
+
+type channelBucket struct {
+	tokens     float64
+	updatedAt  time.Time
+	suppressed int
+}
+
+// NewChannelRateLimiter creates a limiter allowing perMinute messages to each channel.
+func NewChannelRateLimiter(perMinute int) *ChannelRateLimiter {
+	return &ChannelRateLimiter{
+		perMinute: perMinute,
+		buckets:   make(map[string]*channelBucket),
+		now:       time.Now,
+	}
+}
+
+// Allow reports whether a message can be posted to the channel, taking a token if so. Otherwise
+// the message is counted as suppressed and the running count since the last TakeSuppressed is
+// returned, so callers can tell the first suppression of a burst apart from the rest.
+func (l *ChannelRateLimiter) Allow(channelID string) (allowed bool, suppressed int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+
+	bucket, ok := l.buckets[channelID]
+	if !ok {
+		bucket = &channelBucket{tokens: float64(l.perMinute), updatedAt: now}
+		l.buckets[channelID] = bucket
+	}
+
+	// Refill for the time elapsed since the bucket was last touched, up to a full minute's worth.
+	elapsed := now.Sub(bucket.updatedAt).Minutes()
+	bucket.tokens = min(float64(l.perMinute), bucket.tokens+elapsed*float64(l.perMinute))
+	bucket.updatedAt = now
+
+	if bucket.tokens >= 1 {
+		bucket.tokens--
+
+		return true, 0
+	}
+
+	bucket.suppressed++
+
+	return false, bucket.suppressed
+}
+
+// TakeSuppressed returns how many messages to the channel have been suppressed, resetting the count.
+func (l *ChannelRateLimiter) TakeSuppressed(channelID string) int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	bucket, ok := l.buckets[channelID]
+	if !ok {
+		return 0
+	}
+
+	suppressed := bucket.suppressed
+	bucket.suppressed = 0
+
+	return suppressed
+}
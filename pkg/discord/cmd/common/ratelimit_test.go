@@ -0,0 +1,59 @@
+package common
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChannelRateLimiter(t *testing.T) {
+	now := time.Date(2025, 1, 2, 7, 0, 0, 0, time.UTC)
+
+	limiter := NewChannelRateLimiter(2)
+	limiter.now = func() time.Time { return now }
+
+	// A fresh channel gets a full minute's worth of messages.
+	for range 2 {
+		allowed, _ := limiter.Allow("alerts")
+		assert.True(t, allowed)
+	}
+
+	allowed, suppressed := limiter.Allow("alerts")
+	assert.False(t, allowed)
+	assert.Equal(t, 1, suppressed)
+
+	allowed, suppressed = limiter.Allow("alerts")
+	assert.False(t, allowed)
+	assert.Equal(t, 2, suppressed)
+
+	// Other channels have their own bucket.
+	allowed, _ = limiter.Allow("other")
+	assert.True(t, allowed)
+
+	// Half a minute refills one message.
+	now = now.Add(30 * time.Second)
+
+	allowed, _ = limiter.Allow("alerts")
+	assert.True(t, allowed)
+
+	allowed, suppressed = limiter.Allow("alerts")
+	assert.False(t, allowed)
+	assert.Equal(t, 3, suppressed)
+
+	assert.Equal(t, 3, limiter.TakeSuppressed("alerts"))
+	assert.Equal(t, 0, limiter.TakeSuppressed("alerts"))
+	assert.Equal(t, 0, limiter.TakeSuppressed("unknown"))
+
+	// Refills never exceed a minute's worth.
+	now = now.Add(time.Hour)
+
+	for range 2 {
+		allowed, _ = limiter.Allow("alerts")
+		assert.True(t, allowed)
+	}
+
+	allowed, suppressed = limiter.Allow("alerts")
+	assert.False(t, allowed)
+	assert.Equal(t, 1, suppressed)
+}
@@ -0,0 +1,93 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// MaxThreadNameLength is Discord's limit on the length of a thread name.
+const MaxThreadNameLength = 100
+
+// threadNamePlaceholder matches placeholders such as {client} in a thread name template.
+var threadNamePlaceholder = regexp.MustCompile(`\{[^{}]*\}`)
+
+// ThreadNameVars are the values substituted into a thread name template. Unset values render
+// as empty strings.
+type ThreadNameVars struct {
+	Client  string
+	Network string
+	Date    string
+	CheckID string
+	Suite   string
+}
+
+// placeholders returns the template placeholders and their values.
+func (v ThreadNameVars) placeholders() map[string]string {
+	return map[string]string{
+		"{client}":  v.Client,
+		"{network}": v.Network,
+		"{date}":    v.Date,
+		"{checkID}": v.CheckID,
+		"{suite}":   v.Suite,
+	}
+}
+
+// exampleThreadNameVars are representative values used to validate templates up front.
+var exampleThreadNameVars = ThreadNameVars{
+	Client:  "Nethermind",
+	Network: "fusaka-devnet-10",
+	Date:    "2006-01-02",
+	CheckID: "20060102-150405-0123456789abcdef",
+	Suite:   "eest/consume-engine",
+}
+
+// RenderThreadName renders a thread name template, truncating the result to fit Discord's limit.
+func RenderThreadName(template string, vars ThreadNameVars) string {
+	values := vars.placeholders()
+
+	name := threadNamePlaceholder.ReplaceAllStringFunc(template, func(placeholder string) string {
+		if value, ok := values[placeholder]; ok {
+			return value
+		}
+
+		return placeholder
+	})
+
+	// Collapse whitespace left behind by empty values, eg "{client} Issues" without a client.
+	name = strings.Join(strings.Fields(name), " ")
+
+	if runes := []rune(name); len(runes) > MaxThreadNameLength {
+		name = string(runes[:MaxThreadNameLength])
+	}
+
+	return name
+}
+
+// ValidateThreadNameTemplate checks a thread name template only uses known placeholders and
+// renders to a non-empty name within Discord's limit for representative values.
+func ValidateThreadNameTemplate(template string) error {
+	values := exampleThreadNameVars.placeholders()
+
+	for _, placeholder := range threadNamePlaceholder.FindAllString(template, -1) {
+		if _, ok := values[placeholder]; !ok {
+			return fmt.Errorf("unknown placeholder %s", placeholder)
+		}
+	}
+
+	name := threadNamePlaceholder.ReplaceAllStringFunc(template, func(placeholder string) string {
+		return values[placeholder]
+	})
+
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return errors.New("template renders an empty thread name")
+	}
+
+	if n := len([]rune(name)); n > MaxThreadNameLength {
+		return fmt.Errorf("template renders a %d character thread name, the limit is %d", n, MaxThreadNameLength)
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package common
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderThreadName(t *testing.T) {
+	vars := ThreadNameVars{
+		Client:  "Lighthouse",
+		Network: "fusaka-devnet-1",
+		Date:    "2025-01-02",
+		CheckID: "20250102-070000-abcdef",
+	}
+
+	tests := []struct {
+		name     string
+		template string
+		vars     ThreadNameVars
+		expected string
+	}{
+		{
+			name:     "default checks format",
+			template: "{client} Issues - {date}",
+			vars:     vars,
+			expected: "Lighthouse Issues - 2025-01-02",
+		},
+		{
+			name:     "network and check id",
+			template: "{network}: {client} ({checkID})",
+			vars:     vars,
+			expected: "fusaka-devnet-1: Lighthouse (20250102-070000-abcdef)",
+		},
+		{
+			name:     "empty values collapse whitespace",
+			template: "{client} Issues - {date}",
+			vars:     ThreadNameVars{Date: "2025-01-02"},
+			expected: "Issues - 2025-01-02",
+		},
+		{
+			name:     "unknown placeholders are left as is",
+			template: "{client} {unknown}",
+			vars:     vars,
+			expected: "Lighthouse {unknown}",
+		},
+		{
+			name:     "truncated to the discord limit",
+			template: strings.Repeat("x", 150),
+			vars:     vars,
+			expected: strings.Repeat("x", MaxThreadNameLength),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, RenderThreadName(tt.template, tt.vars))
+		})
+	}
+}
+
+func TestValidateThreadNameTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		wantErr  string
+	}{
+		{name: "default checks template", template: "{client} Issues - {date}"},
+		{name: "default hive template", template: "Hive Summary ({suite}) - {date}"},
+		{name: "all placeholders", template: "{network} {client} {date} {checkID}"},
+		{name: "empty", template: "   ", wantErr: "empty"},
+		{name: "unknown placeholder", template: "{client} {run}", wantErr: "unknown placeholder {run}"},
+		{name: "too long", template: strings.Repeat("x", 90) + " {network}", wantErr: "limit is 100"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateThreadNameTemplate(tt.template)
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+
+				return
+			}
+
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package common
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/bwmarrin/discordgo"
@@ -9,13 +10,15 @@ import (
 	"github.com/ethpandaops/panda-pulse/pkg/grafana"
 	"github.com/ethpandaops/panda-pulse/pkg/hive"
 	"github.com/ethpandaops/panda-pulse/pkg/scheduler"
+	"github.com/ethpandaops/panda-pulse/pkg/slack"
 	"github.com/ethpandaops/panda-pulse/pkg/store"
 )
 
 // RoleConfig defines the roles required for each permission level.
 type RoleConfig struct {
-	AdminRoles  map[string]bool     // Map of admin role names that have full access
-	ClientRoles map[string][]string // Map of client names to their team role names
+	AdminRoles  map[string]bool            // Map of admin role names that have full access
+	ClientRoles map[string][]string        // Map of client names to their team role names
+	Permissions map[string]PermissionLevel // Overrides who may run each "command subcommand" or "command"
 }
 
 // Command represents a Discord slash command.
@@ -42,14 +45,34 @@ type BotContext interface {
 	GetMentionsRepo() *store.MentionsRepo
 	// GetHiveSummaryRepo returns the Hive summary repository.
 	GetHiveSummaryRepo() *store.HiveSummaryRepo
+	// GetRoutesRepo returns the alert routes repository.
+	GetRoutesRepo() *store.RoutesRepo
+	// GetVersionsRepo returns the client versions repository.
+	GetVersionsRepo() *store.VersionsRepo
+	// GetNotificationsRepo returns the last notifications repository.
+	GetNotificationsRepo() *store.NotificationsRepo
+	// GetJobRunsRepo returns the scheduled job runs repository.
+	GetJobRunsRepo() *store.JobRunsRepo
+	// GetThresholdsRepo returns the check thresholds repository.
+	GetThresholdsRepo() *store.ThresholdsRepo
+	// GetGuildConfigRepo returns the per-guild config overrides repository.
+	GetGuildConfigRepo() *store.GuildConfigRepo
+	// GetIncidentsRepo returns the incidents repository.
+	GetIncidentsRepo() *store.IncidentsRepo
 	// GetGrafana returns the Grafana client.
 	GetGrafana() grafana.Client
 	// GetHive returns the Hive client.
 	GetHive() hive.Hive
+	// GetSlack returns the Slack client, or nil if Slack isn't configured.
+	GetSlack() slack.Slack
 	// GetCartographoor returns the cartographoor service.
 	GetCartographoor() *cartographoor.Service
 	// GetRoleConfig returns the role configuration.
 	GetRoleConfig() *RoleConfig
+	// IsMaintenance returns true if notifications are currently suppressed.
+	IsMaintenance() bool
+	// SetMaintenance enables or disables maintenance mode.
+	SetMaintenance(enabled bool)
 }
 
 // GetRoleNames returns the plain-english names of the roles a member has.
@@ -68,18 +91,33 @@ func GetRoleNames(member *discordgo.Member, session *discordgo.Session, guildID
 	return roleNames
 }
 
+// FindRoleIDs returns the IDs of the guild's roles matching any of the given names, ignoring case.
+func FindRoleIDs(session *discordgo.Session, guildID string, names []string) ([]string, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	roles, err := session.GuildRoles(guildID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get guild roles: %w", err)
+	}
+
+	ids := make([]string, 0)
+
+	for _, role := range roles {
+		if slices.ContainsFunc(names, func(name string) bool { return strings.EqualFold(role.Name, name) }) {
+			ids = append(ids, role.ID)
+		}
+	}
+
+	return ids, nil
+}
+
 // HasPermission checks if a member has permission to execute a command.
 func HasPermission(member *discordgo.Member, session *discordgo.Session, guildID string, config *RoleConfig, cmdData *discordgo.ApplicationCommandInteractionData) bool {
 	// Check admin roles first and let it through to the keeper.
-	for _, roleID := range member.Roles {
-		role, err := session.State.Role(guildID, roleID)
-		if err != nil {
-			continue
-		}
-
-		if config.AdminRoles[strings.ToLower(role.Name)] {
-			return true
-		}
+	if isAdmin(member, session, guildID, config) {
+		return true
 	}
 
 	// For client team members, we need to check if they're trying to access their own client.
@@ -107,6 +145,22 @@ func HasPermission(member *discordgo.Member, session *discordgo.Session, guildID
 	return false
 }
 
+// isAdmin checks if a member has any of the admin roles.
+func isAdmin(member *discordgo.Member, session *discordgo.Session, guildID string, config *RoleConfig) bool {
+	for _, roleID := range member.Roles {
+		role, err := session.State.Role(guildID, roleID)
+		if err != nil {
+			continue
+		}
+
+		if config.AdminRoles[strings.ToLower(role.Name)] {
+			return true
+		}
+	}
+
+	return false
+}
+
 // findClientArgument looks for a client argument in the command data.
 func findClientArgument(data *discordgo.ApplicationCommandInteractionData) string {
 	if data == nil || len(data.Options) == 0 {
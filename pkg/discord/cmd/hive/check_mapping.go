@@ -0,0 +1,217 @@
+package hive
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/ethpandaops/panda-pulse/pkg/hive"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// checkMappingTimeout bounds the discovery and listing lookups, well within the 15 minute
+	// interaction token.
+	checkMappingTimeout = time.Minute
+	// maxSimilarHiveNetworks caps how many similarly named Hive networks are suggested.
+	maxSimilarHiveNetworks = 10
+)
+
+// getCheckMappingCommandDefinition returns the '/hive check-mapping' subcommand definition.
+func (c *HiveCommand) getCheckMappingCommandDefinition() *discordgo.ApplicationCommandOption {
+	return &discordgo.ApplicationCommandOption{
+		Name:        "check-mapping",
+		Description: "Check which Hive network a network maps to and whether it has results",
+		Type:        discordgo.ApplicationCommandOptionSubCommand,
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				// Not autocompleted, the suggestions are Hive's names and this takes ours.
+				Name:        optionNameNetwork,
+				Description: "The network to check, eg fusaka-devnet-2",
+				Type:        discordgo.ApplicationCommandOptionString,
+				Required:    true,
+			},
+		},
+	}
+}
+
+// handleCheckMapping handles the '/hive check-mapping' subcommand. It reports the Hive network a
+// network maps to, whether Hive knows about it and how many results a summary would be built from,
+// so a wrong mapping is caught before an alert is registered rather than silently summarising nothing.
+func (c *HiveCommand) handleCheckMapping(s *discordgo.Session, i *discordgo.InteractionCreate, cmd *discordgo.ApplicationCommandInteractionDataOption) {
+	var network string
+
+	for _, opt := range cmd.Options {
+		if opt.Name == optionNameNetwork {
+			network = strings.TrimSpace(opt.StringValue())
+		}
+	}
+
+	if network == "" {
+		c.respondWithError(s, i, "🚫 A network is required")
+
+		return
+	}
+
+	// Discovery and the results listing are fetched from Hive, so acknowledge the interaction first.
+	if respondErr := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Flags: discordgo.MessageFlagsEphemeral,
+		},
+	}); respondErr != nil {
+		c.log.WithError(respondErr).Error("Failed to send deferred response")
+
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), checkMappingTimeout)
+	defer cancel()
+
+	report := c.checkMapping(ctx, network)
+
+	c.log.WithFields(logrus.Fields{
+		"network":     network,
+		"hiveNetwork": report.hiveNetwork,
+		"available":   report.available,
+		"results":     report.results,
+	}).Info("Checked Hive network mapping")
+
+	if _, editErr := s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+		Content: new(report.String()),
+	}); editErr != nil {
+		c.log.WithError(editErr).Error("Failed to edit deferred response")
+	}
+}
+
+// mappingReport is the outcome of checking a network's Hive mapping.
+type mappingReport struct {
+	network     string
+	hiveNetwork string
+	available   bool
+	availErr    error
+	results     int
+	suites      int
+	resultsErr  error
+	similar     []string
+}
+
+// checkMapping works out the Hive network a network maps to and validates it against Hive.
+func (c *HiveCommand) checkMapping(ctx context.Context, network string) *mappingReport {
+	h := c.bot.GetHive()
+
+	report := &mappingReport{network: network}
+
+	// Looking the network up first picks up any fallback it resolved to.
+	report.available, report.availErr = h.IsAvailable(ctx, network)
+	report.hiveNetwork = h.MapNetworkName(network)
+
+	if !report.available {
+		// Point operators at what Hive does have, the right name is usually a close match.
+		if networks, err := h.FetchAvailableNetworks(ctx); err != nil {
+			c.log.WithError(err).Warn("Failed to fetch Hive networks")
+		} else {
+			report.similar = similarHiveNetworks(network, networks)
+		}
+
+		return report
+	}
+
+	results, err := h.FetchTestResults(ctx, network, "")
+	if err != nil {
+		report.resultsErr = err
+
+		return report
+	}
+
+	report.results = len(results)
+	report.suites = countSuites(results)
+
+	return report
+}
+
+// String renders the report as a Discord message.
+func (r *mappingReport) String() string {
+	var msg strings.Builder
+
+	fmt.Fprintf(&msg, "🔎 **Hive mapping for %s**\n", r.network)
+
+	if r.hiveNetwork == r.network {
+		fmt.Fprintf(&msg, "• Hive network: `%s` (no mapping, used as is)\n", r.hiveNetwork)
+	} else {
+		fmt.Fprintf(&msg, "• Hive network: `%s`\n", r.hiveNetwork)
+	}
+
+	switch {
+	case r.availErr != nil:
+		fmt.Fprintf(&msg, "• Available: ❌ failed to check: %v\n", r.availErr)
+	case r.available:
+		msg.WriteString("• Available: ✅ listed in Hive discovery\n")
+	default:
+		fmt.Fprintf(&msg, "• Available: ❌ `%s` isn't listed in Hive discovery\n", r.hiveNetwork)
+	}
+
+	switch {
+	case !r.available:
+		if len(r.similar) > 0 {
+			fmt.Fprintf(&msg, "\nHive networks with a similar name: %s\n", formatNetworkNames(r.similar))
+		}
+
+		msg.WriteString("\n⚠️ Summaries for this network would have no results, check the mapping before registering an alert")
+	case r.resultsErr != nil:
+		fmt.Fprintf(&msg, "• Results: ❌ failed to fetch: %v", r.resultsErr)
+	case r.results == 0:
+		msg.WriteString("• Results: ⚠️ none, summaries would be empty until Hive has run against it")
+	default:
+		fmt.Fprintf(&msg, "• Results: ✅ %d across %d suites\n\nThe mapping looks good to register", r.results, r.suites)
+	}
+
+	return msg.String()
+}
+
+// countSuites returns how many distinct suites the results cover.
+func countSuites(results []hive.TestResult) int {
+	suites := make(map[string]struct{}, len(results))
+
+	for _, result := range results {
+		suites[result.Name] = struct{}{}
+	}
+
+	return len(suites)
+}
+
+// similarHiveNetworks returns the Hive networks sharing the network's leading name segment, eg
+// "fusaka" for "fusaka-devnet-2", which is usually what it should be mapped to.
+func similarHiveNetworks(network string, available []string) []string {
+	prefix, _, _ := strings.Cut(strings.ToLower(network), "-")
+
+	similar := make([]string, 0)
+
+	for _, name := range available {
+		if strings.Contains(strings.ToLower(name), prefix) {
+			similar = append(similar, name)
+		}
+	}
+
+	slices.Sort(similar)
+
+	if len(similar) > maxSimilarHiveNetworks {
+		similar = similar[:maxSimilarHiveNetworks]
+	}
+
+	return similar
+}
+
+// formatNetworkNames renders network names as inline code, comma separated.
+func formatNetworkNames(names []string) string {
+	quoted := make([]string, 0, len(names))
+
+	for _, name := range names {
+		quoted = append(quoted, "`"+name+"`")
+	}
+
+	return strings.Join(quoted, ", ")
+}
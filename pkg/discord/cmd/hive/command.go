@@ -2,14 +2,17 @@ package hive
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/bwmarrin/discordgo"
 	"github.com/ethpandaops/panda-pulse/pkg/discord/cmd/common"
 	"github.com/ethpandaops/panda-pulse/pkg/hive"
 	"github.com/ethpandaops/panda-pulse/pkg/queue"
+	"github.com/ethpandaops/panda-pulse/pkg/store"
 	"github.com/sirupsen/logrus"
 )
 
@@ -18,25 +21,33 @@ const (
 	threadDateFormat          = "2006-01-02"
 	optionNameNetwork         = "network"
 	optionNameSuite           = "suite"
+	optionNameClients         = "clients"
+	optionNameFullOverview    = "full-overview"
 )
 
 // HiveCommand handles the /hive command.
 type HiveCommand struct {
 	log                *logrus.Logger
 	bot                common.BotContext
+	config             *Config
 	githubToken        string
 	httpClient         *http.Client
 	queue              *queue.AlertQueue
 	guildRegistrations map[string]string // Maps guild ID to registered command ID for updates
+	summarySlots       chan struct{}     // Limits how many summaries run at once
 }
 
 // NewHiveCommand creates a new hive command.
-func NewHiveCommand(log *logrus.Logger, bot common.BotContext, githubToken string, httpClient *http.Client) *HiveCommand {
+func NewHiveCommand(log *logrus.Logger, bot common.BotContext, githubToken string, httpClient *http.Client, cfg *Config) *HiveCommand {
+	config := cfg.withDefaults()
+
 	cmd := &HiveCommand{
-		log:         log,
-		bot:         bot,
-		githubToken: githubToken,
-		httpClient:  httpClient,
+		log:          log,
+		bot:          bot,
+		config:       config,
+		githubToken:  githubToken,
+		httpClient:   httpClient,
+		summarySlots: make(chan struct{}, config.Concurrency),
 	}
 
 	return cmd
@@ -92,6 +103,60 @@ func (c *HiveCommand) getCommandDefinition() *discordgo.ApplicationCommand {
 						Type:        discordgo.ApplicationCommandOptionString,
 						Required:    false,
 					},
+					{
+						Name:        optionNameClients,
+						Description: "Only break down these clients, comma separated (optional, defaults to all)",
+						Type:        discordgo.ApplicationCommandOptionString,
+						Required:    false,
+					},
+					{
+						Name:        optionNameFullOverview,
+						Description: "Keep overview totals for all clients when filtering clients (defaults to false)",
+						Type:        discordgo.ApplicationCommandOptionBoolean,
+						Required:    false,
+					},
+				},
+			},
+			{
+				Name:        "subscribe-regressions",
+				Description: "Register a Hive alert that only posts clients that newly regressed",
+				Type:        discordgo.ApplicationCommandOptionSubCommand,
+				Options: []*discordgo.ApplicationCommandOption{
+					{
+						Name:         "network",
+						Description:  "The network to monitor",
+						Type:         discordgo.ApplicationCommandOptionString,
+						Required:     true,
+						Autocomplete: true,
+					},
+					{
+						Name:        "channel",
+						Description: "Channel to send regressions to",
+						Type:        discordgo.ApplicationCommandOptionChannel,
+						Required:    true,
+						ChannelTypes: []discordgo.ChannelType{
+							discordgo.ChannelTypeGuildText,
+						},
+					},
+					{
+						Name:         "suite",
+						Description:  "Filter by specific test suite (optional)",
+						Type:         discordgo.ApplicationCommandOptionString,
+						Required:     false,
+						Autocomplete: true,
+					},
+					{
+						Name:        "schedule",
+						Description: "The schedule to look for regressions (cron format)",
+						Type:        discordgo.ApplicationCommandOptionString,
+						Required:    false,
+					},
+					{
+						Name:        optionNameClients,
+						Description: "Only look at these clients, comma separated (optional, defaults to all)",
+						Type:        discordgo.ApplicationCommandOptionString,
+						Required:    false,
+					},
 				},
 			},
 			{
@@ -141,6 +206,68 @@ func (c *HiveCommand) getCommandDefinition() *discordgo.ApplicationCommand {
 					},
 				},
 			},
+			{
+				Name:        "regressions",
+				Description: "List clients that regressed between the most recent Hive summaries",
+				Type:        discordgo.ApplicationCommandOptionSubCommand,
+				Options: []*discordgo.ApplicationCommandOption{
+					{
+						Name:         optionNameNetwork,
+						Description:  "The network to check",
+						Type:         discordgo.ApplicationCommandOptionString,
+						Required:     true,
+						Autocomplete: true,
+					},
+					{
+						Name:         optionNameSuite,
+						Description:  "Filter by specific test suite (optional)",
+						Type:         discordgo.ApplicationCommandOptionString,
+						Required:     false,
+						Autocomplete: true,
+					},
+					{
+						Name:        optionNameCount,
+						Description: "Number of most recent summary comparisons to show (defaults to 1)",
+						Type:        discordgo.ApplicationCommandOptionInteger,
+						Required:    false,
+						MinValue:    new(float64(1)),
+						MaxValue:    maxRegressionsCount,
+					},
+				},
+			},
+			{
+				Name:        "problems",
+				Description: "Rank registered networks by low pass rates and recent regressions",
+				Type:        discordgo.ApplicationCommandOptionSubCommand,
+			},
+			{
+				Name:        "failures",
+				Description: "List a client's failing Hive tests",
+				Type:        discordgo.ApplicationCommandOptionSubCommand,
+				Options: []*discordgo.ApplicationCommandOption{
+					{
+						Name:         optionNameNetwork,
+						Description:  "The network to check",
+						Type:         discordgo.ApplicationCommandOptionString,
+						Required:     true,
+						Autocomplete: true,
+					},
+					{
+						Name:         optionNameClient,
+						Description:  "The client to list failing tests for",
+						Type:         discordgo.ApplicationCommandOptionString,
+						Required:     true,
+						Autocomplete: true,
+					},
+					{
+						Name:         optionNameSuite,
+						Description:  "Filter by specific test suite (optional)",
+						Type:         discordgo.ApplicationCommandOptionString,
+						Required:     false,
+						Autocomplete: true,
+					},
+				},
+			},
 			{
 				Name:        "trigger",
 				Description: "Trigger a Hive test workflow on GitHub",
@@ -173,6 +300,8 @@ func (c *HiveCommand) getCommandDefinition() *discordgo.ApplicationCommand {
 					},
 				},
 			},
+			c.getRegisterAllCommandDefinition(),
+			c.getCheckMappingCommandDefinition(),
 		},
 	}
 }
@@ -273,9 +402,8 @@ func (c *HiveCommand) Handle(s *discordgo.Session, i *discordgo.InteractionCreat
 		return
 	}
 
-	// Get the subcommand
-	if len(data.Options) == 0 {
-		c.respondWithError(s, i, "No subcommand provided")
+	if err := common.ValidateOptions(c.getCommandDefinition(), &data); err != nil {
+		c.respondWithError(s, i, err.Error())
 
 		return
 	}
@@ -283,7 +411,9 @@ func (c *HiveCommand) Handle(s *discordgo.Session, i *discordgo.InteractionCreat
 	subCmd := data.Options[0]
 	switch subCmd.Name {
 	case "register":
-		c.handleRegister(s, i, subCmd)
+		c.handleRegister(s, i, subCmd, false)
+	case "subscribe-regressions":
+		c.handleRegister(s, i, subCmd, true)
 	case "deregister":
 		c.handleDeregister(s, i, subCmd)
 	case "list":
@@ -292,21 +422,68 @@ func (c *HiveCommand) Handle(s *discordgo.Session, i *discordgo.InteractionCreat
 		}
 	case "run":
 		c.handleRun(s, i, subCmd)
+	case "regressions":
+		c.handleRegressions(s, i, subCmd)
+	case "problems":
+		c.handleProblems(s, i, subCmd)
+	case "failures":
+		c.handleFailures(s, i, subCmd)
 	case "trigger":
 		c.handleTrigger(s, i, subCmd)
+	case "register-all-networks":
+		c.handleRegisterAllNetworks(s, i, subCmd)
+	case "check-mapping":
+		c.handleCheckMapping(s, i, subCmd)
 	default:
 		c.respondWithError(s, i, fmt.Sprintf("Unknown subcommand: %s", subCmd.Name))
 	}
 }
 
-// RunHiveSummary runs a Hive summary check for a given alert.
+// SummaryJobName returns the scheduler job name for a network's Hive summary, optionally filtered
+// to a suite.
+func SummaryJobName(network, suite string) string {
+	if suite != "" {
+		return fmt.Sprintf("hive-summary-%s-%s", network, suite)
+	}
+
+	return fmt.Sprintf("hive-summary-%s", network)
+}
+
+// RunScheduledSummary runs a scheduled Hive summary, recording the run once it succeeds.
+func (c *HiveCommand) RunScheduledSummary(ctx context.Context, alert *hive.HiveSummaryAlert) error {
+	if err := c.runHiveSummary(ctx, alert, true); err != nil {
+		return err
+	}
+
+	common.RecordJobRun(ctx, c.log, c.bot.GetJobRunsRepo(), SummaryJobName(alert.Network, alert.Suite))
+
+	return nil
+}
+
+// RunHiveSummary runs a Hive summary check for a given alert. Client versions are only recorded by
+// the network's scheduled summary, so an ad hoc run doesn't use up a downgrade its channel would
+// otherwise be told about.
 func (c *HiveCommand) RunHiveSummary(ctx context.Context, alert *hive.HiveSummaryAlert) error {
+	return c.runHiveSummary(ctx, alert, false)
+}
+
+// runHiveSummary runs a Hive summary check for a given alert, recording the client versions seen
+// if asked to.
+func (c *HiveCommand) runHiveSummary(ctx context.Context, alert *hive.HiveSummaryAlert, recordVersions bool) error {
 	c.log.WithFields(logrus.Fields{
 		"network": alert.Network,
 		"channel": alert.DiscordChannel,
 		"guild":   alert.DiscordGuildID,
 	}).Info("Running Hive summary check")
 
+	// Summaries for every network tend to be scheduled at the same time, so only run a few at once.
+	select {
+	case c.summarySlots <- struct{}{}:
+		defer func() { <-c.summarySlots }()
+	case <-ctx.Done():
+		return fmt.Errorf("cancelled waiting to run summary: %w", ctx.Err())
+	}
+
 	// Fetch test results from Hive
 	results, err := c.bot.GetHive().FetchTestResults(ctx, alert.Network, alert.Suite)
 	if err != nil {
@@ -320,10 +497,23 @@ func (c *HiveCommand) RunHiveSummary(ctx context.Context, alert *hive.HiveSummar
 	}
 
 	// Get previous summary for comparison.
+	comparison := comparisonAvailable
+
 	prevSummary, err := c.bot.GetHiveSummaryRepo().GetPreviousSummaryResultWithSuite(ctx, alert.Network, alert.Suite)
-	if err != nil {
+
+	switch {
+	case errors.Is(err, store.ErrNoPreviousSummary):
+		comparison = comparisonBaseline
+
+		c.log.WithFields(logrus.Fields{
+			"network": alert.Network,
+			"suite":   alert.Suite,
+		}).Info("No previous summary found, sending as a baseline")
+	case err != nil:
+		comparison = comparisonFailed
+
 		c.log.WithError(err).Warn("Failed to get previous summary, continuing without comparison")
-	} else if prevSummary != nil {
+	case prevSummary != nil:
 		// Skip if we're comparing with the same summary.
 		if summary.Timestamp.Equal(prevSummary.Timestamp) {
 			prevSummary = nil
@@ -335,9 +525,76 @@ func (c *HiveCommand) RunHiveSummary(ctx context.Context, alert *hive.HiveSummar
 		c.log.WithError(err).Warn("Failed to store summary, continuing")
 	}
 
-	// Send the summary to Discord.
-	if err := c.sendHiveSummary(ctx, alert, summary, prevSummary, results); err != nil {
-		return fmt.Errorf("failed to send summary: %w", err)
+	// Only network wide summaries track versions, suite summaries can lag behind on older runs
+	// and would flip the last seen version back and forth. Downgrades are only recorded once they've
+	// been reported, so one found while paused or that fails to send is reported again next run.
+	var downgrades []versionDowngrade
+	if alert.Suite == "" {
+		var updates []*store.ClientVersion
+
+		downgrades, updates = c.checkVersions(ctx, summary)
+
+		if recordVersions {
+			c.recordVersions(ctx, updates)
+		}
+	}
+
+	// The summary is still stored above so history stays intact while notifications are paused.
+	if c.bot.IsMaintenance() {
+		c.log.WithFields(logrus.Fields{
+			"network": alert.Network,
+			"suite":   alert.Suite,
+		}).Info("Maintenance mode enabled, skipped notification")
+
+		return nil
+	}
+
+	// Warn alongside the summary when Hive has stopped producing results, rather than quietly
+	// reporting the same old results again.
+	if isStale(summary.Timestamp, time.Now(), c.config.StaleThreshold) {
+		if err := c.sendStaleWarning(alert, summary); err != nil {
+			c.log.WithError(err).Warn("Failed to send stale data warning")
+		}
+	}
+
+	// Narrow what's shown down to the clients of interest, the stored summary above stays complete.
+	if len(alert.Clients) > 0 {
+		summary = hive.FilterSummary(summary, alert.Clients, alert.FullOverview)
+		prevSummary = hive.FilterSummary(prevSummary, alert.Clients, alert.FullOverview)
+
+		// Nobody is told about downgrades of the other clients, so there's nothing to hold them for.
+		var dropped []versionDowngrade
+
+		downgrades, dropped = partitionDowngrades(downgrades, alert.Clients)
+
+		if recordVersions {
+			c.recordVersions(ctx, downgradeRecords(dropped))
+		}
+
+		if !alert.FullOverview {
+			results = hive.FilterResults(results, alert.Clients)
+		}
+	}
+
+	// Regressions only alerts skip the summary, posting just what newly regressed.
+	var sendErr error
+	if alert.RegressionsOnly {
+		sendErr = c.sendNewRegressions(ctx, alert, summary, prevSummary)
+	} else if err := c.sendHiveSummary(ctx, alert, summary, prevSummary, comparison, results); err != nil {
+		sendErr = fmt.Errorf("failed to send summary: %w", err)
+	}
+
+	// Downgrades are sent on their own, so they aren't lost along with a failed summary.
+	if len(downgrades) > 0 {
+		if err := c.sendVersionDowngrades(alert, downgrades); err != nil {
+			c.log.WithError(err).Warn("Failed to send version downgrade alert, it will be sent again next run")
+		} else if recordVersions {
+			c.recordVersions(ctx, downgradeRecords(downgrades))
+		}
+	}
+
+	if sendErr != nil || alert.RegressionsOnly {
+		return sendErr
 	}
 
 	c.log.WithFields(logrus.Fields{
@@ -0,0 +1,69 @@
+package hive
+
+import "time"
+
+const (
+	// DefaultThreadNameTemplate is the name given to Hive summary threads.
+	DefaultThreadNameTemplate = "Hive Summary - {date}"
+	// DefaultSuiteThreadNameTemplate is the name given to Hive summary threads for a specific suite.
+	DefaultSuiteThreadNameTemplate = "Hive Summary ({suite}) - {date}"
+	// DefaultConcurrency is how many networks Hive summaries are processed for at once.
+	DefaultConcurrency = 3
+	// DefaultStaleThreshold is how old the latest Hive results can be before summaries warn they're stale.
+	DefaultStaleThreshold = 36 * time.Hour
+)
+
+// Config contains configuration for the hive command.
+type Config struct {
+	// ThreadNameTemplate names summary threads, supporting {network}, {date} and {suite}. When unset,
+	// the default templates are used.
+	ThreadNameTemplate string
+	// Concurrency limits how many networks Hive summaries are processed for at once, across both
+	// scheduled summaries and bulk commands. Defaults to DefaultConcurrency.
+	Concurrency int
+	// StaleThreshold is how old the latest results can be before a summary warns they're stale, a
+	// negative value disables the warning. Defaults to DefaultStaleThreshold.
+	StaleThreshold time.Duration
+	// PassRates decide how pass rates are colored and marked across the overview and client
+	// breakdown. Unset thresholds default to DefaultGoodPassRate and DefaultWarningPassRate.
+	PassRates PassRateThresholds
+}
+
+// withDefaults returns a copy of the config with any unset values defaulted.
+func (c *Config) withDefaults() *Config {
+	cfg := &Config{}
+
+	if c != nil {
+		*cfg = *c
+	}
+
+	if cfg.Concurrency <= 0 {
+		cfg.Concurrency = DefaultConcurrency
+	}
+
+	if cfg.StaleThreshold == 0 {
+		cfg.StaleThreshold = DefaultStaleThreshold
+	}
+
+	cfg.PassRates = cfg.PassRates.withDefaults()
+
+	return cfg
+}
+
+// threadNameTemplate returns the thread name template to use for the given suite.
+func (c *Config) threadNameTemplate(suite string) string {
+	if c.ThreadNameTemplate != "" {
+		return c.ThreadNameTemplate
+	}
+
+	return defaultThreadNameTemplate(suite)
+}
+
+// defaultThreadNameTemplate returns the default thread name template for the given suite.
+func defaultThreadNameTemplate(suite string) string {
+	if suite != "" {
+		return DefaultSuiteThreadNameTemplate
+	}
+
+	return DefaultThreadNameTemplate
+}
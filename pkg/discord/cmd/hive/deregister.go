@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/bwmarrin/discordgo"
+	"github.com/ethpandaops/panda-pulse/pkg/discord/cmd/common"
 	"github.com/ethpandaops/panda-pulse/pkg/hive"
 	"github.com/sirupsen/logrus"
 )
@@ -18,7 +19,7 @@ const (
 func (c *HiveCommand) handleDeregister(s *discordgo.Session, i *discordgo.InteractionCreate, cmd *discordgo.ApplicationCommandInteractionDataOption) {
 	var (
 		options = cmd.Options
-		network = options[0].StringValue()
+		network = common.FindOption(options, optionNameNetwork).StringValue()
 		suite   = ""
 		guildID = i.GuildID // Get the guild ID from the interaction
 	)
@@ -116,12 +117,7 @@ func (c *HiveCommand) deregisterHiveAlert(ctx context.Context, network, suite, g
 	}
 
 	// Remove from scheduler
-	jobName := fmt.Sprintf("hive-summary-%s", network)
-	if suite != "" {
-		jobName = fmt.Sprintf("hive-summary-%s-%s", network, suite)
-	}
-
-	c.bot.GetScheduler().RemoveJob(jobName)
+	c.bot.GetScheduler().RemoveJob(SummaryJobName(network, suite))
 
 	c.log.WithFields(logrus.Fields{
 		"network": network,
@@ -0,0 +1,168 @@
+package hive
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ethpandaops/panda-pulse/pkg/hive"
+	"github.com/ethpandaops/panda-pulse/pkg/store"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	msgVersionDowngradeHeader = "ℹ️ **Client version downgrade detected on %s**, this is often an accidental rollback:"
+	msgVersionDowngradeLine   = "- **%s**: `%s` → `%s`"
+	msgVersionDowngradeLatest = " (latest release `%s`)"
+)
+
+// versionDowngrade is a client whose running version went backwards between Hive runs.
+type versionDowngrade struct {
+	client   string
+	previous string
+	current  string
+	latest   string
+	// record is the downgraded version to save, once the downgrade has been reported.
+	record *store.ClientVersion
+}
+
+// checkVersions compares the client versions seen in a summary against the last seen versions,
+// returning the clients whose version went backwards along with the other changed versions. Nothing
+// is saved, so a downgrade is only recorded once it has been reported.
+func (c *HiveCommand) checkVersions(ctx context.Context, summary *hive.SummaryResult) ([]versionDowngrade, []*store.ClientVersion) {
+	repo := c.bot.GetVersionsRepo()
+	if repo == nil {
+		return nil, nil
+	}
+
+	var (
+		downgrades []versionDowngrade
+		updates    []*store.ClientVersion
+		now        = time.Now()
+	)
+
+	for client, result := range summary.ClientResults {
+		current := hive.CleanVersion(result.ClientVersion)
+		if current == "" {
+			continue
+		}
+
+		seen, err := repo.Get(ctx, summary.Network, client)
+		if err != nil {
+			c.log.WithError(err).WithField("client", client).Warn("Failed to get last seen client version")
+
+			continue
+		}
+
+		if seen != nil && seen.Version == current {
+			continue
+		}
+
+		record := &store.ClientVersion{
+			Network:   summary.Network,
+			Client:    client,
+			Version:   current,
+			SeenAt:    now,
+			UpdatedAt: now,
+		}
+
+		if seen != nil {
+			if cmp, ok := hive.CompareVersions(current, seen.Version); ok && cmp < 0 {
+				downgrades = append(downgrades, versionDowngrade{
+					client:   client,
+					previous: seen.Version,
+					current:  current,
+					latest:   c.latestVersion(client),
+					record:   record,
+				})
+
+				continue
+			}
+		}
+
+		updates = append(updates, record)
+	}
+
+	slices.SortFunc(downgrades, func(a, b versionDowngrade) int {
+		return strings.Compare(a.client, b.client)
+	})
+
+	if len(downgrades) > 0 {
+		c.log.WithFields(logrus.Fields{
+			"network":    summary.Network,
+			"downgrades": len(downgrades),
+		}).Info("Detected client version downgrades")
+	}
+
+	return downgrades, updates
+}
+
+// recordVersions saves client versions as the last seen versions.
+func (c *HiveCommand) recordVersions(ctx context.Context, records []*store.ClientVersion) {
+	repo := c.bot.GetVersionsRepo()
+	if repo == nil {
+		return
+	}
+
+	for _, record := range records {
+		if err := repo.Persist(ctx, record); err != nil {
+			c.log.WithError(err).WithField("client", record.Client).Warn("Failed to persist client version")
+		}
+	}
+}
+
+// downgradeRecords returns the versions to save for the given downgrades.
+func downgradeRecords(downgrades []versionDowngrade) []*store.ClientVersion {
+	records := make([]*store.ClientVersion, 0, len(downgrades))
+	for _, d := range downgrades {
+		records = append(records, d.record)
+	}
+
+	return records
+}
+
+// latestVersion returns the latest known release of a client, if cartographoor knows about it.
+func (c *HiveCommand) latestVersion(client string) string {
+	if cartographoor := c.bot.GetCartographoor(); cartographoor != nil {
+		return cartographoor.GetClientLatestVersion(client)
+	}
+
+	return ""
+}
+
+// partitionDowngrades splits downgrades into those of the given clients and the rest.
+func partitionDowngrades(downgrades []versionDowngrade, clients []string) (kept, dropped []versionDowngrade) {
+	clients = hive.NormalizeClients(clients)
+
+	for _, d := range downgrades {
+		if slices.Contains(clients, strings.ToLower(d.client)) {
+			kept = append(kept, d)
+		} else {
+			dropped = append(dropped, d)
+		}
+	}
+
+	return kept, dropped
+}
+
+// sendVersionDowngrades sends an informational alert listing client version downgrades.
+func (c *HiveCommand) sendVersionDowngrades(alert *hive.HiveSummaryAlert, downgrades []versionDowngrade) error {
+	lines := []string{fmt.Sprintf(msgVersionDowngradeHeader, alert.Network)}
+
+	for _, d := range downgrades {
+		line := fmt.Sprintf(msgVersionDowngradeLine, d.client, d.previous, d.current)
+		if d.latest != "" {
+			line += fmt.Sprintf(msgVersionDowngradeLatest, d.latest)
+		}
+
+		lines = append(lines, line)
+	}
+
+	if _, err := c.bot.GetSession().ChannelMessageSend(alert.DiscordChannel, strings.Join(lines, "\n")); err != nil {
+		return fmt.Errorf("failed to send version downgrade alert: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,170 @@
+package hive
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/ethpandaops/panda-pulse/pkg/discord/message"
+	"github.com/ethpandaops/panda-pulse/pkg/hive"
+)
+
+const (
+	failuresEmbedColor      = 0xE74C3C
+	maxFailuresDescription  = 3800 // Discord embed descriptions are capped at 4096 characters.
+	maxFailingTestNameChars = 120
+)
+
+// handleFailures handles the '/hive failures' subcommand.
+func (c *HiveCommand) handleFailures(s *discordgo.Session, i *discordgo.InteractionCreate, cmd *discordgo.ApplicationCommandInteractionDataOption) {
+	var network, client, suite string
+
+	for _, opt := range cmd.Options {
+		switch opt.Name {
+		case optionNameNetwork:
+			network = opt.StringValue()
+		case optionNameClient:
+			client = opt.StringValue()
+		case optionNameSuite:
+			suite = opt.StringValue()
+		}
+	}
+
+	// Check if Hive is available for this network.
+	available, err := c.bot.GetHive().IsAvailable(context.Background(), network)
+	if err != nil {
+		c.respondWithError(s, i, fmt.Sprintf("Failed to check Hive availability: %v", err))
+
+		return
+	}
+
+	if !available {
+		c.respondWithError(s, i, fmt.Sprintf("🚫 Hive is not available for network **%s**", network))
+
+		return
+	}
+
+	// Suite result files can be large, so acknowledge the interaction first.
+	if respondErr := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+	}); respondErr != nil {
+		c.log.WithError(respondErr).Error("Failed to send deferred response")
+
+		return
+	}
+
+	failing, err := c.bot.GetHive().FetchFailingTests(context.Background(), network, suite, client)
+	if err != nil {
+		c.log.WithError(err).Error("Failed to fetch failing Hive tests")
+
+		if _, editErr := s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+			Content: new(fmt.Sprintf("❌ Failed to fetch failing tests for **%s** on **%s**: %v", client, network, err)),
+		}); editErr != nil {
+			c.log.WithError(editErr).Error("Failed to edit deferred response")
+		}
+
+		return
+	}
+
+	if len(failing) == 0 {
+		msg := fmt.Sprintf("✅ No failing Hive tests for **%s** on **%s**", client, network)
+		if suite != "" {
+			msg = fmt.Sprintf("✅ No failing Hive tests for **%s** on **%s** (suite: %s)", client, network, suite)
+		}
+
+		if _, err = s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+			Content: new(msg),
+		}); err != nil {
+			c.log.WithError(err).Error("Failed to edit deferred response")
+		}
+
+		return
+	}
+
+	if _, err = s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+		Embeds: &[]*discordgo.MessageEmbed{createFailuresEmbed(network, client, suite, failing)},
+	}); err != nil {
+		c.log.WithError(err).Error("Failed to edit deferred response")
+	}
+}
+
+// createFailuresEmbed builds an embed listing failing tests grouped by suite. If the list doesn't
+// fit, it is truncated and each affected suite links to its full results page instead.
+func createFailuresEmbed(network, client, suite string, failing []hive.FailingTest) *discordgo.MessageEmbed {
+	title := fmt.Sprintf("❌ %d failing Hive tests for %s on %s", len(failing), client, network)
+	if suite != "" {
+		title = fmt.Sprintf("❌ %d failing Hive tests for %s on %s (%s)", len(failing), client, network, suite)
+	}
+
+	var (
+		desc      strings.Builder
+		current   string
+		shown     int
+		truncated = make(map[string]int)
+		suiteURLs = make(map[string]string)
+		suites    = make([]string, 0)
+	)
+
+	for _, test := range failing {
+		if _, ok := suiteURLs[test.Suite]; !ok {
+			suiteURLs[test.Suite] = test.SuiteURL
+			suites = append(suites, test.Suite)
+		}
+
+		var line strings.Builder
+
+		if test.Suite != current {
+			fmt.Fprintf(&line, "\n**%s** ([view suite](%s))\n", test.Suite, test.SuiteURL)
+		}
+
+		fmt.Fprintf(&line, "• [%s](%s)\n", truncateTestName(test.Name), test.URL)
+
+		if len(truncated) > 0 || desc.Len()+line.Len() > maxFailuresDescription {
+			truncated[test.Suite]++
+
+			continue
+		}
+
+		current = test.Suite
+		shown++
+
+		desc.WriteString(line.String())
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       title,
+		Description: strings.TrimSpace(desc.String()),
+		Color:       failuresEmbedColor,
+	}
+
+	if len(truncated) > 0 {
+		var more strings.Builder
+
+		for _, name := range suites {
+			if n, ok := truncated[name]; ok {
+				fmt.Fprintf(&more, "• %d more in [%s](%s)\n", n, name, suiteURLs[name])
+			}
+		}
+
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:  fmt.Sprintf("Showing %d of %d", shown, len(failing)),
+			Value: truncateFieldValue(more.String()),
+		})
+	}
+
+	return embed
+}
+
+// truncateTestName shortens long test names so more of them fit in a single embed.
+func truncateTestName(name string) string {
+	// Square brackets would break the markdown link.
+	name = strings.NewReplacer("[", "(", "]", ")").Replace(name)
+
+	return message.Truncate(name, maxFailingTestNameChars)
+}
+
+// truncateFieldValue keeps an embed field value within Discord's limit, dropping whole lines.
+func truncateFieldValue(value string) string {
+	return message.TruncateLines(value, message.MaxEmbedFieldValue)
+}
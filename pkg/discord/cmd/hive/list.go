@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/bwmarrin/discordgo"
+	"github.com/ethpandaops/panda-pulse/pkg/discord/cmd/common"
 	"github.com/ethpandaops/panda-pulse/pkg/hive"
 	"github.com/robfig/cron/v3"
 )
@@ -17,6 +18,8 @@ const (
 	msgNoHiveSummariesAnyNetwork = " for any network"
 	msgNetworkHiveSummary        = "🌐 Hive summary registered for **%s**\n"
 	msgAlertsSentTo              = "Alerts are sent to "
+	msgClientsFilter             = "Client breakdown (%s) is limited to: %s\n"
+	msgRegressionsOnly           = "Only new regressions are posted (%s)\n"
 )
 
 // handleList handles the '/hive list' command.
@@ -30,8 +33,8 @@ func (c *HiveCommand) handleList(
 		guildID = i.GuildID
 	)
 
-	if len(data.Options) > 0 {
-		n := data.Options[0].StringValue()
+	if opt := common.FindOption(data.Options, optionNameNetwork); opt != nil {
+		n := opt.StringValue()
 		network = &n
 	}
 
@@ -88,6 +91,26 @@ func (c *HiveCommand) handleList(
 		fmt.Fprintf(&msg, msgNetworkHiveSummary, networkName)
 		msg.WriteString(buildSummaryTable(alerts, networkName))
 
+		for _, alert := range alerts {
+			if alert.Network == networkName && len(alert.Clients) > 0 {
+				suite := alert.Suite
+				if suite == "" {
+					suite = "all suites"
+				}
+
+				fmt.Fprintf(&msg, msgClientsFilter, suite, strings.Join(alert.Clients, ", "))
+			}
+
+			if alert.Network == networkName && alert.RegressionsOnly {
+				suite := alert.Suite
+				if suite == "" {
+					suite = "all suites"
+				}
+
+				fmt.Fprintf(&msg, msgRegressionsOnly, suite)
+			}
+		}
+
 		// Find the channel for this network
 		channels := make(map[string]bool)
 
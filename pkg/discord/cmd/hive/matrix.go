@@ -0,0 +1,159 @@
+package hive
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/ethpandaops/panda-pulse/pkg/hive"
+)
+
+const (
+	maxMatrixMessage = 1900 // Discord messages are capped at 2000 characters.
+	codeBlockFence   = "```"
+
+	msgMatrixTitle   = "🧮 **Client × test type matrix** (%d of %d client and test type pairs failing)"
+	msgMatrixLegend  = "`%d` %s"
+	msgMatrixFooter  = "Cells show the failed test count, `ok` if none failed and `-` if the client didn't run that test type."
+	matrixCellOK     = "ok"
+	matrixCellNotRun = "-"
+)
+
+// sendMatrixMessages posts the client by test type matrix to the thread.
+func sendMatrixMessages(session *discordgo.Session, threadID string, matrix hive.Matrix) error {
+	for _, msg := range formatMatrix(matrix) {
+		if _, err := session.ChannelMessageSend(threadID, msg); err != nil {
+			return fmt.Errorf("failed to send matrix message: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// formatMatrix renders the matrix as a compact grid, with clients as rows and numbered test types
+// as columns. The legend and grid are split across as many messages as needed to fit, with each
+// grid message repeating the column header. Returns nil for an empty matrix.
+func formatMatrix(matrix hive.Matrix) []string {
+	var (
+		clients   = matrix.Clients()
+		testTypes = matrix.TestTypes()
+	)
+
+	if len(clients) == 0 || len(testTypes) == 0 {
+		return nil
+	}
+
+	var ran int
+	for _, cells := range matrix {
+		ran += len(cells)
+	}
+
+	// The legend maps column numbers back to test types, their names are too long for headers.
+	legend := []string{fmt.Sprintf(msgMatrixTitle, matrix.FailingCells(), ran)}
+	for idx, testType := range testTypes {
+		legend = append(legend, fmt.Sprintf(msgMatrixLegend, idx+1, testType))
+	}
+
+	legend = append(legend, msgMatrixFooter)
+
+	// Work out the width of each column so the grid lines up.
+	var (
+		clientWidth = len("client")
+		widths      = make([]int, len(testTypes))
+		rows        = make([][]string, 0, len(clients))
+	)
+
+	for idx := range testTypes {
+		widths[idx] = max(len(strconv.Itoa(idx+1)), len(matrixCellOK))
+	}
+
+	for _, client := range clients {
+		clientWidth = max(clientWidth, len(client))
+
+		row := make([]string, len(testTypes))
+
+		for idx, testType := range testTypes {
+			row[idx] = formatMatrixCell(matrix[client], testType)
+			widths[idx] = max(widths[idx], len(row[idx]))
+		}
+
+		rows = append(rows, row)
+	}
+
+	header := formatMatrixRow("client", clientWidth, columnNumbers(len(testTypes)), widths)
+
+	lines := make([]string, 0, len(clients))
+	for idx, client := range clients {
+		lines = append(lines, formatMatrixRow(client, clientWidth, rows[idx], widths))
+	}
+
+	messages := chunkLines(legend, "", "")
+
+	return append(messages, chunkLines(lines, codeBlockFence+"\n"+header+"\n", codeBlockFence)...)
+}
+
+// formatMatrixCell renders a single cell of the matrix.
+func formatMatrixCell(cells map[string]hive.MatrixCell, testType string) string {
+	cell, ok := cells[testType]
+
+	switch {
+	case !ok:
+		return matrixCellNotRun
+	case cell.Fails == 0:
+		return matrixCellOK
+	default:
+		return strconv.Itoa(cell.Fails)
+	}
+}
+
+// formatMatrixRow renders a row of the grid, left aligning the label and right aligning the cells.
+func formatMatrixRow(label string, labelWidth int, cells []string, widths []int) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "%-*s", labelWidth, label)
+
+	for idx, cell := range cells {
+		fmt.Fprintf(&sb, " %*s", widths[idx], cell)
+	}
+
+	return sb.String()
+}
+
+// columnNumbers returns the 1-based column numbers used as the grid header.
+func columnNumbers(n int) []string {
+	numbers := make([]string, n)
+	for idx := range numbers {
+		numbers[idx] = strconv.Itoa(idx + 1)
+	}
+
+	return numbers
+}
+
+// chunkLines joins lines into messages that fit in a Discord message, wrapping each message in the
+// given prefix and suffix.
+func chunkLines(lines []string, prefix, suffix string) []string {
+	var (
+		messages []string
+		sb       strings.Builder
+	)
+
+	flush := func() {
+		if sb.Len() > 0 {
+			messages = append(messages, prefix+sb.String()+suffix)
+			sb.Reset()
+		}
+	}
+
+	for _, line := range lines {
+		if len(prefix)+sb.Len()+len(line)+1+len(suffix) > maxMatrixMessage {
+			flush()
+		}
+
+		sb.WriteString(line + "\n")
+	}
+
+	flush()
+
+	return messages
+}
@@ -0,0 +1,256 @@
+package hive
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/ethpandaops/panda-pulse/pkg/discord/message"
+	"github.com/ethpandaops/panda-pulse/pkg/hive"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// problemsTimeout bounds loading the stored summaries, well within the 15 minute interaction token.
+	problemsTimeout = 2 * time.Minute
+	// maxProblemsDescription is Discord's cap on the characters in an embed description.
+	maxProblemsDescription = 4096
+	// maxProblemRegressions is how many regressed clients are named per network before the rest are counted.
+	maxProblemRegressions = 3
+
+	msgNoHiveProblems     = "%s No problems across the %d registered Hive summaries with recent data"
+	msgProblemsFetchError = "❌ Failed to list Hive summaries: %v"
+	msgNoHiveSummaries    = "ℹ️ No Hive summaries are currently registered"
+)
+
+// networkProblem is how a registered network and suite's latest stored summary looks.
+type networkProblem struct {
+	network     string
+	suite       string
+	summary     *hive.SummaryResult // The latest stored summary, nil if there are none.
+	severity    severity
+	regressions []regression // Clients whose failures rose since the previous summary.
+	stale       bool         // The latest summary is older than the stale threshold.
+}
+
+// isProblem returns whether the summary's pass rate is below the good threshold or clients regressed.
+func (p *networkProblem) isProblem() bool {
+	return p.severity != severityGood || len(p.regressions) > 0
+}
+
+// lacksData returns whether there's no recent stored summary to judge the network by.
+func (p *networkProblem) lacksData() bool {
+	return p.summary == nil || p.stale
+}
+
+// handleProblems handles the '/hive problems' subcommand. It ranks the registered networks by how
+// concerning their latest stored summaries are, from the stored results rather than Hive, so it's
+// quick enough to use for triage.
+func (c *HiveCommand) handleProblems(s *discordgo.Session, i *discordgo.InteractionCreate, _ *discordgo.ApplicationCommandInteractionDataOption) {
+	// Every registered network's summaries are loaded, so acknowledge the interaction first.
+	if err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+	}); err != nil {
+		c.log.WithError(err).Error("Failed to send deferred response")
+
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), problemsTimeout)
+	defer cancel()
+
+	edit := &discordgo.WebhookEdit{}
+
+	alerts, err := c.listAlerts(ctx, i.GuildID, nil)
+
+	switch {
+	case err != nil:
+		c.log.WithError(err).Error("Failed to list Hive summaries")
+		edit.Content = new(fmt.Sprintf(msgProblemsFetchError, err))
+	case len(alerts) == 0:
+		edit.Content = new(msgNoHiveSummaries)
+	default:
+		problems := c.loadProblems(ctx, alerts)
+		edit.Embeds = &[]*discordgo.MessageEmbed{buildProblemsEmbed(problems, c.config.StaleThreshold)}
+	}
+
+	if _, err := s.InteractionResponseEdit(i.Interaction, edit); err != nil {
+		c.log.WithError(err).Error("Failed to edit deferred response")
+	}
+}
+
+// loadProblems assesses the latest stored summaries of each registered network and suite. A network
+// registered more than once, eg in several channels, is only assessed once.
+func (c *HiveCommand) loadProblems(ctx context.Context, alerts []*hive.HiveSummaryAlert) []*networkProblem {
+	var (
+		problems = make([]*networkProblem, 0, len(alerts))
+		seen     = make(map[string]bool, len(alerts))
+		now      = time.Now()
+	)
+
+	for _, alert := range alerts {
+		key := alert.Network + "/" + alert.Suite
+		if seen[key] {
+			continue
+		}
+
+		seen[key] = true
+
+		summaries, err := c.bot.GetHiveSummaryRepo().GetRecentSummaryResultsWithSuite(ctx, alert.Network, alert.Suite, 2)
+		if err != nil {
+			// Shown as having no data, rather than failing the whole list over one network.
+			c.log.WithError(err).WithFields(logrus.Fields{
+				"network": alert.Network,
+				"suite":   alert.Suite,
+			}).Warn("Failed to load Hive summaries")
+		}
+
+		problems = append(problems, assessProblem(alert.Network, alert.Suite, summaries, now, c.config.PassRates, c.config.StaleThreshold))
+	}
+
+	return problems
+}
+
+// assessProblem judges a network and suite by its stored summaries, newest first.
+func assessProblem(
+	network, suite string,
+	summaries []*hive.SummaryResult,
+	now time.Time,
+	thresholds PassRateThresholds,
+	staleThreshold time.Duration,
+) *networkProblem {
+	problem := &networkProblem{network: network, suite: suite}
+
+	if len(summaries) == 0 {
+		return problem
+	}
+
+	problem.summary = summaries[0]
+	problem.severity = thresholds.severity(summaries[0].OverallPassRate)
+	problem.stale = isStale(summaries[0].Timestamp, now, staleThreshold)
+
+	if len(summaries) > 1 {
+		problem.regressions = detectRegressions(summaries[0], summaries[1])
+	}
+
+	return problem
+}
+
+// rankProblems returns the networks with problems, worst first: by severity, then the number of
+// regressed clients, then pass rate. Networks without recent data are left out, they can't be judged.
+func rankProblems(problems []*networkProblem) []*networkProblem {
+	ranked := slices.DeleteFunc(slices.Clone(problems), func(p *networkProblem) bool {
+		return p.lacksData() || !p.isProblem()
+	})
+
+	slices.SortFunc(ranked, func(a, b *networkProblem) int {
+		return cmp.Or(
+			cmp.Compare(b.severity, a.severity),
+			cmp.Compare(len(b.regressions), len(a.regressions)),
+			cmp.Compare(a.summary.OverallPassRate, b.summary.OverallPassRate),
+			cmp.Compare(a.network, b.network),
+			cmp.Compare(a.suite, b.suite),
+		)
+	})
+
+	return ranked
+}
+
+// buildProblemsEmbed lists the ranked problems, followed by the networks without recent data.
+func buildProblemsEmbed(problems []*networkProblem, staleThreshold time.Duration) *discordgo.MessageEmbed {
+	var (
+		sb     strings.Builder
+		ranked = rankProblems(problems)
+		color  = colorGood
+	)
+
+	if len(ranked) > 0 {
+		color = ranked[0].severity.color()
+
+		// Regressions alone still need looking at, even with a healthy pass rate.
+		if ranked[0].severity == severityGood {
+			color = colorWarning
+		}
+	}
+
+	// Networks without recent data are shown apart, a problem can't be ruled out for them.
+	var (
+		missing      strings.Builder
+		missingCount int
+	)
+
+	for _, p := range problems {
+		switch {
+		case p.summary == nil:
+			fmt.Fprintf(&missing, "❔ **%s**: no stored summaries\n", problemName(p))
+		case p.stale:
+			fmt.Fprintf(&missing, "⏳ **%s**: latest summary from <t:%d:R>, over %s ago\n", problemName(p), p.summary.Timestamp.Unix(), staleThreshold)
+		default:
+			continue
+		}
+
+		missingCount++
+	}
+
+	for _, p := range ranked {
+		fmt.Fprintf(
+			&sb,
+			"%s **%s** • %s pass rate",
+			p.severity.indicator(),
+			problemName(p),
+			formatPassRate(p.summary.OverallPassRate, p.summary.TotalFails),
+		)
+
+		if len(p.regressions) > 0 {
+			fmt.Fprintf(&sb, " • %d regressed: %s", len(p.regressions), formatProblemRegressions(p.regressions))
+		}
+
+		sb.WriteString("\n")
+	}
+
+	if len(ranked) == 0 {
+		fmt.Fprintf(&sb, msgNoHiveProblems+"\n", iconSuccess, len(problems)-missingCount)
+	}
+
+	if missingCount > 0 {
+		sb.WriteString("\n**No recent data**\n")
+		sb.WriteString(missing.String())
+	}
+
+	return &discordgo.MessageEmbed{
+		Title:       "🩺 Hive problems",
+		Description: message.TruncateLines(strings.TrimSpace(sb.String()), maxProblemsDescription),
+		Color:       color,
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: "From the latest stored summaries, regressions are since the previous one",
+		},
+	}
+}
+
+// problemName names a problem's network, with its suite if the summary is limited to one.
+func problemName(p *networkProblem) string {
+	if p.suite != "" {
+		return fmt.Sprintf("%s (%s)", p.network, p.suite)
+	}
+
+	return p.network
+}
+
+// formatProblemRegressions names the worst regressed clients with their added failures.
+func formatProblemRegressions(regressions []regression) string {
+	names := make([]string, 0, maxProblemRegressions)
+
+	for _, r := range regressions[:min(len(regressions), maxProblemRegressions)] {
+		names = append(names, fmt.Sprintf("%s (+%d)", r.client, r.currFails-r.prevFails))
+	}
+
+	if more := len(regressions) - len(names); more > 0 {
+		names = append(names, fmt.Sprintf("%d more", more))
+	}
+
+	return strings.Join(names, ", ")
+}
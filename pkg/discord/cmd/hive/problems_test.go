@@ -0,0 +1,106 @@
+package hive
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethpandaops/panda-pulse/pkg/hive"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProblems(t *testing.T) {
+	var (
+		now        = time.Date(2025, 6, 2, 12, 0, 0, 0, time.UTC)
+		thresholds = PassRateThresholds{}.withDefaults()
+		stale      = 36 * time.Hour
+	)
+
+	summary := func(network string, age time.Duration, passRate float64, clientFails map[string]int) *hive.SummaryResult {
+		result := &hive.SummaryResult{
+			Network:         network,
+			Timestamp:       now.Add(-age),
+			OverallPassRate: passRate,
+			ClientResults:   make(map[string]*hive.ClientSummary),
+		}
+
+		for client, fails := range clientFails {
+			result.ClientResults[client] = &hive.ClientSummary{ClientName: client, TotalTests: 100, FailedTests: fails, PassedTests: 100 - fails}
+			result.TotalFails += fails
+		}
+
+		return result
+	}
+
+	problems := []*networkProblem{
+		// Healthy, and no worse than the previous summary.
+		assessProblem("mainnet", "", []*hive.SummaryResult{
+			summary("mainnet", time.Hour, 100, map[string]int{"geth": 0}),
+			summary("mainnet", 25*time.Hour, 100, map[string]int{"geth": 0}),
+		}, now, thresholds, stale),
+		// Healthy pass rate, but a client regressed.
+		assessProblem("hoodi", "", []*hive.SummaryResult{
+			summary("hoodi", time.Hour, 99.8, map[string]int{"geth": 2, "besu": 0}),
+			summary("hoodi", 25*time.Hour, 100, map[string]int{"geth": 0, "besu": 0}),
+		}, now, thresholds, stale),
+		// Poor pass rate.
+		assessProblem("sepolia", "engine", []*hive.SummaryResult{
+			summary("sepolia", time.Hour, 80, map[string]int{"geth": 20}),
+		}, now, thresholds, stale),
+		// Warning pass rate.
+		assessProblem("holesky", "", []*hive.SummaryResult{
+			summary("holesky", time.Hour, 97, map[string]int{"geth": 3}),
+		}, now, thresholds, stale),
+		// Poor, but stale, so can't be judged.
+		assessProblem("fusaka-devnet-2", "", []*hive.SummaryResult{
+			summary("fusaka-devnet-2", 48*time.Hour, 50, map[string]int{"geth": 50}),
+		}, now, thresholds, stale),
+		// Never summarised.
+		assessProblem("glamsterdam-devnet-0", "", nil, now, thresholds, stale),
+	}
+
+	t.Run("ranked worst first", func(t *testing.T) {
+		ranked := rankProblems(problems)
+
+		names := make([]string, 0, len(ranked))
+		for _, p := range ranked {
+			names = append(names, problemName(p))
+		}
+
+		assert.Equal(t, []string{"sepolia (engine)", "holesky", "hoodi"}, names)
+		require.Len(t, ranked[2].regressions, 1)
+		assert.Equal(t, "geth", ranked[2].regressions[0].client)
+	})
+
+	t.Run("embed", func(t *testing.T) {
+		embed := buildProblemsEmbed(problems, stale)
+
+		assert.Equal(t, colorPoor, embed.Color)
+		assert.Contains(t, embed.Description, "🔴 **sepolia (engine)** • 80.0% pass rate\n")
+		assert.Contains(t, embed.Description, "**hoodi** • 99.8% pass rate • 1 regressed: geth (+2)")
+		assert.NotContains(t, embed.Description, "mainnet")
+		assert.Contains(t, embed.Description, "**No recent data**\n")
+		assert.Contains(t, embed.Description, "⏳ **fusaka-devnet-2**: latest summary from")
+		assert.Contains(t, embed.Description, "❔ **glamsterdam-devnet-0**: no stored summaries")
+	})
+
+	t.Run("no problems", func(t *testing.T) {
+		embed := buildProblemsEmbed([]*networkProblem{problems[0], problems[5]}, stale)
+
+		assert.Equal(t, colorGood, embed.Color)
+		assert.Contains(t, embed.Description, "✅ No problems across the 1 registered Hive summaries with recent data")
+		assert.Contains(t, embed.Description, "glamsterdam-devnet-0")
+	})
+
+	t.Run("regressions named up to a limit", func(t *testing.T) {
+		regressions := []regression{
+			{client: "geth", prevFails: 0, currFails: 5},
+			{client: "besu", prevFails: 1, currFails: 4},
+			{client: "nethermind", prevFails: 0, currFails: 2},
+			{client: "reth", prevFails: 0, currFails: 1},
+			{client: "erigon", prevFails: 0, currFails: 1},
+		}
+
+		assert.Equal(t, "geth (+5), besu (+3), nethermind (+2), 2 more", formatProblemRegressions(regressions))
+	})
+}
@@ -3,10 +3,13 @@ package hive
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/bwmarrin/discordgo"
+	"github.com/ethpandaops/panda-pulse/pkg/discord/cmd/common"
 	"github.com/ethpandaops/panda-pulse/pkg/hive"
+	"github.com/ethpandaops/panda-pulse/pkg/store"
 	"github.com/robfig/cron/v3"
 	"github.com/sirupsen/logrus"
 )
@@ -14,25 +17,39 @@ import (
 const (
 	msgHiveAlreadyRegistered = "ℹ️ Hive summary is already registered for **%s** in <#%s>"
 	msgHiveRegistered        = "✅ Successfully registered Hive summary for **%s** notifications in <#%s>"
-	defaultHiveSchedule      = "0 1 * * 1-5" // Weekdays at 1am UTC
+	msgHiveClientsFilter     = "\nClient breakdown is limited to: %s"
+	msgHiveRegressionsOnly   = "\nOnly clients that newly regressed since the previous summary will be posted"
+	defaultHiveSchedule      = store.DefaultHiveSummarySchedule
 )
 
-// handleRegister handles the register subcommand.
-func (c *HiveCommand) handleRegister(s *discordgo.Session, i *discordgo.InteractionCreate, cmd *discordgo.ApplicationCommandInteractionDataOption) {
+// handleRegister handles the register and subscribe-regressions subcommands, the latter registering
+// an alert that only posts new regressions.
+func (c *HiveCommand) handleRegister(
+	s *discordgo.Session,
+	i *discordgo.InteractionCreate,
+	cmd *discordgo.ApplicationCommandInteractionDataOption,
+	regressionsOnly bool,
+) {
 	var (
 		options  = cmd.Options
-		network  = options[0].StringValue()
-		channel  = options[1].ChannelValue(s)
+		network  = common.FindOption(options, optionNameNetwork).StringValue()
+		channel  = common.FindOption(options, "channel").ChannelValue(s)
 		guildID  = i.GuildID // Get the guild ID from the interaction
-		schedule = defaultHiveSchedule
+		schedule string
 		suite    = ""
+		clients  []string
+		full     bool
 	)
 
-	// Extract suite and schedule from options
+	// Extract suite, schedule and client filter from options
 	for _, opt := range options {
 		switch opt.Name {
 		case optionNameSuite:
 			suite = opt.StringValue()
+		case optionNameClients:
+			clients = hive.NormalizeClients(strings.Split(opt.StringValue(), ","))
+		case optionNameFullOverview:
+			full = opt.BoolValue()
 		case "schedule":
 			schedule = opt.StringValue()
 
@@ -86,55 +103,43 @@ func (c *HiveCommand) handleRegister(s *discordgo.Session, i *discordgo.Interact
 		}
 	}
 
+	if schedule == "" {
+		schedule = c.defaultSchedule(context.Background(), guildID)
+	}
+
 	// Create a new alert.
 	alert := &hive.HiveSummaryAlert{
-		Network:        network,
-		Suite:          suite,
-		DiscordChannel: channel.ID,
-		DiscordGuildID: guildID,
-		Enabled:        true,
-		Schedule:       schedule,
-		CreatedAt:      time.Now(),
-		UpdatedAt:      time.Now(),
+		Network:         network,
+		Suite:           suite,
+		Clients:         clients,
+		FullOverview:    full,
+		RegressionsOnly: regressionsOnly,
+		DiscordChannel:  channel.ID,
+		DiscordGuildID:  guildID,
+		Enabled:         true,
+		Schedule:        schedule,
+		CreatedAt:       time.Now(),
+		UpdatedAt:       time.Now(),
 	}
 
-	// Persist the alert.
-	if persistErr := c.bot.GetHiveSummaryRepo().Persist(context.Background(), alert); persistErr != nil {
-		c.respondWithError(s, i, fmt.Sprintf("Failed to persist alert: %v", persistErr))
+	if scheduleErr := c.scheduleSummary(alert); scheduleErr != nil {
+		c.respondWithError(s, i, fmt.Sprintf("Failed to register Hive summary: %v", scheduleErr))
 
 		return
 	}
 
-	// Schedule the alert.
-	jobName := fmt.Sprintf("hive-summary-%s", network)
+	// Respond with success.
+	successMsg := fmt.Sprintf(msgHiveRegistered, network, channel.ID)
 	if suite != "" {
-		jobName = fmt.Sprintf("hive-summary-%s-%s", network, suite)
+		successMsg = fmt.Sprintf("✅ Successfully registered Hive summary for **%s** (suite: %s) notifications in <#%s>", network, suite, channel.ID)
 	}
 
-	c.log.WithFields(logrus.Fields{
-		"network": network,
-		"channel": channel.Name,
-		"key":     jobName,
-	}).Info("Registered Hive summary")
-
-	// Schedule the alert to run on our schedule.
-	if addErr := c.bot.GetScheduler().AddJob(jobName, alert.Schedule, func(ctx context.Context) error {
-		return c.RunHiveSummary(ctx, alert)
-	}); addErr != nil {
-		c.respondWithError(s, i, fmt.Sprintf("Failed to schedule alert: %v", addErr))
-
-		return
+	if len(clients) > 0 {
+		successMsg += fmt.Sprintf(msgHiveClientsFilter, strings.Join(clients, ", "))
 	}
 
-	c.log.WithFields(logrus.Fields{
-		"schedule": alert.Schedule,
-		"key":      jobName,
-	}).Info("Scheduled Hive summary")
-
-	// Respond with success.
-	successMsg := fmt.Sprintf(msgHiveRegistered, network, channel.ID)
-	if suite != "" {
-		successMsg = fmt.Sprintf("✅ Successfully registered Hive summary for **%s** (suite: %s) notifications in <#%s>", network, suite, channel.ID)
+	if regressionsOnly {
+		successMsg += msgHiveRegressionsOnly
 	}
 
 	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
@@ -148,3 +153,42 @@ func (c *HiveCommand) handleRegister(s *discordgo.Session, i *discordgo.Interact
 		c.log.WithError(err).Error("Failed to respond to interaction")
 	}
 }
+
+// defaultSchedule returns the schedule for summaries registered without one, preferring the guild's
+// override over the default Hive schedule.
+func (c *HiveCommand) defaultSchedule(ctx context.Context, guildID string) string {
+	overrides := common.LoadGuildOverrides(ctx, c.log, c.bot.GetGuildConfigRepo(), guildID)
+
+	return overrides.Get(common.GuildConfigHiveSchedule, defaultHiveSchedule)
+}
+
+// scheduleSummary persists a Hive summary alert and schedules it to run.
+func (c *HiveCommand) scheduleSummary(alert *hive.HiveSummaryAlert) error {
+	// Persist the alert.
+	if err := c.bot.GetHiveSummaryRepo().Persist(context.Background(), alert); err != nil {
+		return fmt.Errorf("failed to persist alert: %w", err)
+	}
+
+	// Schedule the alert.
+	jobName := SummaryJobName(alert.Network, alert.Suite)
+
+	c.log.WithFields(logrus.Fields{
+		"network": alert.Network,
+		"channel": alert.DiscordChannel,
+		"key":     jobName,
+	}).Info("Registered Hive summary")
+
+	// Schedule the alert to run on our schedule.
+	if err := c.bot.GetScheduler().AddJob(jobName, alert.Schedule, func(ctx context.Context) error {
+		return c.RunScheduledSummary(ctx, alert)
+	}); err != nil {
+		return fmt.Errorf("failed to schedule alert: %w", err)
+	}
+
+	c.log.WithFields(logrus.Fields{
+		"schedule": alert.Schedule,
+		"key":      jobName,
+	}).Info("Scheduled Hive summary")
+
+	return nil
+}
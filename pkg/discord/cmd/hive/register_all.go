@@ -0,0 +1,185 @@
+package hive
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/ethpandaops/panda-pulse/pkg/discord/cmd/common"
+	"github.com/ethpandaops/panda-pulse/pkg/hive"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	msgNoActiveNetworks       = "ℹ️ No active networks found"
+	msgHiveRegisterAllPreview = "⚠️ This will register Hive summaries on **%d** active networks in <#%s>, networks without Hive results are skipped:\n%s\n\nRe-run with `confirm: True` to proceed"
+	msgHiveRegisterAllHeader  = "🧾 Registered Hive summaries for all active networks in <#%s>"
+	skipReasonRegistered      = "already registered"
+	skipReasonUnavailable     = "Hive not available"
+	// registerAllTimeout bounds a bulk registration, leaving time to report results before the
+	// 15 minute interaction token expires.
+	registerAllTimeout = 10 * time.Minute
+)
+
+// getRegisterAllCommandDefinition returns the '/hive register-all-networks' subcommand definition.
+func (c *HiveCommand) getRegisterAllCommandDefinition() *discordgo.ApplicationCommandOption {
+	return &discordgo.ApplicationCommandOption{
+		Name:        "register-all-networks",
+		Description: "Register Hive summaries for every active network (admin)",
+		Type:        discordgo.ApplicationCommandOptionSubCommand,
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Name:        "channel",
+				Description: "Channel to send summaries to",
+				Type:        discordgo.ApplicationCommandOptionChannel,
+				Required:    true,
+				ChannelTypes: []discordgo.ChannelType{
+					discordgo.ChannelTypeGuildText,
+				},
+			},
+			{
+				Name:        "confirm",
+				Description: "Confirm registering every network, omit to preview what would be registered",
+				Type:        discordgo.ApplicationCommandOptionBoolean,
+				Required:    false,
+			},
+		},
+	}
+}
+
+// handleRegisterAllNetworks handles the '/hive register-all-networks' subcommand.
+func (c *HiveCommand) handleRegisterAllNetworks(s *discordgo.Session, i *discordgo.InteractionCreate, cmd *discordgo.ApplicationCommandInteractionDataOption) {
+	ctx, cancel := context.WithTimeout(context.Background(), registerAllTimeout)
+	defer cancel()
+
+	var (
+		channel *discordgo.Channel
+		confirm bool
+		guildID = i.GuildID
+	)
+
+	for _, opt := range cmd.Options {
+		switch opt.Name {
+		case "channel":
+			channel = opt.ChannelValue(s)
+		case "confirm":
+			confirm = opt.BoolValue()
+		}
+	}
+
+	if channel.Type != discordgo.ChannelTypeGuildText {
+		c.respondWithError(s, i, "🚫 Alerts can only be registered in text channels")
+
+		return
+	}
+
+	networks := c.bot.GetCartographoor().GetActiveNetworks()
+	if len(networks) == 0 {
+		c.respondWithError(s, i, msgNoActiveNetworks)
+
+		return
+	}
+
+	alerts, err := c.bot.GetHiveSummaryRepo().List(ctx)
+	if err != nil {
+		c.respondWithError(s, i, fmt.Sprintf("Failed to list alerts: %v", err))
+
+		return
+	}
+
+	registered := make(map[string]bool, len(alerts))
+
+	for _, alert := range alerts {
+		if alert.DiscordGuildID == guildID && alert.Suite == "" {
+			registered[alert.Network] = true
+		}
+	}
+
+	if !confirm {
+		lines := make([]string, 0, len(networks))
+
+		for _, network := range networks {
+			if registered[network] {
+				lines = append(lines, fmt.Sprintf("- %s (%s, skipped)", network, skipReasonRegistered))
+
+				continue
+			}
+
+			lines = append(lines, fmt.Sprintf("- %s", network))
+		}
+
+		c.respondWithError(s, i, fmt.Sprintf(msgHiveRegisterAllPreview, len(networks), channel.ID, strings.Join(lines, "\n")))
+
+		return
+	}
+
+	// Checking Hive availability for every network takes a while, so acknowledge the interaction first.
+	if respondErr := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Flags: discordgo.MessageFlagsEphemeral,
+		},
+	}); respondErr != nil {
+		c.log.WithError(respondErr).Error("Failed to send deferred response")
+
+		return
+	}
+
+	schedule := c.defaultSchedule(ctx, guildID)
+
+	outcomes := common.RunBulk(ctx, networks, c.config.Concurrency, func(ctx context.Context, network string) common.BulkOutcome {
+		return c.registerNetworkSummary(ctx, network, channel.ID, guildID, schedule, registered[network])
+	})
+
+	c.log.WithFields(logrus.Fields{
+		"channel":  channel.ID,
+		"guild":    guildID,
+		"networks": len(networks),
+	}).Info("Registered Hive summaries for all active networks")
+
+	if _, editErr := s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+		Content: new(common.FormatBulkOutcomes(fmt.Sprintf(msgHiveRegisterAllHeader, channel.ID), outcomes)),
+	}); editErr != nil {
+		c.log.WithError(editErr).Error("Failed to edit deferred response")
+	}
+}
+
+// registerNetworkSummary registers a Hive summary for a single network as part of a bulk registration.
+func (c *HiveCommand) registerNetworkSummary(ctx context.Context, network, channelID, guildID, schedule string, registered bool) common.BulkOutcome {
+	outcome := common.BulkOutcome{Network: network}
+
+	if registered {
+		outcome.SkipReason = skipReasonRegistered
+
+		return outcome
+	}
+
+	available, err := c.bot.GetHive().IsAvailable(ctx, network)
+	if err != nil {
+		outcome.Err = fmt.Errorf("failed to check Hive availability: %w", err)
+
+		return outcome
+	}
+
+	if !available {
+		outcome.SkipReason = skipReasonUnavailable
+
+		return outcome
+	}
+
+	now := time.Now()
+
+	outcome.Err = c.scheduleSummary(&hive.HiveSummaryAlert{
+		Network:        network,
+		DiscordChannel: channelID,
+		DiscordGuildID: guildID,
+		Enabled:        true,
+		Schedule:       schedule,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	})
+
+	return outcome
+}
@@ -0,0 +1,198 @@
+package hive
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/ethpandaops/panda-pulse/pkg/hive"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	optionNameCount          = "count"
+	defaultRegressionsCount  = 1
+	maxRegressionsCount      = 7
+	regressionsEmbedColor    = 0xFF6B6B
+	noRegressionsEmbedColor  = 0x51CF66
+	msgInsufficientHistory   = "ℹ️ Not enough Hive history for **%s** to detect regressions yet, at least two stored summaries are needed"
+	msgRegressionsFetchError = "❌ Failed to load Hive summaries for **%s**: %v"
+)
+
+// handleRegressions handles the '/hive regressions' subcommand.
+func (c *HiveCommand) handleRegressions(s *discordgo.Session, i *discordgo.InteractionCreate, cmd *discordgo.ApplicationCommandInteractionDataOption) {
+	var (
+		network string
+		suite   string
+		count   = defaultRegressionsCount
+	)
+
+	for _, opt := range cmd.Options {
+		switch opt.Name {
+		case optionNameNetwork:
+			network = opt.StringValue()
+		case optionNameSuite:
+			suite = opt.StringValue()
+		case optionNameCount:
+			count = int(opt.IntValue())
+		}
+	}
+
+	count = max(1, min(count, maxRegressionsCount))
+
+	// We need one more summary than comparisons requested.
+	summaries, err := c.bot.GetHiveSummaryRepo().GetRecentSummaryResultsWithSuite(context.Background(), network, suite, count+1)
+	if err != nil {
+		c.log.WithError(err).Error("Failed to load Hive summaries")
+		c.respondWithError(s, i, fmt.Sprintf(msgRegressionsFetchError, network, err))
+
+		return
+	}
+
+	if len(summaries) < 2 {
+		c.respondWithError(s, i, fmt.Sprintf(msgInsufficientHistory, network))
+
+		return
+	}
+
+	embeds := make([]*discordgo.MessageEmbed, 0, len(summaries)-1)
+
+	for idx := range len(summaries) - 1 {
+		embeds = append(embeds, createRegressionsEmbed(network, suite, summaries[idx], summaries[idx+1]))
+	}
+
+	if err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Embeds: embeds,
+		},
+	}); err != nil {
+		c.log.WithError(err).Error("Failed to respond with regressions")
+	}
+}
+
+// createRegressionsEmbed builds an embed listing the regressions between two stored summaries.
+func createRegressionsEmbed(network, suite string, summary, prevSummary *hive.SummaryResult) *discordgo.MessageEmbed {
+	return buildRegressionsEmbed(network, suite, detectRegressions(summary, prevSummary), summary, prevSummary)
+}
+
+// buildRegressionsEmbed builds an embed listing the given regressions between two summaries.
+func buildRegressionsEmbed(
+	network, suite string,
+	regressions []regression,
+	summary, prevSummary *hive.SummaryResult,
+) *discordgo.MessageEmbed {
+	var (
+		title       = fmt.Sprintf("📉 Hive regressions • %s", network)
+		description = fmt.Sprintf("%s No regressions", iconSuccess)
+		color       = noRegressionsEmbedColor
+	)
+
+	if suite != "" {
+		title = fmt.Sprintf("📉 Hive regressions • %s • %s", network, suite)
+	}
+
+	if len(regressions) > 0 {
+		description = formatRegressions(regressions)
+		color = regressionsEmbedColor
+	}
+
+	return &discordgo.MessageEmbed{
+		Title:       title,
+		Description: description,
+		Color:       color,
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: fmt.Sprintf(
+				"%s → %s",
+				prevSummary.Timestamp.UTC().Format(threadDateFormat),
+				summary.Timestamp.UTC().Format(threadDateFormat),
+			),
+		},
+	}
+}
+
+// newRegressions returns the regressions not already reported, along with what's been reported once
+// they're posted. A client's regression is only reported again once its failures rise past those last
+// reported, and is forgotten once the client is back down to the failures it regressed from. The
+// given reported regressions are left untouched.
+func newRegressions(
+	summary *hive.SummaryResult,
+	regressions []regression,
+	reported map[string]hive.ReportedRegression,
+) ([]regression, map[string]hive.ReportedRegression) {
+	updated := make(map[string]hive.ReportedRegression, len(reported))
+
+	for client, prev := range reported {
+		if result, ok := summary.ClientResults[client]; ok && result.FailedTests <= prev.From {
+			continue
+		}
+
+		updated[client] = prev
+	}
+
+	fresh := make([]regression, 0, len(regressions))
+
+	for _, r := range regressions {
+		prev, ok := updated[r.client]
+		if ok && r.currFails <= prev.To {
+			continue
+		}
+
+		if !ok {
+			prev.From = r.prevFails
+		}
+
+		prev.To = r.currFails
+		updated[r.client] = prev
+
+		fresh = append(fresh, r)
+	}
+
+	return fresh, updated
+}
+
+// sendNewRegressions posts the clients that newly regressed since the previous summary for a
+// regressions only alert, staying silent if there are none. What's been posted is persisted on the
+// alert once it's sent, so standing regressions aren't posted again and unsent ones are retried.
+func (c *HiveCommand) sendNewRegressions(ctx context.Context, alert *hive.HiveSummaryAlert, summary, prevSummary *hive.SummaryResult) error {
+	if prevSummary == nil {
+		return nil
+	}
+
+	regressions, reported := newRegressions(summary, detectRegressions(summary, prevSummary), alert.ReportedRegressions)
+
+	log := c.log.WithFields(logrus.Fields{
+		"network":     alert.Network,
+		"suite":       alert.Suite,
+		"regressions": len(regressions),
+	})
+
+	if len(regressions) == 0 {
+		log.Info("No new Hive regressions, skipped notification")
+	} else {
+		if _, err := c.bot.GetSession().ChannelMessageSendEmbed(
+			alert.DiscordChannel,
+			buildRegressionsEmbed(alert.Network, alert.Suite, regressions, summary, prevSummary),
+		); err != nil {
+			return fmt.Errorf("failed to send regressions: %w", err)
+		}
+
+		log.Info("Sent new Hive regressions")
+	}
+
+	// Recoveries are forgotten even when nothing new was posted.
+	if maps.Equal(reported, alert.ReportedRegressions) {
+		return nil
+	}
+
+	alert.ReportedRegressions = reported
+	alert.UpdatedAt = time.Now()
+
+	if err := c.bot.GetHiveSummaryRepo().Persist(ctx, alert); err != nil {
+		c.log.WithError(err).Warn("Failed to persist reported regressions, they may be posted again")
+	}
+
+	return nil
+}
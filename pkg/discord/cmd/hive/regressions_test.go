@@ -0,0 +1,100 @@
+package hive
+
+import (
+	"testing"
+
+	"github.com/ethpandaops/panda-pulse/pkg/hive"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRegressions(t *testing.T) {
+	summary := func(failed int) *hive.SummaryResult {
+		return &hive.SummaryResult{
+			ClientResults: map[string]*hive.ClientSummary{
+				"geth": {
+					ClientName:  "geth",
+					TotalTests:  100,
+					PassedTests: 100 - failed,
+					FailedTests: failed,
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		failures []int  // geth's failures on each run.
+		posted   []bool // Whether a regression is posted on each run after the first.
+		reported map[string]hive.ReportedRegression
+	}{
+		{
+			name:     "new regression",
+			failures: []int{10, 20},
+			posted:   []bool{true},
+			reported: map[string]hive.ReportedRegression{"geth": {From: 10, To: 20}},
+		},
+		{
+			name:     "standing regression",
+			failures: []int{10, 20, 20, 15, 20},
+			posted:   []bool{true, false, false, false},
+			reported: map[string]hive.ReportedRegression{"geth": {From: 10, To: 20}},
+		},
+		{
+			name:     "regression rises again",
+			failures: []int{10, 20, 30},
+			posted:   []bool{true, true},
+			reported: map[string]hive.ReportedRegression{"geth": {From: 10, To: 30}},
+		},
+		{
+			name:     "recovers then regresses",
+			failures: []int{10, 20, 10, 15},
+			posted:   []bool{true, false, true},
+			reported: map[string]hive.ReportedRegression{"geth": {From: 10, To: 15}},
+		},
+		{
+			name:     "recovers",
+			failures: []int{10, 20, 5},
+			posted:   []bool{true, false},
+			reported: map[string]hive.ReportedRegression{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var reported map[string]hive.ReportedRegression
+
+			for idx := 1; idx < len(tt.failures); idx++ {
+				curr, prev := summary(tt.failures[idx]), summary(tt.failures[idx-1])
+
+				fresh, updated := newRegressions(curr, detectRegressions(curr, prev), reported)
+				assert.Equal(t, tt.posted[idx-1], len(fresh) > 0, "run %d", idx)
+
+				reported = updated
+			}
+
+			assert.Equal(t, tt.reported, reported)
+		})
+	}
+}
+
+func TestNewRegressions_LeavesReportedUntouched(t *testing.T) {
+	reported := map[string]hive.ReportedRegression{"geth": {From: 10, To: 20}}
+
+	curr := &hive.SummaryResult{
+		ClientResults: map[string]*hive.ClientSummary{
+			"geth": {TotalTests: 100, FailedTests: 30},
+		},
+	}
+	prev := &hive.SummaryResult{
+		ClientResults: map[string]*hive.ClientSummary{
+			"geth": {TotalTests: 100, FailedTests: 20},
+		},
+	}
+
+	fresh, updated := newRegressions(curr, detectRegressions(curr, prev), reported)
+	assert.Len(t, fresh, 1)
+	assert.Equal(t, hive.ReportedRegression{From: 10, To: 30}, updated["geth"])
+
+	// Nothing counts as reported until the caller has posted it.
+	assert.Equal(t, map[string]hive.ReportedRegression{"geth": {From: 10, To: 20}}, reported)
+}
@@ -5,13 +5,14 @@ import (
 	"fmt"
 
 	"github.com/bwmarrin/discordgo"
+	"github.com/ethpandaops/panda-pulse/pkg/discord/cmd/common"
 	"github.com/ethpandaops/panda-pulse/pkg/hive"
 )
 
 // handleRun handles the run subcommand.
 func (c *HiveCommand) handleRun(s *discordgo.Session, i *discordgo.InteractionCreate, cmd *discordgo.ApplicationCommandInteractionDataOption) {
 	var (
-		network = cmd.Options[0].StringValue()
+		network = common.FindOption(cmd.Options, optionNameNetwork).StringValue()
 		suite   = ""
 		guildID = i.GuildID
 	)
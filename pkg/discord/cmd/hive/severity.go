@@ -0,0 +1,114 @@
+package hive
+
+import (
+	"fmt"
+)
+
+const (
+	// DefaultGoodPassRate is the pass rate at or above which results are shown as healthy.
+	DefaultGoodPassRate = 99.5
+	// DefaultWarningPassRate is the pass rate below which results are shown as failing, results
+	// between it and the good pass rate are shown as a warning.
+	DefaultWarningPassRate = 95.0
+
+	colorGood    = 0x51CF66 // Green.
+	colorWarning = 0xF5A623 // Hive brand yellow/gold.
+	colorPoor    = 0xFF6B6B // Red.
+)
+
+// severity is how concerning a pass rate is.
+type severity int
+
+const (
+	severityGood severity = iota
+	severityWarning
+	severityPoor
+)
+
+// PassRateThresholds decide how pass rates are colored and marked in summaries, the same way for
+// the overview, its test types and each client.
+type PassRateThresholds struct {
+	// Good is the pass rate at or above which results are healthy, defaults to DefaultGoodPassRate.
+	Good float64
+	// Warning is the pass rate below which results are failing, defaults to DefaultWarningPassRate.
+	Warning float64
+}
+
+// withDefaults returns a copy of the thresholds with unset values defaulted.
+func (t PassRateThresholds) withDefaults() PassRateThresholds {
+	if t.Good == 0 {
+		t.Good = DefaultGoodPassRate
+	}
+
+	if t.Warning == 0 {
+		t.Warning = DefaultWarningPassRate
+	}
+
+	return t
+}
+
+// Validate checks the thresholds are percentages, with the good pass rate no lower than the warning
+// one. Unset thresholds are checked as their defaults.
+func (t PassRateThresholds) Validate() error {
+	t = t.withDefaults()
+
+	for _, threshold := range []float64{t.Good, t.Warning} {
+		if threshold < 0 || threshold > 100 {
+			return fmt.Errorf("pass rate %v is not a percentage", threshold)
+		}
+	}
+
+	if t.Warning > t.Good {
+		return fmt.Errorf("warning pass rate %v is above the good pass rate %v", t.Warning, t.Good)
+	}
+
+	return nil
+}
+
+// severity returns how concerning the pass rate is.
+func (t PassRateThresholds) severity(passRate float64) severity {
+	switch {
+	case passRate >= t.Good:
+		return severityGood
+	case passRate >= t.Warning:
+		return severityWarning
+	default:
+		return severityPoor
+	}
+}
+
+// color returns the embed color for the severity.
+func (s severity) color() int {
+	switch s {
+	case severityGood:
+		return colorGood
+	case severityWarning:
+		return colorWarning
+	default:
+		return colorPoor
+	}
+}
+
+// statusIcon returns the icon marking a client or test type with the severity.
+func (s severity) statusIcon() string {
+	switch s {
+	case severityGood:
+		return iconSuccess
+	case severityWarning:
+		return iconWarning
+	default:
+		return iconFailure
+	}
+}
+
+// indicator returns the colored dot marking an overall pass rate with the severity.
+func (s severity) indicator() string {
+	switch s {
+	case severityGood:
+		return iconExcellent
+	case severityWarning:
+		return iconMedium
+	default:
+		return iconPoor
+	}
+}
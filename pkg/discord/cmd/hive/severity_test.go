@@ -0,0 +1,142 @@
+package hive
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ethpandaops/panda-pulse/pkg/hive"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPassRateThresholds_ClientEmbed(t *testing.T) {
+	client := func(passed, failed int) *hive.ClientSummary {
+		total := passed + failed
+
+		return &hive.ClientSummary{
+			ClientName:  "geth",
+			TotalTests:  total,
+			PassedTests: passed,
+			FailedTests: failed,
+			PassRate:    float64(passed) / float64(total) * 100,
+		}
+	}
+
+	tests := []struct {
+		name       string
+		thresholds PassRateThresholds
+		result     *hive.ClientSummary
+		color      int
+		icon       string
+	}{
+		{
+			name:   "perfect score",
+			result: client(1000, 0),
+			color:  colorGood,
+			icon:   iconSuccess,
+		},
+		{
+			name:   "at the default good pass rate",
+			result: client(995, 5),
+			color:  colorGood,
+			icon:   iconSuccess,
+		},
+		{
+			name:   "just below the default good pass rate",
+			result: client(994, 6),
+			color:  colorWarning,
+			icon:   iconWarning,
+		},
+		{
+			name:   "at the default warning pass rate",
+			result: client(950, 50),
+			color:  colorWarning,
+			icon:   iconWarning,
+		},
+		{
+			name:   "just below the default warning pass rate",
+			result: client(949, 51),
+			color:  colorPoor,
+			icon:   iconFailure,
+		},
+		{
+			name:       "at a custom good pass rate",
+			thresholds: PassRateThresholds{Good: 99, Warning: 90},
+			result:     client(990, 10),
+			color:      colorGood,
+			icon:       iconSuccess,
+		},
+		{
+			name:       "at a custom warning pass rate",
+			thresholds: PassRateThresholds{Good: 99, Warning: 90},
+			result:     client(900, 100),
+			color:      colorWarning,
+			icon:       iconWarning,
+		},
+		{
+			name:       "just below a custom warning pass rate",
+			thresholds: PassRateThresholds{Good: 99, Warning: 90},
+			result:     client(899, 101),
+			color:      colorPoor,
+			icon:       iconFailure,
+		},
+		{
+			name:       "perfect score with a custom good pass rate of 100",
+			thresholds: PassRateThresholds{Good: 100},
+			result:     client(1000, 0),
+			color:      colorGood,
+			icon:       iconSuccess,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			embed := createClientEmbed("geth", tt.result, nil, nil, "", nil, tt.thresholds.withDefaults())
+
+			assert.Equal(t, tt.color, embed.Color)
+			assert.Equal(t, tt.icon+" Geth", embed.Title)
+		})
+	}
+}
+
+func TestPassRateThresholds_OverviewMatchesClients(t *testing.T) {
+	for _, thresholds := range []PassRateThresholds{{}, {Good: 99, Warning: 90}} {
+		thresholds = thresholds.withDefaults()
+
+		for _, passRate := range []float64{thresholds.Good, thresholds.Warning, thresholds.Warning - 0.1} {
+			summary := &hive.SummaryResult{
+				Network:         "devnet-0",
+				OverallPassRate: passRate,
+				TotalTests:      1000,
+				TotalFails:      1,
+				ClientResults: map[string]*hive.ClientSummary{
+					"geth": {ClientName: "geth", TotalTests: 1000, PassedTests: 999, FailedTests: 1, PassRate: passRate},
+				},
+			}
+
+			overview := createCombinedOverviewEmbed(summary, nil, comparisonBaseline, nil, "", thresholds)
+			client := createClientEmbed("geth", summary.ClientResults["geth"], nil, nil, "", nil, thresholds)
+
+			assert.Equal(t, client.Color, overview.Color, "pass rate %v", passRate)
+
+			var passRateField string
+
+			for _, field := range overview.Fields {
+				if strings.HasSuffix(field.Name, "Overall Pass Rate") {
+					passRateField = field.Name
+				}
+			}
+
+			assert.Equal(t, thresholds.severity(passRate).indicator()+" Overall Pass Rate", passRateField)
+		}
+	}
+}
+
+func TestPassRateThresholds_Validate(t *testing.T) {
+	require.NoError(t, PassRateThresholds{}.Validate())
+	require.NoError(t, PassRateThresholds{Good: 100, Warning: 100}.Validate())
+	require.NoError(t, PassRateThresholds{Warning: 50}.Validate())
+	require.Error(t, PassRateThresholds{Good: 101}.Validate())
+	require.Error(t, PassRateThresholds{Warning: -1}.Validate())
+	require.Error(t, PassRateThresholds{Good: 90}.Validate(), "the warning pass rate defaults above it")
+}
@@ -0,0 +1,41 @@
+package hive
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ethpandaops/panda-pulse/pkg/hive"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	msgHiveDataStale      = "⚠️ **Hive data is stale** for **%s**: the latest results are from <t:%d:R>, over %s ago. Hive may have stopped producing results, so what follows is based on old data"
+	msgHiveDataStaleSuite = "⚠️ **Hive data is stale** for **%s** (%s): the latest results are from <t:%d:R>, over %s ago. Hive may have stopped producing results, so what follows is based on old data"
+)
+
+// isStale returns whether results last produced at latest are older than the threshold. A
+// non-positive threshold disables the check.
+func isStale(latest, now time.Time, threshold time.Duration) bool {
+	return threshold > 0 && now.Sub(latest) > threshold
+}
+
+// sendStaleWarning warns the alert's channel that the summary's results are stale, so a broken Hive
+// pipeline isn't hidden behind a summary repeating the last results it produced.
+func (c *HiveCommand) sendStaleWarning(alert *hive.HiveSummaryAlert, summary *hive.SummaryResult) error {
+	c.log.WithFields(logrus.Fields{
+		"network": alert.Network,
+		"suite":   alert.Suite,
+		"latest":  summary.Timestamp,
+	}).Warn("Hive data is stale")
+
+	msg := fmt.Sprintf(msgHiveDataStale, alert.Network, summary.Timestamp.Unix(), c.config.StaleThreshold)
+	if alert.Suite != "" {
+		msg = fmt.Sprintf(msgHiveDataStaleSuite, alert.Network, alert.Suite, summary.Timestamp.Unix(), c.config.StaleThreshold)
+	}
+
+	if _, err := c.bot.GetSession().ChannelMessageSend(alert.DiscordChannel, msg); err != nil {
+		return fmt.Errorf("failed to send stale data warning: %w", err)
+	}
+
+	return nil
+}
@@ -3,11 +3,14 @@ package hive
 import (
 	"context"
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 	"time"
 
 	"github.com/bwmarrin/discordgo"
+	"github.com/ethpandaops/panda-pulse/pkg/discord/cmd/common"
+	"github.com/ethpandaops/panda-pulse/pkg/discord/message"
 	"github.com/ethpandaops/panda-pulse/pkg/hive"
 )
 
@@ -27,12 +30,13 @@ func (c *HiveCommand) sendHiveSummary(
 	alert *hive.HiveSummaryAlert,
 	summary *hive.SummaryResult,
 	prevSummary *hive.SummaryResult,
+	comparison comparisonState,
 	results []hive.TestResult,
 ) error {
 	session := c.bot.GetSession()
 
 	// Send the combined summary overview and test type breakdown in the main channel.
-	overviewEmbed := createCombinedOverviewEmbed(summary, prevSummary, results, alert.Suite)
+	overviewEmbed := createCombinedOverviewEmbed(summary, prevSummary, comparison, results, alert.Suite, c.config.PassRates)
 
 	// Create message send object.
 	messageSend := &discordgo.MessageSend{
@@ -66,9 +70,15 @@ func (c *HiveCommand) sendHiveSummary(
 	}
 
 	// Create a thread for the client details.
-	threadName := fmt.Sprintf("Hive Summary - %s", summary.Timestamp.Format(threadDateFormat))
-	if alert.Suite != "" {
-		threadName = fmt.Sprintf("Hive Summary (%s) - %s", alert.Suite, summary.Timestamp.Format(threadDateFormat))
+	vars := common.ThreadNameVars{
+		Network: alert.Network,
+		Date:    summary.Timestamp.Format(threadDateFormat),
+		Suite:   alert.Suite,
+	}
+
+	threadName := common.RenderThreadName(c.config.threadNameTemplate(alert.Suite), vars)
+	if threadName == "" {
+		threadName = common.RenderThreadName(defaultThreadNameTemplate(alert.Suite), vars)
 	}
 
 	thread, err := session.MessageThreadStartComplex(alert.DiscordChannel, mainMessage.ID, &discordgo.ThreadStart{
@@ -80,10 +90,17 @@ func (c *HiveCommand) sendHiveSummary(
 	}
 
 	// Send client breakdown as individual messages in the thread.
-	if err := sendClientBreakdownMessages(ctx, session, thread.ID, summary, prevSummary, results, c.bot.GetHive()); err != nil {
+	if err := sendClientBreakdownMessages(ctx, session, thread.ID, summary, prevSummary, results, c.bot.GetHive(), c.config.PassRates); err != nil {
 		return fmt.Errorf("failed to send client breakdown messages: %w", err)
 	}
 
+	// Follow up with which client and test type combinations are failing, narrowed down to the
+	// clients of interest even when the overview covers every client.
+	matrix := hive.ProcessMatrix(hive.FilterResults(results, alert.Clients))
+	if err := sendMatrixMessages(session, thread.ID, matrix); err != nil {
+		return fmt.Errorf("failed to send matrix messages: %w", err)
+	}
+
 	return nil
 }
 
@@ -96,6 +113,7 @@ func sendClientBreakdownMessages(
 	prevSummary *hive.SummaryResult,
 	results []hive.TestResult,
 	hiveClient hive.Hive,
+	thresholds PassRateThresholds,
 ) error {
 	// Sort clients by failures (descending).
 	clients := make([]string, 0, len(summary.ClientResults))
@@ -116,7 +134,7 @@ func sendClientBreakdownMessages(
 
 	// Send a message for each client.
 	for _, clientKey := range clients {
-		embed := createClientEmbed(clientKey, summary.ClientResults[clientKey], prevSummary, results, summary.Network, hiveClient)
+		embed := createClientEmbed(clientKey, summary.ClientResults[clientKey], prevSummary, results, summary.Network, hiveClient, thresholds)
 
 		_, err := session.ChannelMessageSendEmbed(threadID, embed)
 		if err != nil {
@@ -137,6 +155,7 @@ func createClientEmbed(
 	results []hive.TestResult,
 	network string,
 	hiveClient hive.Hive,
+	thresholds PassRateThresholds,
 ) *discordgo.MessageEmbed {
 	// Use a default name if ClientName is empty.
 	clientName := result.ClientName
@@ -148,7 +167,7 @@ func createClientEmbed(
 	fields := []*discordgo.MessageEmbedField{}
 
 	// Add version info if available.
-	cleanVersion := cleanVersionString(result.ClientVersion)
+	cleanVersion := hive.CleanVersion(result.ClientVersion)
 	if cleanVersion != "" && cleanVersion != "unknown" {
 		fields = append(fields, &discordgo.MessageEmbedField{
 			Name:   "Version",
@@ -253,33 +272,17 @@ func createClientEmbed(
 	}
 
 	if changeValue != "" {
-		// Discord has a 1024 character limit for embed field values
-		const maxFieldLength = 1024
-		if len(changeValue) > maxFieldLength {
-			// Truncate and add ellipsis
-			changeValue = changeValue[:maxFieldLength-3] + "..."
-		}
-
 		fields = append(fields, &discordgo.MessageEmbedField{
 			Name:   "Details",
-			Value:  changeValue,
+			Value:  message.Truncate(changeValue, message.MaxEmbedFieldValue),
 			Inline: false,
 		})
 	}
 
-	// Determine embed color based on pass rate
-	var color int
-
-	statusIcon := iconSuccess
-
+	// Determine embed color and status based on pass rate, a perfect score is always healthy.
+	level := thresholds.severity(result.PassRate)
 	if result.FailedTests == 0 {
-		color = 0x51CF66 // Green for perfect score
-	} else if result.PassRate >= 99 {
-		color = 0xF5A623 // Yellow/gold for very high pass rate
-		statusIcon = iconWarning
-	} else {
-		color = 0xFF6B6B // Red for concerning pass rate
-		statusIcon = iconFailure
+		level = severityGood
 	}
 
 	// Format client name with proper casing
@@ -301,26 +304,41 @@ func createClientEmbed(
 	}
 
 	embed := &discordgo.MessageEmbed{
-		Title:  fmt.Sprintf("%s %s", statusIcon, displayName),
-		Color:  color,
+		Title:  fmt.Sprintf("%s %s", level.statusIcon(), displayName),
+		Color:  level.color(),
 		Fields: fields,
 	}
 
 	return embed
 }
 
+// comparisonState is whether a summary could be compared against the previous one.
+type comparisonState int
+
+const (
+	// comparisonAvailable means the previous summary was loaded, if it differed from this one.
+	comparisonAvailable comparisonState = iota
+	// comparisonBaseline means no summary has been stored before, so this one is the baseline.
+	comparisonBaseline
+	// comparisonFailed means there's history, but the previous summary couldn't be loaded.
+	comparisonFailed
+)
+
 // createCombinedOverviewEmbed creates an embed with the summary overview and test type breakdown.
-func createCombinedOverviewEmbed(summary *hive.SummaryResult, prevSummary *hive.SummaryResult, results []hive.TestResult, suite string) *discordgo.MessageEmbed {
+func createCombinedOverviewEmbed(
+	summary *hive.SummaryResult,
+	prevSummary *hive.SummaryResult,
+	comparison comparisonState,
+	results []hive.TestResult,
+	suite string,
+	thresholds PassRateThresholds,
+) *discordgo.MessageEmbed {
 	// Format the timestamp in a user-friendly way using UTC.
 	lastUpdated := summary.Timestamp.UTC().Format("Mon, 2 Jan 2006")
 
 	// Create the overview fields with improved formatting
-	passRateIcon := iconExcellent
-	if summary.OverallPassRate < 95 {
-		passRateIcon = iconPoor
-	} else if summary.OverallPassRate < 99.5 {
-		passRateIcon = iconMedium
-	}
+	overall := thresholds.severity(summary.OverallPassRate)
+	passRateIcon := overall.indicator()
 
 	failureIcon := iconSuccess
 	if summary.TotalFails > 0 {
@@ -378,13 +396,13 @@ func createCombinedOverviewEmbed(summary *hive.SummaryResult, prevSummary *hive.
 		testTypeResults[testType] = stats
 	}
 
-	// Sort test types alphabetically.
+	// Sort test types naturally, so numbered ones are in numeric order.
 	testTypes := make([]string, 0, len(testTypeResults))
 	for testType := range testTypeResults {
 		testTypes = append(testTypes, testType)
 	}
 
-	sort.Strings(testTypes)
+	slices.SortFunc(testTypes, hive.NaturalCompare)
 
 	// Add test type fields with improved formatting
 	for _, testType := range testTypes {
@@ -398,12 +416,7 @@ func createCombinedOverviewEmbed(summary *hive.SummaryResult, prevSummary *hive.
 		}
 
 		// Add status indicator
-		statusIcon := iconSuccess
-		if passRate < 95 {
-			statusIcon = iconFailure
-		} else if passRate < 100 {
-			statusIcon = iconWarning
-		}
+		statusIcon := thresholds.severity(passRate).statusIcon()
 
 		// Format the pass rate with appropriate precision
 		var passRateStr string
@@ -427,22 +440,39 @@ func createCombinedOverviewEmbed(summary *hive.SummaryResult, prevSummary *hive.
 		})
 	}
 
+	// Call out any clients that regressed since the previous summary.
+	if regressions := detectRegressions(summary, prevSummary); len(regressions) > 0 {
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:   "📉 Regressions since last check",
+			Value:  truncateFieldValue(formatRegressions(regressions)),
+			Inline: false,
+		})
+	}
+
+	// Explain why there are no change indicators, rather than leaving viewers to wonder.
+	switch comparison {
+	case comparisonBaseline:
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:   "🆕 Baseline run",
+			Value:  "No previous summary to compare against, changes and regressions will show from the next run",
+			Inline: false,
+		})
+	case comparisonFailed:
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:   "⚠️ No comparison",
+			Value:  "The previous summary couldn't be loaded, so changes and regressions aren't shown for this run",
+			Inline: false,
+		})
+	}
+
 	// Create title with optional suite information
 	title := fmt.Sprintf("Ethereum Hive • %s", summary.Network)
 	if suite != "" {
 		title = fmt.Sprintf("Ethereum Hive • %s • %s", summary.Network, suite)
 	}
 
-	// Determine color based on overall pass rate
-	embedColor := 0xF5A623 // Hive brand yellow/gold
-	if summary.OverallPassRate < 95 {
-		embedColor = 0xFF6B6B // Red for concerning pass rates
-	} else if summary.OverallPassRate >= 99.5 {
-		embedColor = 0x51CF66 // Green for excellent pass rates
-	}
-
 	return &discordgo.MessageEmbed{
-		Color:  embedColor,
+		Color:  overall.color(),
 		Fields: fields,
 		Author: &discordgo.MessageEmbedAuthor{
 			Name:    title,
@@ -501,6 +531,78 @@ func buildTestSuiteLinks(clientName string, results []hive.TestResult, network s
 	return fmt.Sprintf("📊 [View detailed results in Hive](%s)", hiveURL)
 }
 
+// regression is a client whose failures increased between two summaries.
+type regression struct {
+	client       string
+	prevFails    int
+	currFails    int
+	prevPassRate float64
+	currPassRate float64
+}
+
+// detectRegressions compares two summaries and returns the clients whose failures increased,
+// worst first. Clients missing from either summary are ignored.
+func detectRegressions(summary, prevSummary *hive.SummaryResult) []regression {
+	if summary == nil || prevSummary == nil {
+		return nil
+	}
+
+	regressions := make([]regression, 0)
+
+	for clientKey, result := range summary.ClientResults {
+		prevClient, ok := prevSummary.ClientResults[clientKey]
+		if !ok || prevClient.TotalTests == 0 || result.TotalTests == 0 {
+			continue
+		}
+
+		if result.FailedTests <= prevClient.FailedTests {
+			continue
+		}
+
+		regressions = append(regressions, regression{
+			client:       clientKey,
+			prevFails:    prevClient.FailedTests,
+			currFails:    result.FailedTests,
+			prevPassRate: prevClient.PassRate,
+			currPassRate: result.PassRate,
+		})
+	}
+
+	sort.Slice(regressions, func(i, j int) bool {
+		a := regressions[i].currFails - regressions[i].prevFails
+		b := regressions[j].currFails - regressions[j].prevFails
+
+		if a != b {
+			return a > b
+		}
+
+		return regressions[i].client < regressions[j].client
+	})
+
+	return regressions
+}
+
+// formatRegressions formats regressions as one line per client.
+func formatRegressions(regressions []regression) string {
+	var sb strings.Builder
+
+	for _, r := range regressions {
+		fmt.Fprintf(
+			&sb,
+			"%s **%s**: %d → %d failures (+%d), pass rate %s → %s\n",
+			iconFailure,
+			r.client,
+			r.prevFails,
+			r.currFails,
+			r.currFails-r.prevFails,
+			formatPassRate(r.prevPassRate, r.prevFails),
+			formatPassRate(r.currPassRate, r.currFails),
+		)
+	}
+
+	return sb.String()
+}
+
 // detectAnomalies in test results.
 func detectAnomalies(clientKey string, result *hive.ClientSummary, prevSummary *hive.SummaryResult, results []hive.TestResult) []string {
 	// If no previous summary, we can't detect anomalies.
@@ -599,63 +701,3 @@ func detectAnomalies(clientKey string, result *hive.ClientSummary, prevSummary *
 
 	return anomalies
 }
-
-// cleanVersionString cleans up version strings to make them more readable.
-func cleanVersionString(version string) string {
-	if version == "" || version == "unknown" {
-		return ""
-	}
-
-	// Generic pattern: client/version/platform
-	// Examples:
-	// - Geth/v1.15.0-unstable-7f0dd394-20250204/linux-amd64/...
-	// - besu/v25.3-develop-083b1d3/linux-x86_64/openjdk-java...
-	// - nimbus-eth1/v0.1.0-45767278/linux-amd64/Nim-2.0.14...
-	if strings.Contains(version, "/") {
-		parts := strings.Split(version, "/")
-		if len(parts) >= 2 {
-			// Check if the second part looks like a version (starts with v or has digits)
-			if strings.HasPrefix(parts[1], "v") || containsDigit(parts[1]) {
-				return parts[1] // Return the version part
-			}
-		}
-	}
-
-	// Handle colon-separated formats
-	// Examples:
-	// - reth Version: 1.2.2
-	// - geth Version: 1.22
-	// - version: 1.09
-	// - Platform: Linux x64
-	if strings.Contains(version, ":") {
-		parts := strings.Split(version, ":")
-		if len(parts) >= 2 {
-			// Check if the second part contains digits (likely a version number)
-			secondPart := strings.TrimSpace(parts[1])
-			if containsDigit(secondPart) {
-				return secondPart
-			}
-
-			return secondPart // Return whatever is after the colon
-		}
-	}
-
-	// Limit length
-	maxLen := 30
-	if len(version) > maxLen {
-		version = version[:maxLen] + "..."
-	}
-
-	return strings.TrimSpace(version)
-}
-
-// containsDigit checks if a string contains at least one digit.
-func containsDigit(s string) bool {
-	for _, c := range s {
-		if c >= '0' && c <= '9' {
-			return true
-		}
-	}
-
-	return false
-}
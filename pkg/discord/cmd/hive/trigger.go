@@ -213,7 +213,7 @@ func (c *HiveCommand) hasTriggerPermission(
 	return false
 }
 
-// handleClientAutocomplete handles autocomplete for client selection in the trigger subcommand.
+// handleClientAutocomplete handles autocomplete for client selection in the trigger and failures subcommands.
 func (c *HiveCommand) handleClientAutocomplete(
 	s *discordgo.Session,
 	i *discordgo.InteractionCreate,
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/bwmarrin/discordgo"
+	"github.com/ethpandaops/panda-pulse/pkg/discord/message"
 	"github.com/ethpandaops/panda-pulse/pkg/store"
 )
 
@@ -147,10 +148,7 @@ func (c *MentionsCommand) buildMentionsTable(mentions map[string]*store.ClientMe
 		)
 
 		if exists {
-			mentionsStr = strings.Join(mention.Mentions, " ")
-			if len(mentionsStr) > 25 {
-				mentionsStr = mentionsStr[:22] + "..."
-			}
+			mentionsStr = message.Truncate(strings.Join(mention.Mentions, " "), 25)
 
 			if mention.Enabled {
 				status = "✅"
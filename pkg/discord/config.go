@@ -2,16 +2,34 @@ package discord
 
 import (
 	"strings"
+	"time"
 
 	"github.com/ethpandaops/panda-pulse/pkg/clients"
 	"github.com/ethpandaops/panda-pulse/pkg/discord/cmd/common"
+	"github.com/ethpandaops/panda-pulse/pkg/store"
 )
 
 // Config represents the configuration for the Discord bot.
 type Config struct {
-	DiscordToken string   `yaml:"discordToken"`
-	GithubToken  string   `yaml:"githubToken"`
-	GuildIDs     []string `yaml:"guildIds"` // Optional: if set, commands will be registered to these guilds only
+	DiscordToken           string   `yaml:"discordToken"`
+	GithubToken            string   `yaml:"githubToken"`
+	GuildIDs               []string `yaml:"guildIds"`               // Optional: if set, commands will be registered to these guilds only
+	Intents                []string `yaml:"intents"`                // Optional: gateway intent names, defaults to DefaultIntents
+	Maintenance            bool     `yaml:"maintenance"`            // Optional: start in maintenance mode, suppressing notifications
+	AdminChannelID         string   `yaml:"adminChannelId"`         // Optional: channel operational reports, such as orphaned alerts, are sent to
+	OrphanedAlertsSchedule string   `yaml:"orphanedAlertsSchedule"` // Optional: when alerts are checked for dead channels, defaults to DefaultOrphanedAlertsSchedule
+	DisableOrphanedAlerts  bool     `yaml:"disableOrphanedAlerts"`  // Optional: disable alerts with dead channels, rather than only reporting them
+	CatchUpMissedRuns      bool     `yaml:"catchUpMissedRuns"`      // Optional: on startup, run alerts once that missed a scheduled run
+
+	// Optional: spreads the first run of each existing alert and Hive summary over this long after
+	// startup, so they don't all fire together. Later runs are on their schedule.
+	StartupSpread time.Duration `yaml:"startupSpread"`
+
+	// Optional: how long Hive summary results are kept, daily then weekly, see store.SummaryRetention.
+	SummaryRetention store.SummaryRetention `yaml:"summaryRetention"`
+
+	// Optional: overrides who may run each "command subcommand" or "command", see common.DefaultPermissions.
+	Permissions map[string]common.PermissionLevel `yaml:"permissions"`
 }
 
 // AsRoleConfig returns the role configuration.
@@ -28,5 +46,6 @@ func (c *Config) AsRoleConfig() *common.RoleConfig {
 	return &common.RoleConfig{
 		AdminRoles:  adminRoles,
 		ClientRoles: clients.TeamRoles,
+		Permissions: c.Permissions,
 	}
 }
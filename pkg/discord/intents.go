@@ -0,0 +1,113 @@
+package discord
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/sirupsen/logrus"
+)
+
+// Gateway intents used by the bot.
+//
+// Slash commands are delivered as interactions, which don't require any intent. The guilds intent is
+// required so the session state is populated with guild roles, which permission checks and mention
+// listings rely on. Message content is privileged and only needed by features that read the content
+// of regular messages.
+const (
+	// RequiredIntents are the intents the bot can't function correctly without.
+	RequiredIntents = discordgo.IntentsGuilds
+	// DefaultIntents are the intents requested when none are configured.
+	DefaultIntents = RequiredIntents
+	// PrivilegedIntents must also be enabled for the application in the Discord developer portal.
+	PrivilegedIntents = discordgo.IntentsGuildMembers | discordgo.IntentsGuildPresences | discordgo.IntentsMessageContent
+)
+
+// intentNames maps configurable intent names to their gateway intent.
+var intentNames = map[string]discordgo.Intent{
+	"guilds":                   discordgo.IntentsGuilds,
+	"guild_members":            discordgo.IntentsGuildMembers,
+	"guild_bans":               discordgo.IntentsGuildBans,
+	"guild_emojis":             discordgo.IntentsGuildEmojis,
+	"guild_integrations":       discordgo.IntentsGuildIntegrations,
+	"guild_webhooks":           discordgo.IntentsGuildWebhooks,
+	"guild_invites":            discordgo.IntentsGuildInvites,
+	"guild_voice_states":       discordgo.IntentsGuildVoiceStates,
+	"guild_presences":          discordgo.IntentsGuildPresences,
+	"guild_messages":           discordgo.IntentsGuildMessages,
+	"guild_message_reactions":  discordgo.IntentsGuildMessageReactions,
+	"guild_message_typing":     discordgo.IntentsGuildMessageTyping,
+	"direct_messages":          discordgo.IntentsDirectMessages,
+	"direct_message_reactions": discordgo.IntentsDirectMessageReactions,
+	"direct_message_typing":    discordgo.IntentsDirectMessageTyping,
+	"message_content":          discordgo.IntentsMessageContent,
+	"guild_scheduled_events":   discordgo.IntentsGuildScheduledEvents,
+}
+
+// ParseIntents converts intent names into a gateway intent set. An empty list returns DefaultIntents.
+func ParseIntents(names []string) (discordgo.Intent, error) {
+	var (
+		intents discordgo.Intent
+		unknown []string
+	)
+
+	for _, name := range names {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+
+		intent, ok := intentNames[name]
+		if !ok {
+			unknown = append(unknown, name)
+
+			continue
+		}
+
+		intents |= intent
+	}
+
+	if len(unknown) > 0 {
+		return 0, fmt.Errorf("unknown discord intents: %s", strings.Join(unknown, ", "))
+	}
+
+	if intents == discordgo.IntentsNone {
+		return DefaultIntents, nil
+	}
+
+	return intents, nil
+}
+
+// intentNamesOf returns the sorted names of the intents in the set.
+func intentNamesOf(intents discordgo.Intent) []string {
+	names := make([]string, 0, len(intentNames))
+
+	for name, intent := range intentNames {
+		if intents&intent != 0 {
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// validateIntents logs warnings for intent sets that are likely to cause problems at runtime.
+func validateIntents(log *logrus.Logger, intents discordgo.Intent) {
+	if missing := RequiredIntents &^ intents; missing != 0 {
+		log.WithField("missing", intentNamesOf(missing)).Warn(
+			"Required Discord intents are not enabled, permission checks and role lookups will not work",
+		)
+	}
+
+	if privileged := intents & PrivilegedIntents; privileged != 0 {
+		log.WithField("privileged", intentNamesOf(privileged)).Warn(
+			"Privileged Discord intents requested, these must be enabled in the Discord developer portal " +
+				"or the gateway connection will be rejected",
+		)
+	}
+
+	log.WithField("intents", intentNamesOf(intents)).Info("Using Discord gateway intents")
+}
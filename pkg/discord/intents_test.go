@@ -0,0 +1,37 @@
+package discord
+
+import (
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseIntents(t *testing.T) {
+	t.Run("empty list uses defaults", func(t *testing.T) {
+		intents, err := ParseIntents(nil)
+		require.NoError(t, err)
+		assert.Equal(t, DefaultIntents, intents)
+	})
+
+	t.Run("names are combined", func(t *testing.T) {
+		intents, err := ParseIntents([]string{"guilds", " Message_Content ", ""})
+		require.NoError(t, err)
+		assert.Equal(t, discordgo.IntentsGuilds|discordgo.IntentsMessageContent, intents)
+	})
+
+	t.Run("unknown names are rejected", func(t *testing.T) {
+		_, err := ParseIntents([]string{"guilds", "nope"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "nope")
+	})
+}
+
+func TestIntentNamesOf(t *testing.T) {
+	assert.Equal(
+		t,
+		[]string{"guilds", "message_content"},
+		intentNamesOf(discordgo.IntentsMessageContent|discordgo.IntentsGuilds),
+	)
+}
@@ -0,0 +1,139 @@
+package message
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/ethpandaops/panda-pulse/pkg/store"
+)
+
+// Actions taken on an alert from the buttons on its notification, see store.AlertAction.
+const (
+	AlertActionAcknowledge = "ack"
+	AlertActionSnooze      = "snooze"
+	AlertActionUnsnooze    = "unsnooze"
+	AlertActionMute        = "mute"
+	AlertActionUnmute      = "unmute"
+
+	// AlertSnoozeDuration is how long the snooze button snoozes an alert for.
+	AlertSnoozeDuration = 24 * time.Hour
+
+	// alertActionCommand is the command the buttons' interactions are routed to.
+	alertActionCommand = "checks"
+	maxButtonLabel     = 80 // Discord caps button labels at 80 characters.
+)
+
+// AlertActionID returns the custom ID of an alert action button, "checks:<action>:<network>:<client>".
+func AlertActionID(action, network, client string) string {
+	return strings.Join([]string{alertActionCommand, action, network, client}, ":")
+}
+
+// ParseAlertActionID parses the custom ID of an alert action button, returning false if it isn't one.
+func ParseAlertActionID(customID string) (action, network, client string, ok bool) {
+	parts := strings.Split(customID, ":")
+	if len(parts) != 4 || parts[0] != alertActionCommand || parts[2] == "" || parts[3] == "" {
+		return "", "", "", false
+	}
+
+	return parts[1], parts[2], parts[3], true
+}
+
+// BuildAlertActionButtons builds the row of buttons acknowledging, snoozing and muting the alert,
+// reflecting any actions already taken on it.
+func BuildAlertActionButtons(alert *store.MonitorAlert, now time.Time) discordgo.ActionsRow {
+	id := func(action string) string {
+		return AlertActionID(action, alert.Network, alert.Client)
+	}
+
+	ack := discordgo.Button{
+		Label:    "👀 Acknowledge",
+		Style:    discordgo.PrimaryButton,
+		CustomID: id(AlertActionAcknowledge),
+	}
+
+	if alert.Acknowledged != nil {
+		ack.Label = buttonLabel("👀 Acknowledged by " + alert.Acknowledged.User)
+		ack.Style = discordgo.SecondaryButton
+		ack.Disabled = true
+	}
+
+	snooze := discordgo.Button{
+		Label:    "💤 Snooze 24h",
+		Style:    discordgo.SecondaryButton,
+		CustomID: id(AlertActionSnooze),
+	}
+
+	if alert.IsSnoozed(now) {
+		snooze.Label = buttonLabel(fmt.Sprintf("⏰ Unsnooze (until %s)", alert.Snoozed.Until.UTC().Format("Jan 2 15:04 UTC")))
+		snooze.CustomID = id(AlertActionUnsnooze)
+	}
+
+	mute := discordgo.Button{
+		Label:    "🔇 Mute client",
+		Style:    discordgo.DangerButton,
+		CustomID: id(AlertActionMute),
+	}
+
+	if alert.Muted != nil {
+		mute.Label = buttonLabel("🔊 Unmute client (muted by " + alert.Muted.User + ")")
+		mute.Style = discordgo.SecondaryButton
+		mute.CustomID = id(AlertActionUnmute)
+	}
+
+	return discordgo.ActionsRow{
+		Components: []discordgo.MessageComponent{ack, snooze, mute},
+	}
+}
+
+// ReplaceAlertActionButtons returns a message's components with its alert action buttons rebuilt to
+// reflect the alert, leaving the link buttons alone.
+func ReplaceAlertActionButtons(components []discordgo.MessageComponent, alert *store.MonitorAlert, now time.Time) []discordgo.MessageComponent {
+	replaced := make([]discordgo.MessageComponent, 0, len(components)+1)
+
+	for _, component := range components {
+		if !isAlertActionRow(component) {
+			replaced = append(replaced, component)
+		}
+	}
+
+	return append(replaced, BuildAlertActionButtons(alert, now))
+}
+
+// isAlertActionRow returns true if the component is the row of alert action buttons, whether it was
+// built here or came back from Discord.
+func isAlertActionRow(component discordgo.MessageComponent) bool {
+	var buttons []discordgo.MessageComponent
+
+	switch row := component.(type) {
+	case discordgo.ActionsRow:
+		buttons = row.Components
+	case *discordgo.ActionsRow:
+		buttons = row.Components
+	default:
+		return false
+	}
+
+	for _, button := range buttons {
+		var customID string
+
+		switch b := button.(type) {
+		case discordgo.Button:
+			customID = b.CustomID
+		case *discordgo.Button:
+			customID = b.CustomID
+		}
+
+		if _, _, _, ok := ParseAlertActionID(customID); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// buttonLabel truncates a button label to fit Discord's limit.
+func buttonLabel(label string) string {
+	return Truncate(label, maxButtonLabel)
+}
@@ -0,0 +1,106 @@
+package message
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/ethpandaops/panda-pulse/pkg/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlertActionID(t *testing.T) {
+	id := AlertActionID(AlertActionSnooze, "devnet-0", "geth")
+	assert.Equal(t, "checks:snooze:devnet-0:geth", id)
+
+	action, network, client, ok := ParseAlertActionID(id)
+	require.True(t, ok)
+	assert.Equal(t, AlertActionSnooze, action)
+	assert.Equal(t, "devnet-0", network)
+	assert.Equal(t, "geth", client)
+
+	for _, invalid := range []string{"", "checks:snooze", "build:copy:devnet-0:geth", "checks:snooze::geth", "checks:a:b:c:d"} {
+		_, _, _, ok := ParseAlertActionID(invalid)
+		assert.False(t, ok, invalid)
+	}
+}
+
+func TestBuildAlertActionButtons(t *testing.T) {
+	var (
+		now   = time.Date(2025, time.November, 20, 12, 0, 0, 0, time.UTC)
+		alert = &store.MonitorAlert{Network: "devnet-0", Client: "geth"}
+	)
+
+	buttons := func(alert *store.MonitorAlert) []discordgo.Button {
+		row := BuildAlertActionButtons(alert, now)
+		result := make([]discordgo.Button, 0, len(row.Components))
+
+		for _, component := range row.Components {
+			button, ok := component.(discordgo.Button)
+			require.True(t, ok)
+
+			result = append(result, button)
+		}
+
+		return result
+	}
+
+	t.Run("nothing taken", func(t *testing.T) {
+		btns := buttons(alert)
+		require.Len(t, btns, 3)
+		assert.Equal(t, "checks:ack:devnet-0:geth", btns[0].CustomID)
+		assert.False(t, btns[0].Disabled)
+		assert.Equal(t, "checks:snooze:devnet-0:geth", btns[1].CustomID)
+		assert.Equal(t, "checks:mute:devnet-0:geth", btns[2].CustomID)
+	})
+
+	t.Run("all taken", func(t *testing.T) {
+		taken := *alert
+		taken.Acknowledged = &store.AlertAction{User: "alice", At: now}
+		taken.Snoozed = &store.AlertAction{User: "bob", At: now, Until: now.Add(AlertSnoozeDuration)}
+		taken.Muted = &store.AlertAction{User: "carol", At: now}
+
+		btns := buttons(&taken)
+		assert.True(t, btns[0].Disabled)
+		assert.Contains(t, btns[0].Label, "alice")
+		assert.Equal(t, "checks:unsnooze:devnet-0:geth", btns[1].CustomID)
+		assert.Contains(t, btns[1].Label, "Nov 21 12:00 UTC")
+		assert.Equal(t, "checks:unmute:devnet-0:geth", btns[2].CustomID)
+		assert.Contains(t, btns[2].Label, "carol")
+	})
+
+	t.Run("snooze expired", func(t *testing.T) {
+		expired := *alert
+		expired.Snoozed = &store.AlertAction{User: "bob", At: now.Add(-48 * time.Hour), Until: now.Add(-24 * time.Hour)}
+
+		assert.Equal(t, "checks:snooze:devnet-0:geth", buttons(&expired)[1].CustomID)
+	})
+}
+
+func TestReplaceAlertActionButtons(t *testing.T) {
+	var (
+		now   = time.Now()
+		alert = &store.MonitorAlert{Network: "devnet-0", Client: "geth"}
+		links = &discordgo.ActionsRow{Components: []discordgo.MessageComponent{
+			&discordgo.Button{Label: "📊 Grafana", Style: discordgo.LinkButton, URL: "https://grafana.example.com"},
+		}}
+		actions = &discordgo.ActionsRow{Components: []discordgo.MessageComponent{
+			&discordgo.Button{Label: "👀 Acknowledge", CustomID: AlertActionID(AlertActionAcknowledge, "devnet-0", "geth")},
+		}}
+	)
+
+	alert.Muted = &store.AlertAction{User: "carol", At: now}
+
+	// As the components come back from Discord, pointers rather than values.
+	replaced := ReplaceAlertActionButtons([]discordgo.MessageComponent{links, actions}, alert, now)
+	require.Len(t, replaced, 2)
+	assert.Same(t, links, replaced[0])
+
+	row, ok := replaced[1].(discordgo.ActionsRow)
+	require.True(t, ok)
+	assert.Equal(t, AlertActionID(AlertActionUnmute, "devnet-0", "geth"), row.Components[2].(discordgo.Button).CustomID)
+
+	// Replacing again leaves a single row of actions.
+	assert.Len(t, ReplaceAlertActionButtons(replaced, alert, now), 2)
+}
@@ -2,14 +2,17 @@ package message
 
 import (
 	"bytes"
+	"cmp"
 	"fmt"
+	"maps"
 	"net"
+	"regexp"
 	"slices"
-	"sort"
 	"strings"
 	"time"
 
 	"github.com/bwmarrin/discordgo"
+	"github.com/ethpandaops/panda-pulse/pkg/analyzer"
 	"github.com/ethpandaops/panda-pulse/pkg/cartographoor"
 	"github.com/ethpandaops/panda-pulse/pkg/checks"
 	"github.com/ethpandaops/panda-pulse/pkg/store"
@@ -18,22 +21,31 @@ import (
 )
 
 const (
-	affectedInstancesHeader                = "\n**Affected instances**\n```bash\n"
-	affectedInstancesLikelyUnrelatedHeader = "\n**Affected instances (likely unrelated)**\n```bash\n"
-	infrastructureIssuesHeader             = "\n**Potential infrastructure issues**\n```bash\n"
-	sshCommandsHeader                      = "\n**SSH commands**\n"
-	codeBlockEnd                           = "```"
-	defaultCategoryEmoji                   = "ℹ️"
+	codeBlockStart        = "```bash\n"
+	codeBlockEnd          = "```"
+	categoryDivider       = "------------------------------------------"
+	defaultCategoryEmoji  = "ℹ️"
+	dataAsOfFormat        = "15:04:05 UTC"
+	staleDataMessage      = "The latest data point is %s old, this is more likely a scrape or ingestion problem than a client issue"
+	threadOverflowMessage = "\n**%d more messages not shown** to keep the thread readable. The full list of affected instances and their SSH commands is attached, see [Grafana](%s) for the details."
+	maxButtonsPerRow      = 5
+	maxFilesPerMessage    = 10   // Discord caps the number of attachments on a message.
+	maxLinksMessage       = 1900 // Discord messages are capped at 2000 characters.
+
+	// criticalColor is red, overriding the network's color for alerts with failing critical checks.
+	criticalColor = 0xE74C3C
 )
 
 var (
 	// Category emojis for different check categories.
 	categoryEmojis = map[checks.Category]string{
-		checks.CategorySync: "🔄",
+		checks.CategorySync:      "🔄",
+		checks.CategoryConsensus: "🗳️",
 	}
-	// Detail keys in result sets that we care about. Results are stored as a map[string]interface{}
-	// and return all sorts of data, so we cherry pick the ones we want to determine alert info.
-	relevantDetailKeys = []string{"lowPeerNodes", "notSyncedNodes", "stuckNodes", "behindNodes"}
+	// Characters replaced when turning a suite name into a filename.
+	nonSlugChars = regexp.MustCompile(`[^a-z0-9-]+`)
+	// Region suffix recognised on instance names when none is configured.
+	defaultRegionPattern = regexp.MustCompile(DefaultRegionPattern)
 )
 
 // AlertMessageBuilder builds the alert message.
@@ -43,41 +55,96 @@ type AlertMessageBuilder struct {
 	results                    []*checks.Result
 	hiveAvailable              bool
 	grafanaBaseURL             string
+	dashboardUID               string
+	logsDashboardUID           string
+	networkDashboards          map[string]string
 	hiveBaseURL                string
+	sshCommandTemplate         string
+	remediationCommand         string
+	staleDataThreshold         time.Duration
+	flatInstanceList           bool
+	instancePattern            *regexp.Regexp
+	regionPattern              *regexp.Regexp
+	templates                  *Templates
+	team                       string
+	criticalChecks             []string
+	checkNameAliases           CheckNameAliases
+	embedFields                []EmbedField
 	rootCauses                 []string // List of clients determined to be root causes
-	onlyInfraOrUnrelatedIssues bool     // Flag to indicate if only infrastructure or unrelated issues were detected
+	peerHealth                 []analyzer.PeerHealth
+	onlyInfraOrUnrelatedIssues bool // Flag to indicate if only infrastructure or unrelated issues were detected
 	cartographoor              *cartographoor.Service
 }
 
 type Config struct {
-	CheckID        string
-	Alert          *store.MonitorAlert
-	Results        []*checks.Result
-	HiveAvailable  bool
-	GrafanaBaseURL string
-	HiveBaseURL    string
-	RootCauses     []string // List of clients determined to be root causes
-	Cartographoor  *cartographoor.Service
+	CheckID            string
+	Alert              *store.MonitorAlert
+	Results            []*checks.Result
+	HiveAvailable      bool
+	GrafanaBaseURL     string
+	DashboardUID       string            // Grafana dashboard linked for the client, defaults to DefaultDashboardUID
+	LogsDashboardUID   string            // Grafana dashboard linked for logs, defaults to DefaultLogsDashboardUID
+	NetworkDashboards  map[string]string // Dashboard linked by the Grafana button instead, keyed by network
+	HiveBaseURL        string
+	SSHCommandTemplate string                // Renders SSH commands for affected instances, defaults to DefaultSSHCommandTemplate
+	RemediationCommand string                // Run over SSH on each affected instance by the remediation script, defaults to DefaultRemediationCommand
+	StaleDataThreshold time.Duration         // Data older than this is flagged as stale, zero disables
+	FlatInstanceList   bool                  // List affected instances together rather than by likely cause
+	InstancePattern    *regexp.Regexp        // Parses instance names on networks not following clclient-elclient-N, nil for the default
+	RegionPattern      *regexp.Regexp        // Recognises a region suffixed to instance names, nil for DefaultRegionPattern
+	Templates          *Templates            // Wording of the message, defaults to DefaultTemplates()
+	Team               string                // Team owning the client, left out of the message if empty
+	CriticalChecks     []string              // Failing checks marked critical, which make the alert stand out
+	CheckNameAliases   CheckNameAliases      // Collapses near-duplicate check names in the issues list
+	EmbedFields        []EmbedField          // Fields of the main embed in the order shown, defaults to DefaultEmbedFields
+	RootCauses         []string              // List of clients determined to be root causes
+	PeerHealth         []analyzer.PeerHealth // Health of the counterpart clients in the failing pairs
+	Cartographoor      *cartographoor.Service
 }
 
 // NewAlertMessageBuilder creates a new AlertMessageBuilder.
 func NewAlertMessageBuilder(cfg *Config) *AlertMessageBuilder {
+	templates := cfg.Templates
+	if templates == nil {
+		templates = DefaultTemplates()
+	}
+
+	embedFields := cfg.EmbedFields
+	if len(embedFields) == 0 {
+		embedFields = DefaultEmbedFields
+	}
+
 	return &AlertMessageBuilder{
-		alert:          cfg.Alert,
-		checkID:        cfg.CheckID,
-		results:        cfg.Results,
-		hiveAvailable:  cfg.HiveAvailable,
-		grafanaBaseURL: cfg.GrafanaBaseURL,
-		hiveBaseURL:    cfg.HiveBaseURL,
-		rootCauses:     cfg.RootCauses,
-		cartographoor:  cfg.Cartographoor,
+		alert:              cfg.Alert,
+		checkID:            cfg.CheckID,
+		results:            cfg.Results,
+		hiveAvailable:      cfg.HiveAvailable,
+		grafanaBaseURL:     cfg.GrafanaBaseURL,
+		dashboardUID:       cmp.Or(cfg.DashboardUID, DefaultDashboardUID),
+		logsDashboardUID:   cmp.Or(cfg.LogsDashboardUID, DefaultLogsDashboardUID),
+		networkDashboards:  cfg.NetworkDashboards,
+		hiveBaseURL:        cfg.HiveBaseURL,
+		sshCommandTemplate: cmp.Or(cfg.SSHCommandTemplate, DefaultSSHCommandTemplate),
+		remediationCommand: cmp.Or(cfg.RemediationCommand, DefaultRemediationCommand),
+		staleDataThreshold: cfg.StaleDataThreshold,
+		flatInstanceList:   cfg.FlatInstanceList,
+		instancePattern:    cfg.InstancePattern,
+		regionPattern:      cmp.Or(cfg.RegionPattern, defaultRegionPattern),
+		templates:          templates,
+		team:               cfg.Team,
+		criticalChecks:     cfg.CriticalChecks,
+		checkNameAliases:   cfg.CheckNameAliases,
+		embedFields:        embedFields,
+		rootCauses:         cfg.RootCauses,
+		peerHealth:         cfg.PeerHealth,
+		cartographoor:      cfg.Cartographoor,
 	}
 }
 
 // BuildMainMessage builds the main message.
 func (b *AlertMessageBuilder) BuildMainMessage() *discordgo.MessageSend {
 	msg := &discordgo.MessageSend{
-		Embed:      b.buildMainEmbed(),
+		Embed:      b.buildMainEmbed(b.buildBreakdownField()),
 		Components: b.buildActionButtons(),
 	}
 
@@ -89,60 +156,180 @@ func (b *AlertMessageBuilder) BuildThreadMessages(category checks.Category, fail
 	var messages []string
 
 	var header strings.Builder
-	fmt.Fprintf(&header,
-		"\n\n**%s %s Issues**\n------------------------------------------\n",
-		b.getCategoryEmoji(category),
-		category.String(),
-	)
+	fmt.Fprintf(&header, "\n\n%s\n%s\n", b.render(b.templates.CategoryHeader, templateVars{
+		Category: category.String(),
+		Emoji:    b.getCategoryEmoji(category),
+	}), categoryDivider)
 
-	header.WriteString("**Issues detected**\n")
+	header.WriteString(b.render(b.templates.IssuesDetected, templateVars{}) + "\n")
 
-	names := b.getUniqueCheckNames(failedChecks)
-	for name := range names {
-		fmt.Fprintf(&header, "- %s\n", name)
-	}
+	header.WriteString(checkNameList(b.getUniqueCheckNames(failedChecks)))
 
 	messages = append(messages, header.String())
 
-	instances := b.extractInstances(failedChecks)
+	var (
+		instances = b.extractInstances(failedChecks)
+		flapping  = b.extractFlappingInstances(failedChecks)
+	)
+
+	// Flapping instances are listed on their own, they warrant different handling to a stuck node.
+	for name := range flapping {
+		delete(instances, name)
+	}
+
 	if len(instances) > 0 {
-		instanceList := b.buildInstanceList(instances)
-		messages = append(messages, instanceList)
-		messages = append(messages, b.buildSSHCommands(instances))
+		messages = append(messages, b.buildInstanceList(instances))
+	}
+
+	if len(flapping) > 0 {
+		messages = append(messages, b.buildFlappingInstanceList(flapping))
+	}
+
+	if len(instances) > 0 || len(flapping) > 0 {
+		all := maps.Clone(instances)
+		maps.Copy(all, flapping)
+
+		messages = append(messages, b.buildSSHCommands(all))
+
+		// Links come last, so they're the first to go when the thread is capped.
+		messages = append(messages, b.buildInstanceLinks(all)...)
 	}
 
 	return messages
 }
 
-// BuildHiveMessage builds the Hive message.
-func (b *AlertMessageBuilder) BuildHiveMessage(content []byte) *discordgo.MessageSend {
+// LimitThreadMessages caps the thread messages for an alert at limit. Past the cap, individual detail
+// is dropped and the last slot goes to a single message attaching the full affected instance list
+// and linking to Grafana, which is returned separately. A limit of zero or less disables the cap.
+func (b *AlertMessageBuilder) LimitThreadMessages(messages []string, limit int) ([]string, *discordgo.MessageSend) {
+	if limit <= 0 || len(messages) <= limit {
+		return messages, nil
+	}
+
+	kept := messages[:limit-1]
+
+	return kept, b.buildOverflowMessage(len(messages) - len(kept))
+}
+
+// buildOverflowMessage builds the message sent in place of thread messages past the cap.
+func (b *AlertMessageBuilder) buildOverflowMessage(omitted int) *discordgo.MessageSend {
+	var sb strings.Builder
+
+	for _, inst := range b.getSortedInstances(b.failingInstances()) {
+		fmt.Fprintf(&sb, "%s\t%s\n", inst.name, inst.sshCommand(b.sshCommandTemplate))
+	}
+
 	return &discordgo.MessageSend{
-		Content: "\n**Hive Summary**",
+		Content: fmt.Sprintf(threadOverflowMessage, omitted, b.clientDashboardURL()),
 		Files: []*discordgo.File{
 			{
-				Name:        fmt.Sprintf("hive-%s-%s.png", b.alert.Client, b.checkID),
-				ContentType: "image/png",
-				Reader:      bytes.NewReader(content),
+				Name:        fmt.Sprintf("instances-%s-%s.txt", b.alert.Client, b.checkID),
+				ContentType: "text/plain",
+				Reader:      strings.NewReader(sb.String()),
 			},
 		},
 	}
 }
 
-// BuildMentionMessage builds the mention message.
+// HiveScreenshot is a screenshot of a client's Hive test coverage, for a single suite.
+type HiveScreenshot struct {
+	Suite   string // Empty when the screenshot isn't of a particular suite.
+	Content []byte
+}
+
+// HiveSuiteSlug returns the suite name made safe for filenames and artifact types.
+func HiveSuiteSlug(suite string) string {
+	return strings.Trim(nonSlugChars.ReplaceAllString(strings.ToLower(suite), "-"), "-")
+}
+
+// BuildHiveMessages builds the Hive messages, one screenshot per suite. Discord caps the number of
+// files on a message, so the screenshots are spread over as many messages as needed.
+func (b *AlertMessageBuilder) BuildHiveMessages(screenshots []HiveScreenshot) []*discordgo.MessageSend {
+	var messages []*discordgo.MessageSend
+
+	for chunk := range slices.Chunk(screenshots, maxFilesPerMessage) {
+		msg := &discordgo.MessageSend{}
+		if len(messages) == 0 {
+			msg.Content = "\n" + b.render(b.templates.HiveSummary, templateVars{})
+		}
+
+		for _, screenshot := range chunk {
+			name := fmt.Sprintf("hive-%s-%s.png", b.alert.Client, b.checkID)
+			if slug := HiveSuiteSlug(screenshot.Suite); slug != "" {
+				name = fmt.Sprintf("hive-%s-%s-%s.png", b.alert.Client, b.checkID, slug)
+			}
+
+			msg.Files = append(msg.Files, &discordgo.File{
+				Name:        name,
+				ContentType: "image/png",
+				Reader:      bytes.NewReader(screenshot.Content),
+			})
+		}
+
+		messages = append(messages, msg)
+	}
+
+	return messages
+}
+
+// BuildMentionMessage builds the mention message. Mentions may be gathered from several sources, so
+// each role or user is only mentioned once.
 func (b *AlertMessageBuilder) BuildMentionMessage(mentions []string) *discordgo.MessageSend {
 	return &discordgo.MessageSend{
-		Content: strings.Join(mentions, " "),
+		Content: strings.Join(uniqueMentions(mentions), " "),
+	}
+}
+
+// uniqueMentions returns the mentions with duplicates removed, keeping the first of each. A user's
+// nickname mention, <@!id>, is the same as <@id>.
+func uniqueMentions(mentions []string) []string {
+	var (
+		unique = make([]string, 0, len(mentions))
+		seen   = make(map[string]bool, len(mentions))
+	)
+
+	for _, mention := range mentions {
+		mention = strings.TrimSpace(mention)
+		key := strings.Replace(mention, "<@!", "<@", 1)
+
+		if mention == "" || seen[key] {
+			continue
+		}
+
+		seen[key] = true
+		unique = append(unique, mention)
 	}
+
+	return unique
 }
 
-// getUniqueCheckNames returns a map of unique check names.
-func (b *AlertMessageBuilder) getUniqueCheckNames(checks []*checks.Result) map[string]bool {
-	names := make(map[string]bool)
+// failingInstances returns every instance affected by a failed check, including flapping ones.
+func (b *AlertMessageBuilder) failingInstances() map[string]bool {
+	failed := slices.DeleteFunc(slices.Clone(b.results), func(result *checks.Result) bool {
+		return result.Status != checks.StatusFail
+	})
+
+	instances := b.extractInstances(failed)
+	maps.Copy(instances, b.extractFlappingInstances(failed))
+
+	return instances
+}
+
+// getUniqueCheckNames returns the unique check names, with near-duplicates collapsed under their
+// alias, and how many distinct checks each covers.
+func (b *AlertMessageBuilder) getUniqueCheckNames(checks []*checks.Result) map[string]int {
+	var (
+		names = make(map[string]int)
+		seen  = make(map[string]bool)
+	)
 
 	for _, check := range checks {
-		if _, ok := names[check.Name]; !ok {
-			names[check.Name] = true
+		if seen[check.Name] {
+			continue
 		}
+
+		seen[check.Name] = true
+		names[b.checkNameAliases.Resolve(check.Name)]++
 	}
 
 	return names
@@ -165,23 +352,25 @@ func (b *AlertMessageBuilder) extractInstancesFromCheck(check *checks.Result, in
 		return
 	}
 
-	for k, v := range check.Details {
-		if !b.isRelevantDetailKey(k) {
-			continue
+	// Details hold all sorts of data, so only the keys the check declares list instances are parsed.
+	for _, key := range check.InstanceKeys() {
+		if str, ok := check.Details[key].(string); ok {
+			b.parseInstancesFromString(str, instances)
 		}
+	}
+}
 
-		str, ok := v.(string)
-		if !ok {
-			continue
-		}
+// extractFlappingInstances extracts the instances whose sync status is flapping from the checks.
+func (b *AlertMessageBuilder) extractFlappingInstances(results []*checks.Result) map[string]bool {
+	instances := make(map[string]bool)
 
-		b.parseInstancesFromString(str, instances)
+	for _, result := range results {
+		if str, ok := result.Details[checks.FlappingNodesDetailKey].(string); ok {
+			b.parseInstancesFromString(str, instances)
+		}
 	}
-}
 
-// isRelevantDetailKey checks if the detail key is one we care about.
-func (b *AlertMessageBuilder) isRelevantDetailKey(key string) bool {
-	return slices.Contains(relevantDetailKeys, key)
+	return instances
 }
 
 // parseInstancesFromString parses instances from a multiline string.
@@ -207,24 +396,51 @@ func (b *AlertMessageBuilder) parseInstanceFromLine(line string) string {
 
 	instance = strings.Split(instance, " (")[0]
 
-	// Split the instance name into parts.
-	nodeParts := strings.Split(instance, "-")
-	if len(nodeParts) < 2 {
+	// Split the instance name, without any region suffix, into its client pair.
+	base, _ := splitRegion(instance, b.regionPattern)
+
+	cl, el, ok := splitInstanceName(base, b.instancePattern)
+	if !ok {
 		return ""
 	}
 
 	// Match exactly the CL or EL client name.
-	if nodeParts[0] == b.alert.Client || // CL client
-		(len(nodeParts) > 1 && nodeParts[1] == b.alert.Client) { // EL client
+	if cl == b.alert.Client || el == b.alert.Client {
 		return instance
 	}
 
 	return ""
 }
 
+// instanceGroups are affected instances categorised by how likely they are to be the client's fault.
+type instanceGroups struct {
+	regular        []instance
+	unrelated      []instance // Likely unrelated, eg the counterpart is a root cause or pre-production.
+	infrastructure []instance // The machine itself looks unresponsive.
+}
+
 // buildInstanceList builds the instance list.
 func (b *AlertMessageBuilder) buildInstanceList(instances map[string]bool) string {
-	sortedInstances := b.getSortedInstances(instances)
+	groups := b.categoriseInstances(b.getSortedInstances(instances))
+
+	// If all issues can be classified as infrastructure issues, set the flag.
+	if len(groups.infrastructure) > 0 &&
+		len(groups.regular) == 0 &&
+		len(groups.unrelated) == 0 {
+		b.onlyInfraOrUnrelatedIssues = true
+	}
+
+	// If issues are infrastructure, or classed as unrelated (not-likely root-cause), we won't alert either.
+	if len(groups.infrastructure) > 0 && len(groups.regular) == 0 && len(groups.unrelated) > 0 {
+		b.onlyInfraOrUnrelatedIssues = true
+	}
+
+	return b.renderInstanceGroups(groups)
+}
+
+// categoriseInstances splits sorted instances into regular, likely unrelated and infrastructure issues.
+func (b *AlertMessageBuilder) categoriseInstances(sortedInstances []instance) instanceGroups {
+	var groups instanceGroups
 
 	// Create a map of root causes for faster lookups.
 	rootCauseMap := make(map[string]bool)
@@ -235,102 +451,108 @@ func (b *AlertMessageBuilder) buildInstanceList(instances map[string]bool) strin
 	// Check if the current client is itself a root cause.
 	isClientRootCause := rootCauseMap[b.alert.Client]
 
-	// Categorise instances.
-	regularInstances := make([]instance, 0)
-	unrelatedInstances := make([]instance, 0)
-	infrastructureIssues := make([]instance, 0)
-
 	for _, inst := range sortedInstances {
 		// Check if we might classify this as an infrastructure issue.
 		if !b.checkInfrastructureHealth(inst.name) {
-			infrastructureIssues = append(infrastructureIssues, inst)
+			groups.infrastructure = append(groups.infrastructure, inst)
 
 			continue
 		}
 
 		// If the client itself is a root cause, all instances are related.
 		if isClientRootCause {
-			regularInstances = append(regularInstances, inst)
+			groups.regular = append(groups.regular, inst)
 
 			continue
 		}
 
-		// Extract client parts from instance name.
-		parts := strings.Split(inst.name, "-")
-		if len(parts) < 2 {
-			regularInstances = append(regularInstances, inst)
+		// Instances whose client pair couldn't be parsed can't be checked any further.
+		if inst.cl == "" {
+			groups.regular = append(groups.regular, inst)
 
 			continue
 		}
 
 		// Check if either component is a pre-production client or a root cause.
 		var (
-			clClient = parts[0]
-			elClient string
+			clClient = inst.cl
+			elClient = inst.el
 		)
 
-		if len(parts) > 1 {
-			elClient = parts[1]
-		}
-
 		if (b.cartographoor != nil && (b.cartographoor.IsPreProductionClient(clClient) || b.cartographoor.IsPreProductionClient(elClient))) ||
 			rootCauseMap[clClient] || rootCauseMap[elClient] {
-			unrelatedInstances = append(unrelatedInstances, inst)
+			groups.unrelated = append(groups.unrelated, inst)
 		} else {
-			regularInstances = append(regularInstances, inst)
+			groups.regular = append(groups.regular, inst)
 		}
 	}
 
+	return groups
+}
+
+// renderInstanceGroups renders categorised instances, either as separate sections or, if the
+// categorisation is turned off, as a single list.
+func (b *AlertMessageBuilder) renderInstanceGroups(groups instanceGroups) string {
 	var sb strings.Builder
 
-	// Infrastructure issues.
-	if len(infrastructureIssues) > 0 {
-		sb.WriteString(infrastructureIssuesHeader)
+	// Instances are sorted by region first, so each region is listed separately under its own header.
+	writeSection := func(title string, instances []instance) {
+		for i, inst := range instances {
+			if i == 0 || inst.region != instances[i-1].region {
+				if i > 0 {
+					sb.WriteString(codeBlockEnd)
+				}
+
+				if inst.region == "" {
+					sb.WriteString(b.sectionHeader(title))
+				} else {
+					sb.WriteString(b.regionSectionHeader(title, inst.region))
+				}
+			}
 
-		for _, inst := range infrastructureIssues {
 			sb.WriteString(inst.name)
 			sb.WriteString("\n")
 		}
 
-		sb.WriteString(codeBlockEnd)
+		if len(instances) > 0 {
+			sb.WriteString(codeBlockEnd)
+		}
 	}
 
-	// Regular instances.
-	if len(regularInstances) > 0 {
-		sb.WriteString(affectedInstancesHeader)
+	if b.flatInstanceList {
+		all := slices.Concat(groups.infrastructure, groups.regular, groups.unrelated)
+		slices.SortFunc(all, compareInstances)
 
-		for _, inst := range regularInstances {
-			sb.WriteString(inst.name)
-			sb.WriteString("\n")
-		}
+		writeSection(b.templates.AffectedInstances, all)
 
-		sb.WriteString(codeBlockEnd)
+		return sb.String()
 	}
 
+	// Infrastructure issues.
+	writeSection(b.templates.InfrastructureIssues, groups.infrastructure)
+
+	// Regular instances.
+	writeSection(b.templates.AffectedInstances, groups.regular)
+
 	// Likely unrelated instances (eg, ethereumjs the root cause, failing for everyone).
-	if len(unrelatedInstances) > 0 {
-		sb.WriteString(affectedInstancesLikelyUnrelatedHeader)
+	writeSection(b.templates.LikelyUnrelated, groups.unrelated)
 
-		for _, inst := range unrelatedInstances {
-			sb.WriteString(inst.name)
-			sb.WriteString("\n")
-		}
+	return sb.String()
+}
 
-		sb.WriteString(codeBlockEnd)
-	}
+// buildFlappingInstanceList builds the list of flapping instances.
+func (b *AlertMessageBuilder) buildFlappingInstanceList(instances map[string]bool) string {
+	var sb strings.Builder
 
-	// If all issues can be classified as infrastructure issues, set the flag.
-	if len(infrastructureIssues) > 0 &&
-		len(regularInstances) == 0 &&
-		len(unrelatedInstances) == 0 {
-		b.onlyInfraOrUnrelatedIssues = true
-	}
+	sb.WriteString(b.sectionHeader(b.templates.FlappingInstances))
 
-	// If issues are infrastructure, or classed as unrelated (not-likely root-cause), we won't alert either.
-	if len(infrastructureIssues) > 0 && len(regularInstances) == 0 && len(unrelatedInstances) > 0 {
-		b.onlyInfraOrUnrelatedIssues = true
+	for _, inst := range b.getSortedInstances(instances) {
+		sb.WriteString(inst.name)
+		sb.WriteString("\n")
 	}
 
+	sb.WriteString(codeBlockEnd)
+
 	return sb.String()
 }
 
@@ -340,11 +562,11 @@ func (b *AlertMessageBuilder) buildSSHCommands(instances map[string]bool) string
 
 	var sb strings.Builder
 
-	sb.WriteString(sshCommandsHeader)
+	sb.WriteString("\n" + b.render(b.templates.SSHCommands, templateVars{}) + "\n")
 
 	for _, inst := range sortedInstances {
-		sb.WriteString("```bash\n")
-		sb.WriteString(inst.sshCommand())
+		sb.WriteString(codeBlockStart)
+		sb.WriteString(inst.sshCommand(b.sshCommandTemplate))
 		sb.WriteString(codeBlockEnd)
 		sb.WriteString("\n")
 	}
@@ -352,20 +574,65 @@ func (b *AlertMessageBuilder) buildSSHCommands(instances map[string]bool) string
 	return sb.String()
 }
 
-// getSortedInstances sorts the instances.
+// buildInstanceLinks builds links to each instance's Grafana dashboard, split across as many messages
+// as needed to stay under Discord's limit. Returns nil without a Grafana URL to link to.
+func (b *AlertMessageBuilder) buildInstanceLinks(instances map[string]bool) []string {
+	if b.grafanaBaseURL == "" {
+		return nil
+	}
+
+	var (
+		messages []string
+		sb       strings.Builder
+	)
+
+	sb.WriteString("\n" + b.render(b.templates.InstanceDashboards, templateVars{}) + "\n")
+
+	for _, inst := range b.getSortedInstances(instances) {
+		// Wrapping the URL in angle brackets stops Discord embedding a preview of every link.
+		line := fmt.Sprintf("- [%s](<%s>)\n", inst.name, b.instanceDashboardURL(inst.name))
+
+		if sb.Len()+len(line) > maxLinksMessage {
+			messages = append(messages, sb.String())
+			sb.Reset()
+		}
+
+		sb.WriteString(line)
+	}
+
+	return append(messages, sb.String())
+}
+
+// getSortedInstances sorts the instances, grouped by the counterpart client of each pair.
 func (b *AlertMessageBuilder) getSortedInstances(instances map[string]bool) []instance {
 	sorted := make([]instance, 0, len(instances))
 	for name := range instances {
-		sorted = append(sorted, newInstance(name, b.alert.Network, b.alert.Client))
+		sorted = append(sorted, newInstanceWithPattern(name, b.alert.Network, b.alert.Client, b.instancePattern, b.regionPattern))
 	}
 
-	sort.Slice(sorted, func(i, j int) bool {
-		return sorted[i].name < sorted[j].name
-	})
+	slices.SortFunc(sorted, compareInstances)
 
 	return sorted
 }
 
+// render renders a message template for the alert, filling in its client and network.
+func (b *AlertMessageBuilder) render(template string, vars templateVars) string {
+	vars.Client = b.alert.Client
+	vars.Network = b.alert.Network
+
+	return renderTemplate(template, vars)
+}
+
+// sectionHeader renders the title of an instance list section, opening its code block.
+func (b *AlertMessageBuilder) sectionHeader(title string) string {
+	return "\n" + b.render(title, templateVars{}) + "\n" + codeBlockStart
+}
+
+// regionSectionHeader returns the header for a section's instances in a region.
+func (b *AlertMessageBuilder) regionSectionHeader(title, region string) string {
+	return "\n" + b.render(title, templateVars{}) + " (" + region + ")\n" + codeBlockStart
+}
+
 // getCategoryEmoji returns the emoji for the category.
 func (b *AlertMessageBuilder) getCategoryEmoji(category checks.Category) string {
 	if emoji, ok := categoryEmojis[category]; ok {
@@ -391,17 +658,9 @@ func (b *AlertMessageBuilder) buildGrafanaURL(dashboard string, params map[strin
 	return fmt.Sprintf("%s?%s", baseURL, strings.Join(queryParams, "&"))
 }
 
-// buildMainEmbed builds the main embed.
-func (b *AlertMessageBuilder) buildMainEmbed() *discordgo.MessageEmbed {
-	// Count unique failed checks.
-	uniqueFailedChecks := make(map[string]bool)
-
-	for _, result := range b.results {
-		if result.Status == checks.StatusFail {
-			uniqueFailedChecks[result.Name] = true
-		}
-	}
-
+// buildMainEmbed builds the main embed, with its fields in the configured order. The breakdown field
+// is passed in, as compact alerts swap it for a summary.
+func (b *AlertMessageBuilder) buildMainEmbed(breakdown *discordgo.MessageEmbedField) *discordgo.MessageEmbed {
 	embed := &discordgo.MessageEmbed{
 		Title:     b.getTitle(),
 		Color:     hashToColor(b.alert.Network),
@@ -417,53 +676,182 @@ func (b *AlertMessageBuilder) buildMainEmbed() *discordgo.MessageEmbed {
 		}
 	}
 
-	embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
-		Name:   fmt.Sprintf("%s %d Active Issues", "⚠️", len(uniqueFailedChecks)),
-		Inline: true,
-	})
+	// Critical checks are called out in red, so the alert can't be mistaken for a routine one. This
+	// holds even if their field is hidden.
+	if len(b.criticalChecks) > 0 {
+		embed.Title = "🚨 " + embed.Title
+		embed.Color = criticalColor
+	}
 
-	embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
-		Name:   fmt.Sprintf("🌐 %s", b.alert.Network),
-		Inline: true,
-	})
+	dataTimestamp := b.DataTimestamp()
 
-	embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
-		Value:  "Check the thread below for a breakdown",
-		Inline: false,
-	})
+	for _, name := range b.embedFields {
+		if field := b.buildEmbedField(name, breakdown, dataTimestamp); field != nil {
+			embed.Fields = append(embed.Fields, field)
+		}
+	}
+
+	footer := fmt.Sprintf("ID: %s", b.checkID)
+	if !dataTimestamp.IsZero() {
+		footer += fmt.Sprintf(" • Data as of %s", dataTimestamp.UTC().Format(dataAsOfFormat))
+	}
 
 	embed.Footer = &discordgo.MessageEmbedFooter{
-		Text: fmt.Sprintf("ID: %s", b.checkID),
+		Text: footer,
 	}
 
 	return embed
 }
 
-// buildActionButtons builds the action buttons.
-func (b *AlertMessageBuilder) buildActionButtons() []discordgo.MessageComponent {
-	executionClient := "All"
-	consensusClient := "All"
+// buildEmbedField builds one of the main embed's fields, or returns nil if it has nothing to show.
+func (b *AlertMessageBuilder) buildEmbedField(
+	name EmbedField,
+	breakdown *discordgo.MessageEmbedField,
+	dataTimestamp time.Time,
+) *discordgo.MessageEmbedField {
+	switch name {
+	case EmbedFieldActiveIssues:
+		// Count unique failed checks.
+		uniqueFailedChecks := make(map[string]bool)
+
+		for _, result := range b.results {
+			if result.Status == checks.StatusFail {
+				uniqueFailedChecks[result.Name] = true
+			}
+		}
 
-	if b.cartographoor != nil {
-		if b.cartographoor.IsELClient(b.alert.Client) {
-			executionClient = b.alert.Client
+		return &discordgo.MessageEmbedField{
+			Name:   b.render(b.templates.ActiveIssues, templateVars{Count: len(uniqueFailedChecks)}),
+			Inline: true,
+		}
+	case EmbedFieldNetwork:
+		return &discordgo.MessageEmbedField{
+			Name:   fmt.Sprintf("🌐 %s", b.alert.Network),
+			Inline: true,
+		}
+	case EmbedFieldTeam:
+		if b.team == "" {
+			return nil
 		}
 
-		if b.cartographoor.IsCLClient(b.alert.Client) {
-			consensusClient = b.alert.Client
+		return &discordgo.MessageEmbedField{
+			Name:   fmt.Sprintf("👥 %s", b.team),
+			Inline: true,
+		}
+	case EmbedFieldCriticalChecks:
+		if len(b.criticalChecks) == 0 {
+			return nil
+		}
+
+		return &discordgo.MessageEmbedField{
+			Name:   b.render(b.templates.CriticalChecks, templateVars{}),
+			Value:  "- " + strings.Join(b.criticalChecks, "\n- "),
+			Inline: false,
+		}
+	case EmbedFieldPeerHealth:
+		peerHealth := b.buildPeerHealth()
+		if peerHealth == "" {
+			return nil
+		}
+
+		return &discordgo.MessageEmbedField{
+			Name:   b.render(b.templates.PeerHealth, templateVars{}),
+			Value:  peerHealth,
+			Inline: false,
+		}
+	case EmbedFieldStaleData:
+		age := time.Since(dataTimestamp)
+		if dataTimestamp.IsZero() || b.staleDataThreshold <= 0 || age <= b.staleDataThreshold {
+			return nil
+		}
+
+		return &discordgo.MessageEmbedField{
+			Name:   b.render(b.templates.StaleData, templateVars{}),
+			Value:  fmt.Sprintf(staleDataMessage, age.Truncate(time.Second)),
+			Inline: false,
+		}
+	case EmbedFieldBreakdown:
+		return breakdown
+	default:
+		return nil
+	}
+}
+
+// buildBreakdownField builds the field pointing at the breakdown in the alert's thread.
+func (b *AlertMessageBuilder) buildBreakdownField() *discordgo.MessageEmbedField {
+	return &discordgo.MessageEmbedField{
+		Value:  b.render(b.templates.Breakdown, templateVars{}),
+		Inline: false,
+	}
+}
+
+// DataTimestamp returns the time of the stalest data behind the failed checks, or the zero time if
+// none of them had timestamped data.
+func (b *AlertMessageBuilder) DataTimestamp() time.Time {
+	var oldest time.Time
+
+	for _, result := range b.results {
+		if result.Status != checks.StatusFail || result.DataTimestamp.IsZero() {
+			continue
+		}
+
+		if oldest.IsZero() || result.DataTimestamp.Before(oldest) {
+			oldest = result.DataTimestamp
 		}
 	}
 
+	return oldest
+}
+
+// buildPeerHealth summarises whether the counterpart clients in the failing pairs are healthy with
+// other clients, which points at the target client being the problem.
+func (b *AlertMessageBuilder) buildPeerHealth() string {
+	if len(b.peerHealth) == 0 {
+		return ""
+	}
+
+	var (
+		sb           strings.Builder
+		allElsewhere = true
+	)
+
+	for _, peer := range b.peerHealth {
+		// The peer's partners are the same type as the target client.
+		partnerType := analyzer.ClientTypeCL
+		if peer.Type == analyzer.ClientTypeCL {
+			partnerType = analyzer.ClientTypeEL
+		}
+
+		if peer.HealthyElsewhere() {
+			fmt.Fprintf(&sb, "✅ **%s** is healthy with all other %s clients\n", peer.Peer, partnerType)
+
+			continue
+		}
+
+		allElsewhere = false
+
+		fmt.Fprintf(&sb, "❌ **%s** is also failing with %s\n", peer.Peer, strings.Join(peer.FailingWith, ", "))
+	}
+
+	if allElsewhere {
+		fmt.Fprintf(&sb, "➡️ Likely a **%s** issue", b.getTitle())
+	}
+
+	return Truncate(strings.TrimSpace(sb.String()), MaxEmbedFieldValue)
+}
+
+// buildActionButtons builds the link buttons and the buttons acting on the alert.
+func (b *AlertMessageBuilder) buildActionButtons() []discordgo.MessageComponent {
 	btns := []discordgo.MessageComponent{
 		discordgo.Button{
 			Label: "📊 Grafana",
 			Style: discordgo.LinkButton,
-			URL:   b.buildGrafanaURL("cebekx08rl9tsc", map[string]string{"orgId": "1", "var-consensus_client": consensusClient, "var-execution_client": executionClient, "var-network": b.alert.Network}),
+			URL:   b.clientDashboardURL(),
 		},
 		discordgo.Button{
 			Label: "📝 Logs",
 			Style: discordgo.LinkButton,
-			URL:   b.buildGrafanaURL("aebfg1654nqwwd", map[string]string{"orgId": "1", "var-network": b.alert.Network}),
+			URL:   b.buildGrafanaURL(b.logsDashboardUID, map[string]string{"orgId": "1", "var-network": b.alert.Network}),
 		},
 	}
 
@@ -475,10 +863,85 @@ func (b *AlertMessageBuilder) buildActionButtons() []discordgo.MessageComponent
 		})
 	}
 
-	return []discordgo.MessageComponent{
-		discordgo.ActionsRow{
-			Components: btns,
-		},
+	// Link out to the network's own services when cartographoor knows about them.
+	if b.cartographoor != nil {
+		urls := b.cartographoor.GetServiceURLs(b.alert.Network)
+
+		if urls.Dora != "" {
+			btns = append(btns, discordgo.Button{
+				Label: "🔍 Dora",
+				Style: discordgo.LinkButton,
+				URL:   urls.Dora,
+			})
+		}
+
+		if urls.Explorer != "" {
+			btns = append(btns, discordgo.Button{
+				Label: "🧭 Explorer",
+				Style: discordgo.LinkButton,
+				URL:   urls.Explorer,
+			})
+		}
+	}
+
+	// Discord allows at most 5 buttons per row, wrap the rest onto additional rows.
+	rows := make([]discordgo.MessageComponent, 0, (len(btns)+maxButtonsPerRow-1)/maxButtonsPerRow+1)
+
+	for chunk := range slices.Chunk(btns, maxButtonsPerRow) {
+		rows = append(rows, discordgo.ActionsRow{
+			Components: chunk,
+		})
+	}
+
+	// Followed by the buttons acknowledging, snoozing and muting the alert.
+	if b.alert.Client != "" {
+		rows = append(rows, BuildAlertActionButtons(b.alert, time.Now()))
+	}
+
+	return rows
+}
+
+// clientDashboardURL returns the Grafana dashboard URL for the alert's client on its network, on the
+// network's own dashboard when it has one.
+func (b *AlertMessageBuilder) clientDashboardURL() string {
+	dashboard := b.dashboardUID
+	if uid, ok := b.networkDashboards[b.alert.Network]; ok {
+		dashboard = uid
+	}
+
+	return b.buildGrafanaURL(dashboard, b.clientDashboardParams())
+}
+
+// instanceDashboardURL returns the Grafana dashboard URL for the alert's client, filtered to a single
+// instance. A network's own dashboard isn't known to have the instance variable, so this is always
+// on the default dashboard.
+func (b *AlertMessageBuilder) instanceDashboardURL(name string) string {
+	params := b.clientDashboardParams()
+	params["var-instance"] = name
+
+	return b.buildGrafanaURL(b.dashboardUID, params)
+}
+
+// clientDashboardParams returns the Grafana dashboard variables selecting the alert's client on its network.
+func (b *AlertMessageBuilder) clientDashboardParams() map[string]string {
+	executionClient := "All"
+	consensusClient := "All"
+
+	if b.cartographoor != nil {
+		if b.cartographoor.IsELClient(b.alert.Client) {
+			executionClient = b.alert.Client
+		}
+
+		if b.cartographoor.IsCLClient(b.alert.Client) {
+			consensusClient = b.alert.Client
+		}
+	}
+
+	return map[string]string{
+		"orgId":                "1",
+		"var-consensus_client": consensusClient,
+		"var-execution_client": executionClient,
+		"var-network":          b.alert.Network,
 	}
 }
 
@@ -0,0 +1,405 @@
+package message
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/ethpandaops/panda-pulse/pkg/checks"
+	"github.com/ethpandaops/panda-pulse/pkg/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildThreadMessages_Flapping(t *testing.T) {
+	b := NewAlertMessageBuilder(&Config{
+		Alert: &store.MonitorAlert{Network: "devnet-0", Client: "lighthouse"},
+	})
+
+	messages := b.BuildThreadMessages(checks.CategorySync, []*checks.Result{
+		{
+			Name:     "Node sync status flapping",
+			Category: checks.CategorySync,
+			Status:   checks.StatusFail,
+			Details: map[string]any{
+				checks.FlappingNodesDetailKey: "lighthouse-geth-10\nlighthouse-geth-2",
+			},
+		},
+	})
+
+	require.Len(t, messages, 3)
+
+	joined := strings.Join(messages, "")
+	assert.NotContains(t, joined, "**Affected instances**")
+	assert.Contains(t, messages[1], "**Flapping instances**")
+	assert.Less(t, strings.Index(messages[1], "lighthouse-geth-2"), strings.Index(messages[1], "lighthouse-geth-10"))
+	assert.Contains(t, messages[2], "ssh devops@lighthouse-geth-2.devnet-0.ethpandaops.io")
+	assert.Contains(t, messages[2], "ssh devops@lighthouse-geth-10.devnet-0.ethpandaops.io")
+	assert.False(t, b.HasOnlyInfraOrUnrelatedIssues())
+}
+
+func TestBuildThreadMessages_InstanceDetailKeys(t *testing.T) {
+	b := NewAlertMessageBuilder(&Config{
+		Alert: &store.MonitorAlert{Network: "devnet-0", Client: "lighthouse"},
+	})
+
+	joined := strings.Join(b.BuildThreadMessages(checks.CategorySync, []*checks.Result{
+		{
+			// A check declaring its own key, only the declared key lists instances.
+			Name:     "Memory usage",
+			Category: checks.CategorySync,
+			Status:   checks.StatusFail,
+			Details: map[string]any{
+				"lowMemoryNodes":               "lighthouse-geth-1",
+				checks.NotSyncedNodesDetailKey: "lighthouse-geth-2",
+			},
+			InstanceDetailKeys: []string{"lowMemoryNodes"},
+		},
+		{
+			// A result stored before checks declared their keys.
+			Name:     "Node sync status",
+			Category: checks.CategorySync,
+			Status:   checks.StatusFail,
+			Details: map[string]any{
+				"behindNodes": "lighthouse-geth-3",
+			},
+		},
+	}), "")
+
+	assert.Contains(t, joined, "ssh devops@lighthouse-geth-1.devnet-0.ethpandaops.io")
+	assert.NotContains(t, joined, "lighthouse-geth-2")
+	assert.Contains(t, joined, "ssh devops@lighthouse-geth-3.devnet-0.ethpandaops.io")
+}
+
+func TestBuildThreadMessages_InstanceDashboards(t *testing.T) {
+	results := []*checks.Result{
+		{
+			Name:     "Node sync status flapping",
+			Category: checks.CategorySync,
+			Status:   checks.StatusFail,
+			Details: map[string]any{
+				checks.FlappingNodesDetailKey: "lighthouse-geth-10\nlighthouse-geth-2",
+			},
+		},
+	}
+
+	b := NewAlertMessageBuilder(&Config{
+		Alert:          &store.MonitorAlert{Network: "devnet-0", Client: "lighthouse"},
+		GrafanaBaseURL: "https://grafana.example.com",
+	})
+
+	messages := b.BuildThreadMessages(checks.CategorySync, results)
+	require.Len(t, messages, 4)
+
+	// The plaintext list is kept alongside the links.
+	assert.Contains(t, messages[1], "lighthouse-geth-2\n")
+
+	links := messages[3]
+	assert.Contains(t, links, "**Instance dashboards**")
+	assert.Contains(t, links, "- [lighthouse-geth-2](<https://grafana.example.com/d/cebekx08rl9tsc?")
+	assert.Contains(t, links, "var-instance=lighthouse-geth-2")
+	assert.Contains(t, links, "var-network=devnet-0")
+	assert.Less(t, strings.Index(links, "[lighthouse-geth-2]"), strings.Index(links, "[lighthouse-geth-10]"))
+
+	t.Run("split under the message limit", func(t *testing.T) {
+		instances := make(map[string]bool)
+		for i := range 50 {
+			instances[fmt.Sprintf("lighthouse-geth-%d", i)] = true
+		}
+
+		messages := b.buildInstanceLinks(instances)
+		require.Greater(t, len(messages), 1)
+
+		for _, msg := range messages {
+			assert.LessOrEqual(t, len(msg), maxLinksMessage)
+		}
+
+		assert.Equal(t, 50, strings.Count(strings.Join(messages, ""), "var-instance="))
+	})
+
+	t.Run("no grafana url", func(t *testing.T) {
+		b := NewAlertMessageBuilder(&Config{Alert: &store.MonitorAlert{Network: "devnet-0", Client: "lighthouse"}})
+		assert.Nil(t, b.buildInstanceLinks(map[string]bool{"lighthouse-geth-1": true}))
+	})
+}
+
+func TestBuildHiveMessages(t *testing.T) {
+	b := NewAlertMessageBuilder(&Config{
+		Alert:   &store.MonitorAlert{Network: "devnet-0", Client: "lighthouse"},
+		CheckID: "abc123",
+	})
+
+	t.Run("single screenshot", func(t *testing.T) {
+		messages := b.BuildHiveMessages([]HiveScreenshot{{Content: []byte("png")}})
+		require.Len(t, messages, 1)
+		require.Len(t, messages[0].Files, 1)
+		assert.Equal(t, "hive-lighthouse-abc123.png", messages[0].Files[0].Name)
+	})
+
+	t.Run("screenshot per suite", func(t *testing.T) {
+		screenshots := make([]HiveScreenshot, 0, 12)
+		for idx := range 12 {
+			screenshots = append(screenshots, HiveScreenshot{Suite: fmt.Sprintf("Consume Engine/%d", idx), Content: []byte("png")})
+		}
+
+		messages := b.BuildHiveMessages(screenshots)
+		require.Len(t, messages, 2)
+		assert.Len(t, messages[0].Files, 10)
+		assert.Len(t, messages[1].Files, 2)
+		assert.NotEmpty(t, messages[0].Content)
+		assert.Empty(t, messages[1].Content)
+		assert.Equal(t, "hive-lighthouse-abc123-consume-engine-0.png", messages[0].Files[0].Name)
+	})
+
+	t.Run("no screenshots", func(t *testing.T) {
+		assert.Empty(t, b.BuildHiveMessages(nil))
+	})
+}
+
+func TestLimitThreadMessages(t *testing.T) {
+	results := []*checks.Result{
+		{
+			Name:     "Node sync status",
+			Category: checks.CategorySync,
+			Status:   checks.StatusFail,
+			Details: map[string]any{
+				"notSyncedNodes": "lighthouse-geth-1\nlighthouse-besu-1\nlighthouse-nethermind-1",
+			},
+		},
+		{
+			Name:     "Node sync status flapping",
+			Category: checks.CategorySync,
+			Status:   checks.StatusFail,
+			Details: map[string]any{
+				checks.FlappingNodesDetailKey: "lighthouse-reth-1",
+			},
+		},
+		{
+			Name:     "Head slot",
+			Category: checks.CategorySync,
+			Status:   checks.StatusOK,
+			Details: map[string]any{
+				"behindNodes": "lighthouse-erigon-1",
+			},
+		},
+	}
+
+	b := NewAlertMessageBuilder(&Config{
+		CheckID:        "check-1",
+		Alert:          &store.MonitorAlert{Network: "devnet-0", Client: "lighthouse"},
+		Results:        results,
+		GrafanaBaseURL: "https://grafana.example.com",
+	})
+
+	// Stand-ins for the built thread messages, building them probes the instances over SSH.
+	messages := []string{"header", "instances", "flapping", "ssh commands"}
+
+	t.Run("within the cap", func(t *testing.T) {
+		kept, overflow := b.LimitThreadMessages(messages, 4)
+		assert.Equal(t, messages, kept)
+		assert.Nil(t, overflow)
+	})
+
+	t.Run("cap disabled", func(t *testing.T) {
+		kept, overflow := b.LimitThreadMessages(messages, 0)
+		assert.Equal(t, messages, kept)
+		assert.Nil(t, overflow)
+	})
+
+	t.Run("exceeds the cap", func(t *testing.T) {
+		kept, overflow := b.LimitThreadMessages(messages, 3)
+		assert.Equal(t, messages[:2], kept)
+		require.NotNil(t, overflow)
+
+		assert.Contains(t, overflow.Content, "**2 more messages not shown**")
+		assert.Contains(t, overflow.Content, "https://grafana.example.com/d/cebekx08rl9tsc?")
+
+		require.Len(t, overflow.Files, 1)
+		assert.Equal(t, "instances-lighthouse-check-1.txt", overflow.Files[0].Name)
+
+		content, err := io.ReadAll(overflow.Files[0].Reader)
+		require.NoError(t, err)
+
+		// Every failing instance is listed, including flapping ones, but not those from passing checks.
+		for _, name := range []string{"lighthouse-geth-1", "lighthouse-besu-1", "lighthouse-nethermind-1", "lighthouse-reth-1"} {
+			assert.Contains(t, string(content), name+"\tssh devops@"+name+".devnet-0.ethpandaops.io\n")
+		}
+
+		assert.NotContains(t, string(content), "lighthouse-erigon-1")
+	})
+}
+
+func TestBuildMainMessage_DataFreshness(t *testing.T) {
+	var (
+		fresh = time.Now().Add(-time.Minute).UTC()
+		stale = time.Now().Add(-20 * time.Minute).UTC()
+	)
+
+	tests := []struct {
+		name          string
+		dataTimestamp time.Time
+		threshold     time.Duration
+		expectFooter  bool
+		expectStale   bool
+	}{
+		{name: "no data timestamp"},
+		{name: "fresh data", dataTimestamp: fresh, threshold: 5 * time.Minute, expectFooter: true},
+		{name: "stale data", dataTimestamp: stale, threshold: 5 * time.Minute, expectFooter: true, expectStale: true},
+		{name: "stale flag disabled", dataTimestamp: stale, expectFooter: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewAlertMessageBuilder(&Config{
+				Alert:   &store.MonitorAlert{Network: "devnet-0", Client: "lighthouse"},
+				CheckID: "check-id",
+				Results: []*checks.Result{
+					{Name: "Head slot not advancing", Status: checks.StatusFail, DataTimestamp: tt.dataTimestamp},
+					{Name: "Node sync status flapping", Status: checks.StatusOK, DataTimestamp: time.Now().Add(-time.Hour)},
+				},
+				StaleDataThreshold: tt.threshold,
+			})
+
+			embed := b.BuildMainMessage().Embed
+
+			if tt.expectFooter {
+				assert.Equal(t, "ID: check-id • Data as of "+tt.dataTimestamp.Format(dataAsOfFormat), embed.Footer.Text)
+			} else {
+				assert.Equal(t, "ID: check-id", embed.Footer.Text)
+			}
+
+			hasStale := slices.ContainsFunc(embed.Fields, func(field *discordgo.MessageEmbedField) bool {
+				return strings.Contains(field.Name, "Stale data")
+			})
+			assert.Equal(t, tt.expectStale, hasStale)
+		})
+	}
+}
+
+func TestBuildMainMessage_Team(t *testing.T) {
+	tests := []struct {
+		name string
+		team string
+		want string
+	}{
+		{name: "known team", team: "sigmaprime", want: "👥 sigmaprime"},
+		{name: "unknown team"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewAlertMessageBuilder(&Config{
+				Alert:   &store.MonitorAlert{Network: "devnet-0", Client: "lighthouse"},
+				CheckID: "check-id",
+				Results: []*checks.Result{{Name: "Head slot not advancing", Status: checks.StatusFail}},
+				Team:    tt.team,
+			})
+
+			idx := slices.IndexFunc(b.BuildMainMessage().Embed.Fields, func(field *discordgo.MessageEmbedField) bool {
+				return strings.HasPrefix(field.Name, "👥")
+			})
+
+			if tt.want == "" {
+				assert.Equal(t, -1, idx)
+			} else {
+				require.NotEqual(t, -1, idx)
+				assert.Equal(t, tt.want, b.BuildMainMessage().Embed.Fields[idx].Name)
+			}
+		})
+	}
+}
+
+func TestBuildMainMessage_CriticalChecks(t *testing.T) {
+	newBuilder := func(critical []string) *AlertMessageBuilder {
+		return NewAlertMessageBuilder(&Config{
+			Alert:          &store.MonitorAlert{Network: "devnet-0", Client: "lighthouse"},
+			CheckID:        "check-id",
+			Results:        []*checks.Result{{Name: "Finalized epoch not advancing", Status: checks.StatusFail}},
+			CriticalChecks: critical,
+		})
+	}
+
+	hasCritical := func(embed *discordgo.MessageEmbed) bool {
+		return slices.ContainsFunc(embed.Fields, func(field *discordgo.MessageEmbedField) bool {
+			return strings.Contains(field.Name, "Critical checks")
+		})
+	}
+
+	t.Run("routine", func(t *testing.T) {
+		embed := newBuilder(nil).BuildMainMessage().Embed
+
+		assert.Equal(t, "Lighthouse", embed.Title)
+		assert.Equal(t, hashToColor("devnet-0"), embed.Color)
+		assert.False(t, hasCritical(embed))
+	})
+
+	t.Run("critical", func(t *testing.T) {
+		embed := newBuilder([]string{"Finalized epoch not advancing"}).BuildMainMessage().Embed
+
+		assert.Equal(t, "🚨 Lighthouse", embed.Title)
+		assert.Equal(t, criticalColor, embed.Color)
+		require.True(t, hasCritical(embed))
+	})
+}
+
+func TestRenderInstanceGroups(t *testing.T) {
+	groups := instanceGroups{
+		regular:        []instance{newInstance("lighthouse-geth-2", "devnet-0", "lighthouse")},
+		unrelated:      []instance{newInstance("lighthouse-ethereumjs-1", "devnet-0", "lighthouse")},
+		infrastructure: []instance{newInstance("lighthouse-besu-1", "devnet-0", "lighthouse")},
+	}
+
+	t.Run("categorised", func(t *testing.T) {
+		b := NewAlertMessageBuilder(&Config{Alert: &store.MonitorAlert{Network: "devnet-0", Client: "lighthouse"}})
+
+		rendered := b.renderInstanceGroups(groups)
+		assert.Contains(t, rendered, b.sectionHeader(b.templates.InfrastructureIssues)+"lighthouse-besu-1\n")
+		assert.Contains(t, rendered, b.sectionHeader(b.templates.AffectedInstances)+"lighthouse-geth-2\n")
+		assert.Contains(t, rendered, b.sectionHeader(b.templates.LikelyUnrelated)+"lighthouse-ethereumjs-1\n")
+	})
+
+	t.Run("flat", func(t *testing.T) {
+		b := NewAlertMessageBuilder(&Config{
+			Alert:            &store.MonitorAlert{Network: "devnet-0", Client: "lighthouse"},
+			FlatInstanceList: true,
+		})
+
+		rendered := b.renderInstanceGroups(groups)
+		assert.Equal(t, b.sectionHeader(b.templates.AffectedInstances)+"lighthouse-besu-1\nlighthouse-ethereumjs-1\nlighthouse-geth-2\n"+codeBlockEnd, rendered)
+	})
+
+	t.Run("regions", func(t *testing.T) {
+		b := NewAlertMessageBuilder(&Config{Alert: &store.MonitorAlert{Network: "devnet-0", Client: "lighthouse"}})
+
+		regional := instanceGroups{
+			regular: b.getSortedInstances(map[string]bool{
+				"lighthouse-geth-1-use1": true,
+				"lighthouse-besu-1-euw1": true,
+				"lighthouse-geth-2":      true,
+			}),
+		}
+
+		rendered := b.renderInstanceGroups(regional)
+		assert.Equal(t, b.sectionHeader(b.templates.AffectedInstances)+"lighthouse-geth-2\n"+codeBlockEnd+
+			b.regionSectionHeader(b.templates.AffectedInstances, "euw1")+"lighthouse-besu-1-euw1\n"+codeBlockEnd+
+			b.regionSectionHeader(b.templates.AffectedInstances, "use1")+"lighthouse-geth-1-use1\n"+codeBlockEnd, rendered)
+	})
+}
+
+func TestBuildMentionMessage(t *testing.T) {
+	b := NewAlertMessageBuilder(&Config{Alert: &store.MonitorAlert{Network: "devnet-0", Client: "lighthouse"}})
+
+	var (
+		sync      = []string{"<@&100>", "<@200>", "<@300>"}
+		consensus = []string{"<@&100>", "<@!200>", "<@400>", ""}
+	)
+
+	msg := b.BuildMentionMessage(slices.Concat(sync, consensus))
+	assert.Equal(t, "<@&100> <@200> <@300> <@400>", msg.Content)
+
+	assert.Empty(t, b.BuildMentionMessage(nil).Content)
+}
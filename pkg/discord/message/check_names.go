@@ -0,0 +1,82 @@
+package message
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// checkNamePrefixWildcard marks a check name alias matching every name starting with it.
+const checkNamePrefixWildcard = "*"
+
+// CheckNameAliases collapses near-duplicate check names under a single name, keyed by the check name
+// or, ending with "*", a prefix of it. Only how the checks are listed in alerts changes, results and
+// check logs keep their raw names.
+type CheckNameAliases map[string]string
+
+// ParseCheckNameAliases parses a JSON object of check name, or name prefix ending with "*", to the
+// name it's listed under, eg {"Head slot*": "Head slot"}. An empty value has no aliases.
+func ParseCheckNameAliases(value string) (CheckNameAliases, error) {
+	aliases := make(CheckNameAliases)
+
+	if strings.TrimSpace(value) == "" {
+		return aliases, nil
+	}
+
+	if err := json.Unmarshal([]byte(value), &aliases); err != nil {
+		return nil, fmt.Errorf("failed to decode check name aliases: %w", err)
+	}
+
+	for name, alias := range aliases {
+		if strings.TrimSuffix(name, checkNamePrefixWildcard) == "" {
+			return nil, fmt.Errorf("check name alias %q has no name to match", name)
+		}
+
+		if strings.TrimSpace(alias) == "" {
+			return nil, fmt.Errorf("check name alias for %q is empty", name)
+		}
+	}
+
+	return aliases, nil
+}
+
+// Resolve returns the name a check is listed under: its exact alias, otherwise the alias of the
+// longest matching prefix, otherwise the name itself.
+func (a CheckNameAliases) Resolve(name string) string {
+	if alias, ok := a[name]; ok {
+		return alias
+	}
+
+	var longest string
+
+	for key := range a {
+		prefix, ok := strings.CutSuffix(key, checkNamePrefixWildcard)
+		if ok && strings.HasPrefix(name, prefix) && len(prefix) > len(strings.TrimSuffix(longest, checkNamePrefixWildcard)) {
+			longest = key
+		}
+	}
+
+	if longest == "" {
+		return name
+	}
+
+	return a[longest]
+}
+
+// checkNameList renders the unique check names as a sorted list, with a count against the names
+// covering several checks.
+func checkNameList(names map[string]int) string {
+	var sb strings.Builder
+
+	for _, name := range slices.Sorted(maps.Keys(names)) {
+		if count := names[name]; count > 1 {
+			fmt.Fprintf(&sb, "- %s (%d checks)\n", name, count)
+		} else {
+			fmt.Fprintf(&sb, "- %s\n", name)
+		}
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,57 @@
+package message
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ethpandaops/panda-pulse/pkg/checks"
+	"github.com/ethpandaops/panda-pulse/pkg/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCheckNameAliases(t *testing.T) {
+	aliases, err := ParseCheckNameAliases("")
+	require.NoError(t, err)
+	assert.Empty(t, aliases)
+
+	aliases, err = ParseCheckNameAliases(`{"Head slot*": "Head slot not advancing"}`)
+	require.NoError(t, err)
+	assert.Equal(t, "Head slot not advancing", aliases["Head slot*"])
+
+	for _, invalid := range []string{`not json`, `{"*": "Everything"}`, `{"": "Nothing"}`, `{"Head slot": " "}`} {
+		_, err := ParseCheckNameAliases(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestCheckNameAliasesResolve(t *testing.T) {
+	aliases := CheckNameAliases{
+		"Head slot*":            "Head slot",
+		"Head slot behind*":     "Head slot behind",
+		"Node sync status (el)": "Node sync status",
+	}
+
+	assert.Equal(t, "Head slot", aliases.Resolve("Head slot not advancing"))
+	assert.Equal(t, "Head slot behind", aliases.Resolve("Head slot behind by 10"))
+	assert.Equal(t, "Node sync status", aliases.Resolve("Node sync status (el)"))
+	assert.Equal(t, "Node sync status (cl)", aliases.Resolve("Node sync status (cl)"))
+	assert.Equal(t, "Low peer count", CheckNameAliases(nil).Resolve("Low peer count"))
+}
+
+func TestBuildThreadMessages_CheckNameAliases(t *testing.T) {
+	results := []*checks.Result{
+		{Name: "Head slot not advancing", Category: checks.CategorySync, Status: checks.StatusFail},
+		{Name: "Head slot behind", Category: checks.CategorySync, Status: checks.StatusFail},
+		{Name: "Head slot behind", Category: checks.CategorySync, Status: checks.StatusFail},
+		{Name: "Node sync status", Category: checks.CategorySync, Status: checks.StatusFail},
+	}
+
+	b := NewAlertMessageBuilder(&Config{
+		Alert:            &store.MonitorAlert{Network: "devnet-0", Client: "lighthouse"},
+		CheckNameAliases: CheckNameAliases{"Head slot*": "Head slot"},
+	})
+
+	header := b.BuildThreadMessages(checks.CategorySync, results)[0]
+	assert.True(t, strings.HasSuffix(header, "**Issues detected**\n- Head slot (2 checks)\n- Node sync status\n"), header)
+}
@@ -0,0 +1,68 @@
+package message
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/ethpandaops/panda-pulse/pkg/checks"
+)
+
+// maxCompactInstances is how many affected instances a compact alert names before counting the rest.
+const maxCompactInstances = 10
+
+// BuildCompactMessage builds a compact alert, a single message standing in for the main message and
+// its thread. The thread pointer is swapped for the failing checks and a short summary of the affected
+// instances, and any mentions go in the message itself.
+func (b *AlertMessageBuilder) BuildCompactMessage(mentions []string) *discordgo.MessageSend {
+	summary := &discordgo.MessageEmbedField{
+		Name:   b.render(b.templates.IssuesDetected, templateVars{}),
+		Value:  b.buildCompactSummary(),
+		Inline: false,
+	}
+
+	embed := b.buildMainEmbed(summary)
+
+	// The summary is all a compact alert has in place of its thread, so it's kept even when the
+	// breakdown is hidden.
+	if !slices.Contains(b.embedFields, EmbedFieldBreakdown) {
+		embed.Fields = append(embed.Fields, summary)
+	}
+
+	return &discordgo.MessageSend{
+		Content:    strings.Join(uniqueMentions(mentions), " "),
+		Embed:      embed,
+		Components: b.buildActionButtons(),
+	}
+}
+
+// buildCompactSummary lists the failing checks and names the first few affected instances.
+func (b *AlertMessageBuilder) buildCompactSummary() string {
+	var (
+		sb     strings.Builder
+		failed = slices.DeleteFunc(slices.Clone(b.results), func(result *checks.Result) bool {
+			return result.Status != checks.StatusFail
+		})
+	)
+
+	sb.WriteString(checkNameList(b.getUniqueCheckNames(failed)))
+
+	instances := b.getSortedInstances(b.failingInstances())
+	if len(instances) == 0 {
+		return Truncate(sb.String(), MaxEmbedFieldValue)
+	}
+
+	shown := make([]string, 0, maxCompactInstances)
+	for _, inst := range instances[:min(len(instances), maxCompactInstances)] {
+		shown = append(shown, fmt.Sprintf("`%s`", inst.name))
+	}
+
+	fmt.Fprintf(&sb, "\n**%d affected instances**: %s", len(instances), strings.Join(shown, ", "))
+
+	if more := len(instances) - len(shown); more > 0 {
+		fmt.Fprintf(&sb, " and %d more", more)
+	}
+
+	return Truncate(sb.String(), MaxEmbedFieldValue)
+}
@@ -0,0 +1,55 @@
+package message
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/ethpandaops/panda-pulse/pkg/checks"
+	"github.com/ethpandaops/panda-pulse/pkg/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildCompactMessage(t *testing.T) {
+	nodes := make([]string, 0, 12)
+	for i := range 12 {
+		nodes = append(nodes, fmt.Sprintf("lighthouse-geth-%d", i+1))
+	}
+
+	b := NewAlertMessageBuilder(&Config{
+		Alert:   &store.MonitorAlert{Network: "devnet-0", Client: "lighthouse"},
+		CheckID: "check-1",
+		Results: []*checks.Result{
+			{Name: "Node sync status", Status: checks.StatusFail, Details: map[string]any{"notSyncedNodes": strings.Join(nodes, "\n")}},
+			{Name: "Head slot", Status: checks.StatusFail, Details: map[string]any{"behindNodes": "lighthouse-geth-1"}},
+			{Name: "Low peer count", Status: checks.StatusOK, Details: map[string]any{"lowPeerNodes": "lighthouse-besu-1"}},
+		},
+	})
+
+	msg := b.BuildCompactMessage([]string{"<@&1>", "<@2>"})
+	assert.Equal(t, "<@&1> <@2>", msg.Content)
+	assert.NotEmpty(t, msg.Components)
+
+	// The thread pointer is replaced by the summary.
+	summary := msg.Embed.Fields[len(msg.Embed.Fields)-1]
+	assert.Equal(t, "**Issues detected**", summary.Name)
+	assert.NotContains(t, summary.Value, "thread")
+	assert.Contains(t, summary.Value, "- Head slot\n- Node sync status\n")
+	assert.NotContains(t, summary.Value, "Low peer count")
+	assert.Contains(t, summary.Value, "**12 affected instances**: `lighthouse-geth-1`, `lighthouse-geth-2`")
+	assert.True(t, strings.HasSuffix(summary.Value, " and 2 more"))
+	assert.NotContains(t, summary.Value, "lighthouse-besu-1")
+
+	t.Run("without mentions or instances", func(t *testing.T) {
+		b := NewAlertMessageBuilder(&Config{
+			Alert:   &store.MonitorAlert{Network: "devnet-0", Client: "lighthouse"},
+			Results: []*checks.Result{{Name: "Finalized epoch not advancing", Status: checks.StatusFail}},
+		})
+
+		msg := b.BuildCompactMessage(nil)
+		require.NotNil(t, msg.Embed)
+		assert.Empty(t, msg.Content)
+		assert.Equal(t, "- Finalized epoch not advancing\n", msg.Embed.Fields[len(msg.Embed.Fields)-1].Value)
+	})
+}
@@ -0,0 +1,46 @@
+package message
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+const (
+	// DefaultDashboardUID is the Grafana dashboard alerts link to for the client on its network.
+	DefaultDashboardUID = "cebekx08rl9tsc"
+	// DefaultLogsDashboardUID is the Grafana dashboard alerts link to for the network's logs.
+	DefaultLogsDashboardUID = "aebfg1654nqwwd"
+)
+
+// ParseNetworkDashboards parses the JSON object of network to the UID of the Grafana dashboard its
+// alerts link to, for networks with a bespoke dashboard. An empty value is no overrides.
+func ParseNetworkDashboards(value string) (map[string]string, error) {
+	dashboards := make(map[string]string)
+
+	if strings.TrimSpace(value) == "" {
+		return dashboards, nil
+	}
+
+	var raw map[string]string
+	if err := json.Unmarshal([]byte(value), &raw); err != nil {
+		return nil, fmt.Errorf("failed to decode network dashboards: %w", err)
+	}
+
+	for _, network := range slices.Sorted(maps.Keys(raw)) {
+		uid := strings.TrimSpace(raw[network])
+		if uid == "" {
+			return nil, fmt.Errorf("dashboard for %s has no UID", network)
+		}
+
+		if strings.ContainsAny(uid, "/?#& ") {
+			return nil, fmt.Errorf("dashboard UID for %s is invalid: %q", network, uid)
+		}
+
+		dashboards[network] = uid
+	}
+
+	return dashboards, nil
+}
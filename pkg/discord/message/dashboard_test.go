@@ -0,0 +1,72 @@
+package message
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/ethpandaops/panda-pulse/pkg/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseNetworkDashboards(t *testing.T) {
+	dashboards, err := ParseNetworkDashboards(`{"devnet-0": " abc123xyz "}`)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"devnet-0": "abc123xyz"}, dashboards)
+
+	dashboards, err = ParseNetworkDashboards("")
+	require.NoError(t, err)
+	assert.Empty(t, dashboards)
+
+	for _, invalid := range []string{
+		`not json`,
+		`{"devnet-0": ""}`,
+		`{"devnet-0": "abc/123"}`,
+	} {
+		_, err = ParseNetworkDashboards(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestBuildActionButtons_Dashboards(t *testing.T) {
+	buttonURL := func(t *testing.T, b *AlertMessageBuilder, label string) string {
+		t.Helper()
+
+		for _, row := range b.buildActionButtons() {
+			for _, component := range row.(discordgo.ActionsRow).Components {
+				if btn := component.(discordgo.Button); strings.Contains(btn.Label, label) {
+					return btn.URL
+				}
+			}
+		}
+
+		t.Fatalf("no %s button", label)
+
+		return ""
+	}
+
+	newBuilder := func(network string) *AlertMessageBuilder {
+		return NewAlertMessageBuilder(&Config{
+			Alert:             &store.MonitorAlert{Network: network, Client: "lighthouse"},
+			GrafanaBaseURL:    "https://grafana.example.com",
+			LogsDashboardUID:  "logs456",
+			NetworkDashboards: map[string]string{"devnet-1": "bespoke789"},
+		})
+	}
+
+	t.Run("default", func(t *testing.T) {
+		b := newBuilder("devnet-0")
+
+		assert.True(t, strings.HasPrefix(buttonURL(t, b, "Grafana"), "https://grafana.example.com/d/"+DefaultDashboardUID+"?"))
+		assert.True(t, strings.HasPrefix(buttonURL(t, b, "Logs"), "https://grafana.example.com/d/logs456?"))
+	})
+
+	t.Run("network override", func(t *testing.T) {
+		b := newBuilder("devnet-1")
+
+		assert.True(t, strings.HasPrefix(buttonURL(t, b, "Grafana"), "https://grafana.example.com/d/bespoke789?"))
+		assert.Contains(t, buttonURL(t, b, "Grafana"), "var-network=devnet-1")
+		assert.True(t, strings.HasPrefix(b.instanceDashboardURL("lighthouse-geth-1"), "https://grafana.example.com/d/"+DefaultDashboardUID+"?"))
+	})
+}
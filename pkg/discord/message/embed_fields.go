@@ -0,0 +1,68 @@
+package message
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// EmbedField is a field of an alert's main embed, which can be reordered or hidden.
+type EmbedField string
+
+const (
+	EmbedFieldActiveIssues   EmbedField = "active-issues"   // Count of failing checks.
+	EmbedFieldNetwork        EmbedField = "network"         // Network the alert is for.
+	EmbedFieldTeam           EmbedField = "team"            // Team owning the client, when it has one.
+	EmbedFieldCriticalChecks EmbedField = "critical-checks" // Failing checks marked critical.
+	EmbedFieldPeerHealth     EmbedField = "peer-health"     // Analysis of the counterpart clients' health.
+	EmbedFieldStaleData      EmbedField = "stale-data"      // Warning that the checks ran on stale data.
+	EmbedFieldBreakdown      EmbedField = "breakdown"       // Pointer to the breakdown in the thread.
+)
+
+// DefaultEmbedFields is the order the main embed's fields are shown in when none is configured.
+var DefaultEmbedFields = []EmbedField{
+	EmbedFieldActiveIssues,
+	EmbedFieldNetwork,
+	EmbedFieldTeam,
+	EmbedFieldCriticalChecks,
+	EmbedFieldPeerHealth,
+	EmbedFieldStaleData,
+	EmbedFieldBreakdown,
+}
+
+// ParseEmbedFields parses a comma-separated list of the main embed's fields, in the order they're
+// shown, eg "network,active-issues,breakdown". Fields left out are hidden. An empty value returns
+// nil, for DefaultEmbedFields.
+func ParseEmbedFields(value string) ([]EmbedField, error) {
+	if strings.TrimSpace(value) == "" {
+		return nil, nil
+	}
+
+	fields := make([]EmbedField, 0)
+
+	for name := range strings.SplitSeq(value, ",") {
+		field := EmbedField(strings.ToLower(strings.TrimSpace(name)))
+
+		if !slices.Contains(DefaultEmbedFields, field) {
+			return nil, fmt.Errorf("unknown embed field %q, expected one of %s", name, embedFieldNames())
+		}
+
+		if slices.Contains(fields, field) {
+			return nil, fmt.Errorf("embed field %q is listed more than once", field)
+		}
+
+		fields = append(fields, field)
+	}
+
+	return fields, nil
+}
+
+// embedFieldNames lists the names of the main embed's fields, for error messages.
+func embedFieldNames() string {
+	names := make([]string, 0, len(DefaultEmbedFields))
+	for _, field := range DefaultEmbedFields {
+		names = append(names, string(field))
+	}
+
+	return strings.Join(names, ", ")
+}
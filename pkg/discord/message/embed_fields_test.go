@@ -0,0 +1,101 @@
+package message
+
+import (
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/ethpandaops/panda-pulse/pkg/checks"
+	"github.com/ethpandaops/panda-pulse/pkg/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseEmbedFields(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected []EmbedField
+		wantErr  string
+	}{
+		{
+			name:  "empty uses the defaults",
+			value: " ",
+		},
+		{
+			name:     "reordered and hidden",
+			value:    "Network, breakdown,active-issues",
+			expected: []EmbedField{EmbedFieldNetwork, EmbedFieldBreakdown, EmbedFieldActiveIssues},
+		},
+		{
+			name:    "unknown field",
+			value:   "network,version",
+			wantErr: `unknown embed field "version"`,
+		},
+		{
+			name:    "empty field",
+			value:   "network,,breakdown",
+			wantErr: `unknown embed field ""`,
+		},
+		{
+			name:    "duplicate field",
+			value:   "network,breakdown,network",
+			wantErr: `embed field "network" is listed more than once`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fields, err := ParseEmbedFields(tt.value)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, fields)
+		})
+	}
+}
+
+func TestBuildMainMessage_EmbedFields(t *testing.T) {
+	newBuilder := func(fields []EmbedField) *AlertMessageBuilder {
+		return NewAlertMessageBuilder(&Config{
+			Alert:          &store.MonitorAlert{Network: "devnet-0", Client: "lighthouse"},
+			Results:        []*checks.Result{{Name: "CL sync", Status: checks.StatusFail}},
+			Team:           "Sigma Prime",
+			CriticalChecks: []string{"CL sync"},
+			EmbedFields:    fields,
+		})
+	}
+
+	names := func(fields []*discordgo.MessageEmbedField) []string {
+		out := make([]string, 0, len(fields))
+		for _, field := range fields {
+			out = append(out, field.Name)
+		}
+
+		return out
+	}
+
+	t.Run("defaults", func(t *testing.T) {
+		embed := newBuilder(nil).BuildMainMessage().Embed
+		assert.Equal(t, []string{"⚠️ 1 Active Issues", "🌐 devnet-0", "👥 Sigma Prime", "🚨 Critical checks failing", ""}, names(embed.Fields))
+	})
+
+	t.Run("reordered and hidden", func(t *testing.T) {
+		embed := newBuilder([]EmbedField{EmbedFieldNetwork, EmbedFieldActiveIssues}).BuildMainMessage().Embed
+		assert.Equal(t, []string{"🌐 devnet-0", "⚠️ 1 Active Issues"}, names(embed.Fields))
+
+		// Hiding the critical checks field still marks the alert as critical.
+		assert.Equal(t, criticalColor, embed.Color)
+	})
+
+	t.Run("compact keeps its summary", func(t *testing.T) {
+		msg := newBuilder([]EmbedField{EmbedFieldNetwork}).BuildCompactMessage(nil)
+		require.Len(t, msg.Embed.Fields, 2)
+		assert.Equal(t, "**Issues detected**", msg.Embed.Fields[1].Name)
+		assert.Equal(t, "- CL sync\n", msg.Embed.Fields[1].Value)
+	})
+}
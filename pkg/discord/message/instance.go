@@ -1,12 +1,39 @@
 package message
 
-import "fmt"
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// DefaultSSHCommandTemplate is the SSH command shown for affected instances, supporting
+// {instance} and {network}.
+const DefaultSSHCommandTemplate = "ssh devops@{instance}.{network}.ethpandaops.io"
+
+// DefaultRegionPattern recognises a region suffixed to an instance name, eg the "use1" of
+// lighthouse-geth-1-use1.
+const DefaultRegionPattern = `-(?P<region>[a-z]{2,}\d+)$`
+
+// Named groups of an instance name pattern. The cl and el groups are required, index is optional.
+const (
+	instanceGroupCL     = "cl"
+	instanceGroupEL     = "el"
+	instanceGroupIndex  = "index"
+	instanceGroupRegion = "region"
+)
 
 // instance represents a node/instance of a client pair in the network.
 type instance struct {
 	name    string
 	network string
 	client  string
+	cl      string // CL client of the pair, empty if the name couldn't be parsed
+	el      string // EL client of the pair, empty if the name couldn't be parsed
+	region  string // Region suffixed to the name, empty if there isn't one
 }
 
 // String returns the string representation of the instance.
@@ -14,16 +41,227 @@ func (i instance) String() string {
 	return i.name
 }
 
-// sshCommand returns the SSH command to connect to the instance.
-func (i instance) sshCommand() string {
-	return fmt.Sprintf("ssh devops@%s.%s.ethpandaops.io", i.name, i.network)
+// sshCommand returns the SSH command to connect to the instance, rendered from template.
+func (i instance) sshCommand(template string) string {
+	return strings.NewReplacer("{instance}", i.name, "{network}", i.network).Replace(template)
 }
 
-// newInstance creates a new instance with the given parameters.
+// counterpart returns the other client in the instance's client pair, eg "geth" for the
+// lighthouse instance "lighthouse-geth-1". Falls back to the full name if it can't be parsed.
+func (i instance) counterpart() string {
+	if i.cl == "" {
+		return i.name
+	}
+
+	if i.cl == i.client {
+		return i.el
+	}
+
+	return i.cl
+}
+
+// newInstance creates a new instance with the given parameters, parsing its client pair with the
+// default clclient-elclient-N naming convention.
 func newInstance(name, network, client string) instance {
-	return instance{
+	return newInstanceWithPattern(name, network, client, nil, nil)
+}
+
+// newInstanceWithPattern creates a new instance, parsing its client pair with pattern, or the
+// default naming convention if pattern is nil. Any region suffix matching region is parsed off
+// first, a nil region doesn't look for one.
+func newInstanceWithPattern(name, network, client string, pattern, region *regexp.Regexp) instance {
+	inst := instance{
 		name:    name,
 		network: network,
 		client:  client,
 	}
+
+	var base string
+
+	base, inst.region = splitRegion(name, region)
+	inst.cl, inst.el, _ = splitInstanceName(base, pattern)
+
+	return inst
+}
+
+// splitRegion splits the region suffix off an instance name, returning the name without it and the
+// region. Names without a region suffix, or a nil pattern, are returned as is.
+func splitRegion(name string, pattern *regexp.Regexp) (string, string) {
+	if pattern == nil {
+		return name, ""
+	}
+
+	match := pattern.FindStringSubmatchIndex(name)
+	if match == nil || match[1] != len(name) {
+		return name, ""
+	}
+
+	group := pattern.SubexpIndex(instanceGroupRegion)
+	if group < 0 || match[2*group] < 0 || match[0] == 0 {
+		return name, ""
+	}
+
+	return name[:match[0]], name[match[2*group]:match[2*group+1]]
+}
+
+// splitInstanceName returns the CL and EL clients of an instance name, without any region suffix.
+// Without a pattern, the name is expected to follow the clclient-elclient-N convention. Returns
+// false if the name doesn't match.
+func splitInstanceName(name string, pattern *regexp.Regexp) (string, string, bool) {
+	if pattern == nil {
+		parts := strings.Split(name, "-")
+		if len(parts) < 2 {
+			return "", "", false
+		}
+
+		return parts[0], parts[1], true
+	}
+
+	match := pattern.FindStringSubmatch(name)
+	if match == nil {
+		return "", "", false
+	}
+
+	var (
+		cl = match[pattern.SubexpIndex(instanceGroupCL)]
+		el = match[pattern.SubexpIndex(instanceGroupEL)]
+	)
+
+	if cl == "" || el == "" {
+		return "", "", false
+	}
+
+	return cl, el, true
+}
+
+// ParseInstancePatterns parses a JSON object of network to instance name pattern, for networks
+// whose instances don't follow the clclient-elclient-N convention. Patterns are regular expressions
+// with named groups for the cl and el clients, and optionally the index, eg
+// {"devnet-0": "^node-(?P<el>[a-z]+)_(?P<cl>[a-z]+)_(?P<index>\\d+)$"}.
+func ParseInstancePatterns(value string) (map[string]*regexp.Regexp, error) {
+	patterns := make(map[string]*regexp.Regexp)
+
+	if strings.TrimSpace(value) == "" {
+		return patterns, nil
+	}
+
+	var raw map[string]string
+	if err := json.Unmarshal([]byte(value), &raw); err != nil {
+		return nil, fmt.Errorf("failed to decode instance patterns: %w", err)
+	}
+
+	for _, network := range slices.Sorted(maps.Keys(raw)) {
+		pattern, err := regexp.Compile(raw[network])
+		if err != nil {
+			return nil, fmt.Errorf("invalid instance pattern for %s: %w", network, err)
+		}
+
+		for _, group := range []string{instanceGroupCL, instanceGroupEL} {
+			if pattern.SubexpIndex(group) < 0 {
+				return nil, fmt.Errorf("instance pattern for %s is missing the %s group", network, group)
+			}
+		}
+
+		patterns[network] = pattern
+	}
+
+	return patterns, nil
+}
+
+// ParseRegionPattern parses the pattern recognising a region suffixed to instance names, which
+// needs a region group and to match the end of the name. An empty value is DefaultRegionPattern.
+func ParseRegionPattern(value string) (*regexp.Regexp, error) {
+	if strings.TrimSpace(value) == "" {
+		value = DefaultRegionPattern
+	}
+
+	pattern, err := regexp.Compile(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid region pattern: %w", err)
+	}
+
+	if pattern.SubexpIndex(instanceGroupRegion) < 0 {
+		return nil, fmt.Errorf("region pattern is missing the %s group", instanceGroupRegion)
+	}
+
+	if !strings.HasSuffix(value, "$") {
+		return nil, errors.New("region pattern must be anchored to the end of the name with $")
+	}
+
+	return pattern, nil
+}
+
+// compareInstances orders instances by region, then their counterpart client, then naturally by
+// name so instances of the same client pair in a region are grouped together and "-2" sorts
+// before "-10". Instances without a region come first.
+func compareInstances(a, b instance) int {
+	if c := strings.Compare(a.region, b.region); c != 0 {
+		return c
+	}
+
+	if c := naturalCompare(a.counterpart(), b.counterpart()); c != 0 {
+		return c
+	}
+
+	return naturalCompare(a.name, b.name)
+}
+
+// naturalCompare compares two strings, treating runs of digits as numbers rather than text.
+func naturalCompare(a, b string) int {
+	for a != "" && b != "" {
+		var (
+			aChunk, aDigits = nextChunk(a)
+			bChunk, bDigits = nextChunk(b)
+		)
+
+		a, b = a[len(aChunk):], b[len(bChunk):]
+
+		if aDigits && bDigits {
+			if c := compareNumeric(aChunk, bChunk); c != 0 {
+				return c
+			}
+
+			continue
+		}
+
+		if c := strings.Compare(aChunk, bChunk); c != 0 {
+			return c
+		}
+	}
+
+	return len(a) - len(b)
+}
+
+// nextChunk returns the leading run of either digits or non-digits in s.
+func nextChunk(s string) (string, bool) {
+	digits := isDigit(s[0])
+
+	end := 1
+	for end < len(s) && isDigit(s[end]) == digits {
+		end++
+	}
+
+	return s[:end], digits
+}
+
+// compareNumeric compares two digit strings by value without parsing, so arbitrarily long
+// runs can't overflow.
+func compareNumeric(a, b string) int {
+	trimmedA, trimmedB := strings.TrimLeft(a, "0"), strings.TrimLeft(b, "0")
+
+	if len(trimmedA) != len(trimmedB) {
+		return len(trimmedA) - len(trimmedB)
+	}
+
+	if c := strings.Compare(trimmedA, trimmedB); c != 0 {
+		return c
+	}
+
+	// Equal values, fall back to the raw strings so "01" and "1" still have a stable order.
+	return strings.Compare(a, b)
+}
+
+// isDigit returns true if the byte is an ASCII digit.
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
 }
@@ -0,0 +1,313 @@
+package message
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/ethpandaops/panda-pulse/pkg/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNaturalCompare(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        string
+		b        string
+		expected int
+	}{
+		{name: "single digit before double digit", a: "lighthouse-geth-2", b: "lighthouse-geth-10", expected: -1},
+		{name: "double digit after single digit", a: "lighthouse-geth-10", b: "lighthouse-geth-2", expected: 1},
+		{name: "equal", a: "lighthouse-geth-1", b: "lighthouse-geth-1", expected: 0},
+		{name: "text compared lexically", a: "lighthouse-besu-1", b: "lighthouse-geth-1", expected: -1},
+		{name: "prefix sorts first", a: "lighthouse-geth", b: "lighthouse-geth-1", expected: -1},
+		{name: "leading zeros compare by value", a: "node-02", b: "node-10", expected: -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := naturalCompare(tt.a, tt.b)
+
+			switch {
+			case tt.expected < 0:
+				assert.Negative(t, got)
+			case tt.expected > 0:
+				assert.Positive(t, got)
+			default:
+				assert.Zero(t, got)
+			}
+		})
+	}
+}
+
+func TestGetSortedInstances(t *testing.T) {
+	tests := []struct {
+		name      string
+		client    string
+		instances []string
+		expected  []string
+	}{
+		{
+			name:   "cl client grouped by el counterpart with numeric indices",
+			client: "lighthouse",
+			instances: []string{
+				"lighthouse-geth-10",
+				"lighthouse-besu-2",
+				"lighthouse-geth-2",
+				"lighthouse-besu-10",
+				"lighthouse-geth-1",
+				"lighthouse-besu-1",
+			},
+			expected: []string{
+				"lighthouse-besu-1",
+				"lighthouse-besu-2",
+				"lighthouse-besu-10",
+				"lighthouse-geth-1",
+				"lighthouse-geth-2",
+				"lighthouse-geth-10",
+			},
+		},
+		{
+			name:   "el client grouped by cl counterpart",
+			client: "geth",
+			instances: []string{
+				"teku-geth-1",
+				"lighthouse-geth-10",
+				"prysm-geth-3",
+				"lighthouse-geth-2",
+				"teku-geth-11",
+			},
+			expected: []string{
+				"lighthouse-geth-2",
+				"lighthouse-geth-10",
+				"prysm-geth-3",
+				"teku-geth-1",
+				"teku-geth-11",
+			},
+		},
+		{
+			name:   "variant suffixes stay with their client pair",
+			client: "lighthouse",
+			instances: []string{
+				"lighthouse-nethermind-super-1",
+				"lighthouse-nethermind-10",
+				"lighthouse-nethermind-2",
+			},
+			expected: []string{
+				"lighthouse-nethermind-2",
+				"lighthouse-nethermind-10",
+				"lighthouse-nethermind-super-1",
+			},
+		},
+		{
+			name:   "region suffixes grouped by region",
+			client: "lighthouse",
+			instances: []string{
+				"lighthouse-geth-2-use1",
+				"lighthouse-besu-1-euw1",
+				"lighthouse-geth-1-euw1",
+				"lighthouse-geth-3",
+				"lighthouse-besu-1-use1",
+			},
+			expected: []string{
+				"lighthouse-geth-3",
+				"lighthouse-besu-1-euw1",
+				"lighthouse-geth-1-euw1",
+				"lighthouse-besu-1-use1",
+				"lighthouse-geth-2-use1",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewAlertMessageBuilder(&Config{
+				Alert: &store.MonitorAlert{Network: "devnet-0", Client: tt.client},
+			})
+
+			instances := make(map[string]bool, len(tt.instances))
+			for _, name := range tt.instances {
+				instances[name] = true
+			}
+
+			got := make([]string, 0, len(tt.expected))
+			for _, inst := range b.getSortedInstances(instances) {
+				got = append(got, inst.name)
+			}
+
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestInstanceSSHCommand(t *testing.T) {
+	inst := newInstance("lighthouse-geth-1", "devnet-0", "lighthouse")
+
+	assert.Equal(t, "ssh devops@lighthouse-geth-1.devnet-0.ethpandaops.io", inst.sshCommand(DefaultSSHCommandTemplate))
+	assert.Equal(t, "ssh -J bastion root@lighthouse-geth-1.devnet-0.example.com", inst.sshCommand("ssh -J bastion root@{instance}.{network}.example.com"))
+}
+
+func TestParseInstancePatterns(t *testing.T) {
+	patterns, err := ParseInstancePatterns(`{"devnet-0": "^(?P<el>[a-z]+)_(?P<cl>[a-z]+)_(?P<index>\\d+)$"}`)
+	require.NoError(t, err)
+	require.Contains(t, patterns, "devnet-0")
+
+	patterns, err = ParseInstancePatterns("")
+	require.NoError(t, err)
+	assert.Empty(t, patterns)
+
+	for _, invalid := range []string{
+		`not json`,
+		`{"devnet-0": "(?P<cl>[a-z]+"}`,
+		`{"devnet-0": "^(?P<cl>[a-z]+)-(\\d+)$"}`,
+	} {
+		_, err = ParseInstancePatterns(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestParseRegionPattern(t *testing.T) {
+	pattern, err := ParseRegionPattern("")
+	require.NoError(t, err)
+	assert.Equal(t, DefaultRegionPattern, pattern.String())
+
+	_, err = ParseRegionPattern(`\.(?P<region>[a-z]+)$`)
+	require.NoError(t, err)
+
+	for _, invalid := range []string{
+		`-(?P<region>[a-z]+`,
+		`-([a-z]+\d)$`,
+		`-(?P<region>[a-z]+\d)`,
+	} {
+		_, err = ParseRegionPattern(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestInstanceRegions(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern string
+		client  string
+		line    string
+		cl, el  string
+		region  string
+	}{
+		{name: "no region", client: "lighthouse", line: "lighthouse-geth-1", cl: "lighthouse", el: "geth"},
+		{name: "region suffix", client: "geth", line: "lighthouse-geth-1-use1", cl: "lighthouse", el: "geth", region: "use1"},
+		{name: "variant suffix isn't a region", client: "lighthouse", line: "lighthouse-nethermind-super-1", cl: "lighthouse", el: "nethermind"},
+		{
+			name:    "region with an instance pattern",
+			pattern: `^(?P<el>[a-z]+)_(?P<cl>[a-z]+)_(?P<index>\d+)$`,
+			client:  "lighthouse",
+			line:    "nethermind_lighthouse_3-aps1 (2 peers)",
+			cl:      "lighthouse",
+			el:      "nethermind",
+			region:  "aps1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var pattern *regexp.Regexp
+			if tt.pattern != "" {
+				pattern = regexp.MustCompile(tt.pattern)
+			}
+
+			b := NewAlertMessageBuilder(&Config{
+				Alert:           &store.MonitorAlert{Network: "devnet-0", Client: tt.client},
+				InstancePattern: pattern,
+			})
+
+			name := b.parseInstanceFromLine(tt.line)
+			require.NotEmpty(t, name, "client should be matched")
+
+			inst := b.getSortedInstances(map[string]bool{name: true})[0]
+			assert.Equal(t, tt.cl, inst.cl)
+			assert.Equal(t, tt.el, inst.el)
+			assert.Equal(t, tt.region, inst.region)
+		})
+	}
+
+	t.Run("custom region pattern", func(t *testing.T) {
+		b := NewAlertMessageBuilder(&Config{
+			Alert:         &store.MonitorAlert{Network: "devnet-0", Client: "lighthouse"},
+			RegionPattern: regexp.MustCompile(`\.(?P<region>[a-z-]+)$`),
+		})
+
+		inst := b.getSortedInstances(map[string]bool{"lighthouse-geth-1.us-east": true})[0]
+		assert.Equal(t, "us-east", inst.region)
+		assert.Equal(t, "geth", inst.el)
+	})
+}
+
+func TestInstanceNamePatterns(t *testing.T) {
+	tests := []struct {
+		name     string
+		pattern  string
+		client   string
+		line     string
+		expected string
+		cl, el   string
+	}{
+		{
+			name:     "provider prefix",
+			pattern:  `^(?:hetzner|aws)-(?P<cl>[a-z]+)-(?P<el>[a-z]+)-(?P<index>\d+)$`,
+			client:   "geth",
+			line:     "hetzner-lighthouse-geth-1",
+			expected: "hetzner-lighthouse-geth-1",
+			cl:       "lighthouse",
+			el:       "geth",
+		},
+		{
+			name:     "underscores with the el first",
+			pattern:  `^(?P<el>[a-z]+)_(?P<cl>[a-z]+)_(?P<index>\d+)$`,
+			client:   "lighthouse",
+			line:     "nethermind_lighthouse_3 (2 peers)",
+			expected: "nethermind_lighthouse_3",
+			cl:       "lighthouse",
+			el:       "nethermind",
+		},
+		{
+			name:    "other client",
+			pattern: `^(?P<el>[a-z]+)_(?P<cl>[a-z]+)_(?P<index>\d+)$`,
+			client:  "teku",
+			line:    "nethermind_lighthouse_3",
+		},
+		{
+			name:    "not matching the pattern",
+			pattern: `^(?P<el>[a-z]+)_(?P<cl>[a-z]+)_(?P<index>\d+)$`,
+			client:  "lighthouse",
+			line:    "lighthouse-nethermind-3",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pattern := regexp.MustCompile(tt.pattern)
+
+			b := NewAlertMessageBuilder(&Config{
+				Alert:           &store.MonitorAlert{Network: "devnet-0", Client: tt.client},
+				InstancePattern: pattern,
+			})
+
+			assert.Equal(t, tt.expected, b.parseInstanceFromLine(tt.line))
+
+			if tt.expected == "" {
+				return
+			}
+
+			inst := newInstanceWithPattern(tt.expected, "devnet-0", tt.client, pattern, defaultRegionPattern)
+			assert.Equal(t, tt.cl, inst.cl)
+			assert.Equal(t, tt.el, inst.el)
+			assert.NotEqual(t, tt.client, inst.counterpart())
+		})
+	}
+
+	t.Run("default convention", func(t *testing.T) {
+		b := NewAlertMessageBuilder(&Config{Alert: &store.MonitorAlert{Network: "devnet-0", Client: "geth"}})
+
+		assert.Equal(t, "lighthouse-geth-1", b.parseInstanceFromLine("lighthouse-geth-1"))
+		assert.Empty(t, b.parseInstanceFromLine("hetzner-lighthouse-geth-1"))
+	})
+}
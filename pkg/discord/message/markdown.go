@@ -0,0 +1,54 @@
+package message
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// BuildMarkdown renders the main message as plain Markdown, for notification sinks that can't
+// show embeds or buttons. The buttons become links at the bottom of the message.
+func (b *AlertMessageBuilder) BuildMarkdown() string {
+	var (
+		sb    strings.Builder
+		embed = b.buildMainEmbed(b.buildBreakdownField())
+	)
+
+	fmt.Fprintf(&sb, "**%s**\n", embed.Title)
+
+	for _, field := range embed.Fields {
+		if field.Name != "" {
+			sb.WriteString(field.Name + "\n")
+		}
+
+		if field.Value != "" {
+			sb.WriteString(field.Value + "\n")
+		}
+	}
+
+	links := make([]string, 0)
+
+	for _, row := range b.buildActionButtons() {
+		actions, ok := row.(discordgo.ActionsRow)
+		if !ok {
+			continue
+		}
+
+		for _, component := range actions.Components {
+			if button, ok := component.(discordgo.Button); ok && button.URL != "" {
+				links = append(links, fmt.Sprintf("[%s](%s)", button.Label, button.URL))
+			}
+		}
+	}
+
+	if len(links) > 0 {
+		sb.WriteString(strings.Join(links, " • ") + "\n")
+	}
+
+	if embed.Footer != nil {
+		fmt.Fprintf(&sb, "_%s_", embed.Footer.Text)
+	}
+
+	return strings.TrimSpace(sb.String())
+}
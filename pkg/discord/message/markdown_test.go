@@ -0,0 +1,33 @@
+package message
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ethpandaops/panda-pulse/pkg/checks"
+	"github.com/ethpandaops/panda-pulse/pkg/store"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildMarkdown(t *testing.T) {
+	b := NewAlertMessageBuilder(&Config{
+		Alert:          &store.MonitorAlert{Network: "devnet-0", Client: "lighthouse"},
+		CheckID:        "check-id",
+		GrafanaBaseURL: "https://grafana.example.com",
+		Results: []*checks.Result{
+			{Name: "Head slot not advancing", Status: checks.StatusFail},
+			{Name: "Node sync status", Status: checks.StatusFail},
+		},
+	})
+
+	markdown := b.BuildMarkdown()
+	lines := strings.Split(markdown, "\n")
+
+	assert.Equal(t, "**Lighthouse**", lines[0])
+	assert.Contains(t, markdown, "⚠️ 2 Active Issues\n🌐 devnet-0\n")
+	assert.Contains(t, markdown, "Check the thread below for a breakdown")
+	assert.Contains(t, markdown, "[📊 Grafana](https://grafana.example.com/")
+	assert.Contains(t, markdown, " • [📝 Logs](https://grafana.example.com/")
+	assert.NotContains(t, markdown, "Hive")
+	assert.Equal(t, "_ID: check-id_", lines[len(lines)-1])
+}
@@ -0,0 +1,80 @@
+package message
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// DefaultRemediationCommand is the command the remediation script runs on each affected instance.
+const DefaultRemediationCommand = "docker ps --all"
+
+const remediationScriptMessage = "📜 Script running `%s` on the **%d** affected instances, review it before running"
+
+// shellSafeWord matches values that can be used in a shell command as they are.
+var shellSafeWord = regexp.MustCompile(`^[A-Za-z0-9._-]+$`)
+
+// BuildRemediationScript builds a message attaching a shell script that runs the remediation command
+// over SSH on every affected instance, in the same order they're listed in the thread. Returns nil
+// if there are no affected instances.
+func (b *AlertMessageBuilder) BuildRemediationScript() *discordgo.MessageSend {
+	instances := b.getSortedInstances(b.failingInstances())
+	if len(instances) == 0 {
+		return nil
+	}
+
+	var sb strings.Builder
+
+	sb.WriteString("#!/usr/bin/env bash\n")
+	fmt.Fprintf(&sb, "# Remediation script for %s on %s, check %s.\n", b.alert.Client, b.alert.Network, b.checkID)
+	sb.WriteString("#\n")
+	sb.WriteString("# SAFETY: this runs the command below on every instance listed, review both before running.\n")
+	sb.WriteString("# Instances are worked through one at a time, a failure on one doesn't stop the rest.\n")
+	fmt.Fprintf(&sb, "#\n# Command: %s\n\n", b.remediationCommand)
+	sb.WriteString("set -u\n\n")
+	fmt.Fprintf(&sb, "remediation=%s\n", shellQuote(b.remediationCommand))
+	sb.WriteString("failed=0\n\n")
+
+	for _, inst := range instances {
+		fmt.Fprintf(&sb, "echo %s\n", shellQuote("==> "+inst.name))
+		fmt.Fprintf(&sb, "%s \"$remediation\" || { echo %s; failed=$((failed + 1)); }\n\n",
+			inst.scriptSSHCommand(b.sshCommandTemplate), shellQuote("!! failed on "+inst.name))
+	}
+
+	fmt.Fprintf(&sb, "echo \"Done, ${failed} of %d instances failed\"\n", len(instances))
+	sb.WriteString("[ \"$failed\" -eq 0 ]\n")
+
+	return &discordgo.MessageSend{
+		Content: fmt.Sprintf(remediationScriptMessage, b.remediationCommand, len(instances)),
+		Files: []*discordgo.File{
+			{
+				Name:        fmt.Sprintf("remediate-%s-%s.sh", b.alert.Client, b.checkID),
+				ContentType: "text/x-shellscript",
+				Reader:      strings.NewReader(sb.String()),
+			},
+		},
+	}
+}
+
+// scriptSSHCommand returns the SSH command to connect to the instance for use in a script. The
+// instance and network come from metric labels, so any that aren't plain names are quoted rather
+// than being run as shell code.
+func (i instance) scriptSSHCommand(template string) string {
+	return strings.NewReplacer("{instance}", shellWord(i.name), "{network}", shellWord(i.network)).Replace(template)
+}
+
+// shellWord returns the value as it is if it's safe to use in a shell command, quoted otherwise.
+func shellWord(value string) string {
+	if shellSafeWord.MatchString(value) {
+		return value
+	}
+
+	return shellQuote(value)
+}
+
+// shellQuote quotes a value for use as a single shell word.
+func shellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
@@ -0,0 +1,121 @@
+package message
+
+import (
+	"io"
+	"regexp"
+	"testing"
+
+	"github.com/ethpandaops/panda-pulse/pkg/checks"
+	"github.com/ethpandaops/panda-pulse/pkg/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildRemediationScript(t *testing.T) {
+	results := []*checks.Result{
+		{
+			Name:     "Node sync status",
+			Category: checks.CategorySync,
+			Status:   checks.StatusFail,
+			Details: map[string]any{
+				"notSyncedNodes": "lighthouse-geth-1\nlighthouse-besu-1",
+			},
+		},
+		{
+			Name:     "Head slot",
+			Category: checks.CategorySync,
+			Status:   checks.StatusOK,
+			Details: map[string]any{
+				"behindNodes": "lighthouse-erigon-1",
+			},
+		},
+	}
+
+	t.Run("no affected instances", func(t *testing.T) {
+		b := NewAlertMessageBuilder(&Config{
+			CheckID: "check-1",
+			Alert:   &store.MonitorAlert{Network: "devnet-0", Client: "lighthouse"},
+			Results: results[1:],
+		})
+
+		assert.Nil(t, b.BuildRemediationScript())
+	})
+
+	t.Run("default command", func(t *testing.T) {
+		b := NewAlertMessageBuilder(&Config{
+			CheckID: "check-1",
+			Alert:   &store.MonitorAlert{Network: "devnet-0", Client: "lighthouse"},
+			Results: results,
+		})
+
+		msg := b.BuildRemediationScript()
+		require.NotNil(t, msg)
+		assert.Contains(t, msg.Content, "**2** affected instances")
+
+		require.Len(t, msg.Files, 1)
+		assert.Equal(t, "remediate-lighthouse-check-1.sh", msg.Files[0].Name)
+
+		content, err := io.ReadAll(msg.Files[0].Reader)
+		require.NoError(t, err)
+
+		script := string(content)
+		assert.Contains(t, script, "#!/usr/bin/env bash\n")
+		assert.Contains(t, script, "# Remediation script for lighthouse on devnet-0, check check-1.\n")
+		assert.Contains(t, script, "SAFETY")
+		assert.Contains(t, script, "remediation='docker ps --all'\n")
+		assert.Contains(t, script, "ssh devops@lighthouse-besu-1.devnet-0.ethpandaops.io \"$remediation\"")
+		assert.Contains(t, script, "ssh devops@lighthouse-geth-1.devnet-0.ethpandaops.io \"$remediation\"")
+		assert.NotContains(t, script, "lighthouse-erigon-1")
+	})
+
+	t.Run("custom command and SSH template", func(t *testing.T) {
+		b := NewAlertMessageBuilder(&Config{
+			CheckID:            "check-1",
+			Alert:              &store.MonitorAlert{Network: "devnet-0", Client: "lighthouse"},
+			Results:            results,
+			SSHCommandTemplate: "ssh -p 2222 root@{instance}.example.com",
+			RemediationCommand: "journalctl -u 'beacon' -n 50",
+		})
+
+		content, err := io.ReadAll(b.BuildRemediationScript().Files[0].Reader)
+		require.NoError(t, err)
+
+		assert.Contains(t, string(content), `remediation='journalctl -u '\''beacon'\'' -n 50'`)
+		assert.Contains(t, string(content), "ssh -p 2222 root@lighthouse-geth-1.example.com \"$remediation\"")
+	})
+
+	t.Run("hostile instance names are quoted", func(t *testing.T) {
+		b := NewAlertMessageBuilder(&Config{
+			CheckID: "check-1",
+			Alert:   &store.MonitorAlert{Network: "devnet-0", Client: "lighthouse"},
+			Results: []*checks.Result{{
+				Name:     "Node sync status",
+				Category: checks.CategorySync,
+				Status:   checks.StatusFail,
+				Details: map[string]any{
+					"notSyncedNodes": "lighthouse-geth-1;$(reboot)",
+				},
+			}},
+		})
+
+		content, err := io.ReadAll(b.BuildRemediationScript().Files[0].Reader)
+		require.NoError(t, err)
+
+		const (
+			hostile = "lighthouse-geth-1;$(reboot)"
+			quoted  = `'lighthouse-geth-1;$(reboot)'`
+		)
+
+		assert.Equal(t, quoted, shellWord(hostile))
+
+		script := string(content)
+		assert.Contains(t, script, `ssh devops@'lighthouse-geth-1;$(reboot)'.devnet-0.ethpandaops.io "$remediation"`)
+		assert.NotContains(t, script, "@"+hostile)
+
+		// Nothing of the name is left once comments and everything in single quotes are removed.
+		unquoted := regexp.MustCompile(`(?m)^#.*$`).ReplaceAllString(script, "")
+		unquoted = regexp.MustCompile(`'[^']*'`).ReplaceAllString(unquoted, "")
+		assert.NotContains(t, unquoted, hostile)
+		assert.NotContains(t, unquoted, "$(reboot)")
+	})
+}
@@ -0,0 +1,161 @@
+package message
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// templatePlaceholder matches placeholders such as {client} in a message template.
+var templatePlaceholder = regexp.MustCompile(`\{[^{}]*\}`)
+
+// Templates is the wording used in alert messages. Templates may use the {client}, {network},
+// {count}, {category} and {emoji} placeholders, unset values render as empty strings.
+type Templates struct {
+	ActiveIssues         string `json:"activeIssues"`         // Main embed issue count.
+	Breakdown            string `json:"breakdown"`            // Main embed pointer to the thread.
+	PeerHealth           string `json:"peerHealth"`           // Main embed peer health title.
+	StaleData            string `json:"staleData"`            // Main embed stale data title.
+	CriticalChecks       string `json:"criticalChecks"`       // Main embed title for failing critical checks.
+	CategoryHeader       string `json:"categoryHeader"`       // Thread header for each check category.
+	IssuesDetected       string `json:"issuesDetected"`       // Title of the failed check list.
+	AffectedInstances    string `json:"affectedInstances"`    // Section title for affected instances.
+	LikelyUnrelated      string `json:"likelyUnrelated"`      // Section title for instances likely failing for another reason.
+	InfrastructureIssues string `json:"infrastructureIssues"` // Section title for instances likely down.
+	FlappingInstances    string `json:"flappingInstances"`    // Section title for flapping instances.
+	SSHCommands          string `json:"sshCommands"`          // Section title for SSH commands.
+	InstanceDashboards   string `json:"instanceDashboards"`   // Section title for per-instance Grafana links.
+	HiveSummary          string `json:"hiveSummary"`          // Caption for the Hive screenshot.
+}
+
+// templateVars are the values substituted into a message template.
+type templateVars struct {
+	Client   string
+	Network  string
+	Count    int
+	Category string
+	Emoji    string
+}
+
+// placeholders returns the template placeholders and their values.
+func (v templateVars) placeholders() map[string]string {
+	return map[string]string{
+		"{client}":   v.Client,
+		"{network}":  v.Network,
+		"{count}":    fmt.Sprint(v.Count),
+		"{category}": v.Category,
+		"{emoji}":    v.Emoji,
+	}
+}
+
+// DefaultTemplates returns the default alert message wording.
+func DefaultTemplates() *Templates {
+	return &Templates{
+		ActiveIssues:         "⚠️ {count} Active Issues",
+		Breakdown:            "Check the thread below for a breakdown",
+		PeerHealth:           "🩺 Peer health",
+		StaleData:            "🕰️ Stale data",
+		CriticalChecks:       "🚨 Critical checks failing",
+		CategoryHeader:       "**{emoji} {category} Issues**",
+		IssuesDetected:       "**Issues detected**",
+		AffectedInstances:    "**Affected instances**",
+		LikelyUnrelated:      "**Affected instances (likely unrelated)**",
+		InfrastructureIssues: "**Potential infrastructure issues**",
+		FlappingInstances:    "**Flapping instances** (sync status keeps toggling, likely intermittent rather than stuck)",
+		SSHCommands:          "**SSH commands**",
+		InstanceDashboards:   "**Instance dashboards**",
+		HiveSummary:          "**Hive Summary**",
+	}
+}
+
+// LoadTemplates loads alert message templates from a JSON file, falling back to the defaults for any
+// template the file leaves out. An empty path returns the defaults.
+func LoadTemplates(path string) (*Templates, error) {
+	templates := DefaultTemplates()
+
+	if path == "" {
+		return templates, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read templates: %w", err)
+	}
+
+	// Reject unknown keys, a typo would otherwise silently leave the default in place.
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	if derr := decoder.Decode(templates); derr != nil {
+		return nil, fmt.Errorf("failed to decode templates: %w", derr)
+	}
+
+	if verr := templates.Validate(); verr != nil {
+		return nil, verr
+	}
+
+	return templates, nil
+}
+
+// Validate checks every template only uses known placeholders and isn't empty.
+func (t *Templates) Validate() error {
+	var (
+		known  = templateVars{}.placeholders()
+		fields = t.fields()
+	)
+
+	for _, name := range slices.Sorted(maps.Keys(fields)) {
+		template := fields[name]
+		if strings.TrimSpace(template) == "" {
+			return fmt.Errorf("template %s is empty", name)
+		}
+
+		for _, placeholder := range templatePlaceholder.FindAllString(template, -1) {
+			if _, ok := known[placeholder]; !ok {
+				return fmt.Errorf("template %s has unknown placeholder %s", name, placeholder)
+			}
+		}
+	}
+
+	return nil
+}
+
+// fields returns each template keyed by its JSON name.
+func (t *Templates) fields() map[string]string {
+	return map[string]string{
+		"activeIssues":         t.ActiveIssues,
+		"breakdown":            t.Breakdown,
+		"peerHealth":           t.PeerHealth,
+		"staleData":            t.StaleData,
+		"criticalChecks":       t.CriticalChecks,
+		"categoryHeader":       t.CategoryHeader,
+		"issuesDetected":       t.IssuesDetected,
+		"affectedInstances":    t.AffectedInstances,
+		"likelyUnrelated":      t.LikelyUnrelated,
+		"infrastructureIssues": t.InfrastructureIssues,
+		"flappingInstances":    t.FlappingInstances,
+		"sshCommands":          t.SSHCommands,
+		"instanceDashboards":   t.InstanceDashboards,
+		"hiveSummary":          t.HiveSummary,
+	}
+}
+
+// renderTemplate renders a message template. Unknown placeholders are left as-is.
+func renderTemplate(template string, vars templateVars) string {
+	values := vars.placeholders()
+
+	rendered := templatePlaceholder.ReplaceAllStringFunc(template, func(placeholder string) string {
+		if value, ok := values[placeholder]; ok {
+			return value
+		}
+
+		return placeholder
+	})
+
+	return strings.TrimSpace(rendered)
+}
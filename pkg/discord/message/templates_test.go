@@ -0,0 +1,80 @@
+package message
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethpandaops/panda-pulse/pkg/checks"
+	"github.com/ethpandaops/panda-pulse/pkg/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultTemplates(t *testing.T) {
+	require.NoError(t, DefaultTemplates().Validate())
+}
+
+func TestLoadTemplates(t *testing.T) {
+	write := func(t *testing.T, content string) string {
+		t.Helper()
+
+		path := filepath.Join(t.TempDir(), "templates.json")
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+		return path
+	}
+
+	t.Run("defaults without a file", func(t *testing.T) {
+		templates, err := LoadTemplates("")
+		require.NoError(t, err)
+		assert.Equal(t, DefaultTemplates(), templates)
+	})
+
+	t.Run("overrides are merged with the defaults", func(t *testing.T) {
+		templates, err := LoadTemplates(write(t, `{"breakdown": "See the thread for {client}"}`))
+		require.NoError(t, err)
+		assert.Equal(t, "See the thread for {client}", templates.Breakdown)
+		assert.Equal(t, DefaultTemplates().ActiveIssues, templates.ActiveIssues)
+	})
+
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{name: "unknown key", content: `{"breakdwon": "typo"}`, wantErr: "unknown field"},
+		{name: "unknown placeholder", content: `{"activeIssues": "{total} issues"}`, wantErr: "unknown placeholder {total}"},
+		{name: "empty template", content: `{"sshCommands": " "}`, wantErr: "template sshCommands is empty"},
+		{name: "invalid json", content: `{`, wantErr: "failed to decode templates"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadTemplates(write(t, tt.content))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+
+	t.Run("missing file", func(t *testing.T) {
+		_, err := LoadTemplates(filepath.Join(t.TempDir(), "missing.json"))
+		require.Error(t, err)
+	})
+}
+
+func TestBuildMainMessage_Templates(t *testing.T) {
+	templates := DefaultTemplates()
+	templates.ActiveIssues = "{count} problems for {client} on {network}"
+	templates.Breakdown = "Details below"
+
+	builder := NewAlertMessageBuilder(&Config{
+		Alert:     &store.MonitorAlert{Network: "devnet-0", Client: "lighthouse"},
+		Results:   []*checks.Result{{Name: "CL sync", Status: checks.StatusFail}},
+		Templates: templates,
+	})
+
+	embed := builder.BuildMainMessage().Embed
+	assert.Equal(t, "1 problems for lighthouse on devnet-0", embed.Fields[0].Name)
+	assert.Equal(t, "Details below", embed.Fields[len(embed.Fields)-1].Value)
+}
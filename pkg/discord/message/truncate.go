@@ -0,0 +1,52 @@
+package message
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// MaxEmbedFieldValue is Discord's cap on the characters in an embed field value.
+	MaxEmbedFieldValue = 1024
+
+	truncatedSuffix = "..."
+)
+
+// Truncate shortens a value to at most limit characters, ending it with "..." when it's cut. Discord
+// counts characters rather than bytes, so the value is only ever cut between whole characters.
+func Truncate(value string, limit int) string {
+	if utf8.RuneCountInString(value) <= limit {
+		return value
+	}
+
+	keep := limit - len(truncatedSuffix)
+	if keep <= 0 {
+		return string([]rune(value)[:max(limit, 0)])
+	}
+
+	return string([]rune(value)[:keep]) + truncatedSuffix
+}
+
+// TruncateLines shortens a multi-line value to at most limit characters, dropping whole lines and
+// ending it with a "..." line when it's cut. A first line too long to fit is cut as Truncate does.
+func TruncateLines(value string, limit int) string {
+	if utf8.RuneCountInString(value) <= limit {
+		return value
+	}
+
+	suffix := "\n" + truncatedSuffix
+
+	keep := limit - len(suffix)
+	if keep <= 0 {
+		return Truncate(value, limit)
+	}
+
+	kept := string([]rune(value)[:keep])
+
+	cut := strings.LastIndex(kept, "\n")
+	if cut < 0 {
+		return Truncate(value, limit)
+	}
+
+	return kept[:cut] + suffix
+}
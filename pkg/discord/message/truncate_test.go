@@ -0,0 +1,124 @@
+package message
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		limit    int
+		expected string
+	}{
+		{
+			name:     "short value unchanged",
+			value:    "lighthouse",
+			limit:    10,
+			expected: "lighthouse",
+		},
+		{
+			name:     "ascii cut",
+			value:    "lighthouse-geth-1",
+			limit:    10,
+			expected: "lightho...",
+		},
+		{
+			name:     "multi-byte value within limit unchanged",
+			value:    strings.Repeat("🔥", 10),
+			limit:    10,
+			expected: strings.Repeat("🔥", 10),
+		},
+		{
+			name:     "emoji at the cut",
+			value:    "abcdef🔥🔥🔥🔥",
+			limit:    9,
+			expected: "abcdef...",
+		},
+		{
+			name:     "emoji kept before the cut",
+			value:    "abcde🔥🔥🔥🔥🔥",
+			limit:    9,
+			expected: "abcde🔥...",
+		},
+		{
+			name:     "cjk cut",
+			value:    "节点同步状态检查失败",
+			limit:    7,
+			expected: "节点同步...",
+		},
+		{
+			name:     "limit too small for the ellipsis",
+			value:    "🔥🔥🔥🔥",
+			limit:    2,
+			expected: "🔥🔥",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Truncate(tt.value, tt.limit)
+			assert.Equal(t, tt.expected, got)
+			assert.True(t, utf8.ValidString(got))
+			assert.LessOrEqual(t, utf8.RuneCountInString(got), tt.limit)
+		})
+	}
+}
+
+func TestTruncateEveryBoundary(t *testing.T) {
+	// Mixed widths so every limit lands somewhere different within a character's bytes.
+	value := strings.Repeat("aé节🔥", 50)
+
+	for limit := range utf8.RuneCountInString(value) + 2 {
+		got := Truncate(value, limit)
+		assert.True(t, utf8.ValidString(got), "limit %d", limit)
+		assert.LessOrEqual(t, utf8.RuneCountInString(got), limit, "limit %d", limit)
+	}
+}
+
+func TestTruncateLines(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		limit    int
+		expected string
+	}{
+		{
+			name:     "short value unchanged",
+			value:    "line 1\nline 2",
+			limit:    20,
+			expected: "line 1\nline 2",
+		},
+		{
+			name:     "drops whole lines",
+			value:    "line 1\nline 2\nline 3",
+			limit:    16,
+			expected: "line 1\n...",
+		},
+		{
+			name:     "multi-byte lines",
+			value:    "🔥 one\n🔥 two\n🔥 three",
+			limit:    16,
+			expected: "🔥 one\n🔥 two\n...",
+		},
+		{
+			name:     "first line too long",
+			value:    "🔥🔥🔥🔥🔥🔥🔥🔥\nline 2",
+			limit:    8,
+			expected: "🔥🔥🔥🔥🔥...",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := TruncateLines(tt.value, tt.limit)
+			assert.Equal(t, tt.expected, got)
+			assert.True(t, utf8.ValidString(got))
+			assert.LessOrEqual(t, utf8.RuneCountInString(got), tt.limit)
+		})
+	}
+}
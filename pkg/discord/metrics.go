@@ -7,6 +7,9 @@ type Metrics struct {
 	commandErrors   *prometheus.CounterVec
 	commandDuration *prometheus.HistogramVec
 	lastCommandTS   *prometheus.GaugeVec
+	maintenance     prometheus.Gauge
+	orphanedAlerts  *prometheus.GaugeVec
+	caughtUpJobs    prometheus.Gauge
 }
 
 func NewMetrics(namespace string) *Metrics {
@@ -39,6 +42,27 @@ func NewMetrics(namespace string) *Metrics {
 			Name:      "last_command_timestamp",
 			Help:      "Timestamp of last command execution",
 		}, []string{"command", "subcommand"}),
+
+		maintenance: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "discord",
+			Name:      "maintenance_mode",
+			Help:      "Whether maintenance mode is enabled (1) or not (0)",
+		}),
+
+		orphanedAlerts: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "discord",
+			Name:      "orphaned_alerts",
+			Help:      "Number of enabled alerts whose channel no longer exists or can't be accessed",
+		}, []string{"kind"}),
+
+		caughtUpJobs: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "discord",
+			Name:      "caught_up_jobs",
+			Help:      "Number of scheduled jobs that missed a run and were caught up on startup",
+		}),
 	}
 
 	prometheus.MustRegister(
@@ -46,6 +70,9 @@ func NewMetrics(namespace string) *Metrics {
 		m.commandErrors,
 		m.commandDuration,
 		m.lastCommandTS,
+		m.maintenance,
+		m.orphanedAlerts,
+		m.caughtUpJobs,
 	)
 
 	return m
@@ -70,3 +97,24 @@ func (m *Metrics) ObserveCommandDuration(command, subcommand string, duration fl
 func (m *Metrics) SetLastCommandTimestamp(command, subcommand string, timestamp float64) {
 	m.lastCommandTS.WithLabelValues(command, subcommand).Set(timestamp)
 }
+
+// SetMaintenance records whether maintenance mode is enabled.
+func (m *Metrics) SetMaintenance(enabled bool) {
+	if enabled {
+		m.maintenance.Set(1)
+
+		return
+	}
+
+	m.maintenance.Set(0)
+}
+
+// SetOrphanedAlerts records the number of orphaned alerts found of the given kind.
+func (m *Metrics) SetOrphanedAlerts(kind string, count int) {
+	m.orphanedAlerts.WithLabelValues(kind).Set(float64(count))
+}
+
+// SetCaughtUpJobs records the number of scheduled jobs caught up on startup.
+func (m *Metrics) SetCaughtUpJobs(count int) {
+	m.caughtUpJobs.Set(float64(count))
+}
@@ -20,6 +20,7 @@ import (
 	hive "github.com/ethpandaops/panda-pulse/pkg/hive"
 	queue "github.com/ethpandaops/panda-pulse/pkg/queue"
 	scheduler "github.com/ethpandaops/panda-pulse/pkg/scheduler"
+	slack "github.com/ethpandaops/panda-pulse/pkg/slack"
 	store "github.com/ethpandaops/panda-pulse/pkg/store"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -90,6 +91,20 @@ func (mr *MockBotMockRecorder) GetGrafana() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGrafana", reflect.TypeOf((*MockBot)(nil).GetGrafana))
 }
 
+// GetGuildConfigRepo mocks base method.
+func (m *MockBot) GetGuildConfigRepo() *store.GuildConfigRepo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGuildConfigRepo")
+	ret0, _ := ret[0].(*store.GuildConfigRepo)
+	return ret0
+}
+
+// GetGuildConfigRepo indicates an expected call of GetGuildConfigRepo.
+func (mr *MockBotMockRecorder) GetGuildConfigRepo() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGuildConfigRepo", reflect.TypeOf((*MockBot)(nil).GetGuildConfigRepo))
+}
+
 // GetHive mocks base method.
 func (m *MockBot) GetHive() hive.Hive {
 	m.ctrl.T.Helper()
@@ -118,6 +133,34 @@ func (mr *MockBotMockRecorder) GetHiveSummaryRepo() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHiveSummaryRepo", reflect.TypeOf((*MockBot)(nil).GetHiveSummaryRepo))
 }
 
+// GetIncidentsRepo mocks base method.
+func (m *MockBot) GetIncidentsRepo() *store.IncidentsRepo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIncidentsRepo")
+	ret0, _ := ret[0].(*store.IncidentsRepo)
+	return ret0
+}
+
+// GetIncidentsRepo indicates an expected call of GetIncidentsRepo.
+func (mr *MockBotMockRecorder) GetIncidentsRepo() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIncidentsRepo", reflect.TypeOf((*MockBot)(nil).GetIncidentsRepo))
+}
+
+// GetJobRunsRepo mocks base method.
+func (m *MockBot) GetJobRunsRepo() *store.JobRunsRepo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJobRunsRepo")
+	ret0, _ := ret[0].(*store.JobRunsRepo)
+	return ret0
+}
+
+// GetJobRunsRepo indicates an expected call of GetJobRunsRepo.
+func (mr *MockBotMockRecorder) GetJobRunsRepo() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJobRunsRepo", reflect.TypeOf((*MockBot)(nil).GetJobRunsRepo))
+}
+
 // GetMentionsRepo mocks base method.
 func (m *MockBot) GetMentionsRepo() *store.MentionsRepo {
 	m.ctrl.T.Helper()
@@ -146,6 +189,20 @@ func (mr *MockBotMockRecorder) GetMonitorRepo() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMonitorRepo", reflect.TypeOf((*MockBot)(nil).GetMonitorRepo))
 }
 
+// GetNotificationsRepo mocks base method.
+func (m *MockBot) GetNotificationsRepo() *store.NotificationsRepo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotificationsRepo")
+	ret0, _ := ret[0].(*store.NotificationsRepo)
+	return ret0
+}
+
+// GetNotificationsRepo indicates an expected call of GetNotificationsRepo.
+func (mr *MockBotMockRecorder) GetNotificationsRepo() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotificationsRepo", reflect.TypeOf((*MockBot)(nil).GetNotificationsRepo))
+}
+
 // GetQueues mocks base method.
 func (m *MockBot) GetQueues() []queue.Queuer {
 	m.ctrl.T.Helper()
@@ -174,6 +231,20 @@ func (mr *MockBotMockRecorder) GetRoleConfig() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoleConfig", reflect.TypeOf((*MockBot)(nil).GetRoleConfig))
 }
 
+// GetRoutesRepo mocks base method.
+func (m *MockBot) GetRoutesRepo() *store.RoutesRepo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoutesRepo")
+	ret0, _ := ret[0].(*store.RoutesRepo)
+	return ret0
+}
+
+// GetRoutesRepo indicates an expected call of GetRoutesRepo.
+func (mr *MockBotMockRecorder) GetRoutesRepo() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoutesRepo", reflect.TypeOf((*MockBot)(nil).GetRoutesRepo))
+}
+
 // GetScheduler mocks base method.
 func (m *MockBot) GetScheduler() *scheduler.Scheduler {
 	m.ctrl.T.Helper()
@@ -202,6 +273,62 @@ func (mr *MockBotMockRecorder) GetSession() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockBot)(nil).GetSession))
 }
 
+// GetSlack mocks base method.
+func (m *MockBot) GetSlack() slack.Slack {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSlack")
+	ret0, _ := ret[0].(slack.Slack)
+	return ret0
+}
+
+// GetSlack indicates an expected call of GetSlack.
+func (mr *MockBotMockRecorder) GetSlack() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSlack", reflect.TypeOf((*MockBot)(nil).GetSlack))
+}
+
+// GetThresholdsRepo mocks base method.
+func (m *MockBot) GetThresholdsRepo() *store.ThresholdsRepo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetThresholdsRepo")
+	ret0, _ := ret[0].(*store.ThresholdsRepo)
+	return ret0
+}
+
+// GetThresholdsRepo indicates an expected call of GetThresholdsRepo.
+func (mr *MockBotMockRecorder) GetThresholdsRepo() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetThresholdsRepo", reflect.TypeOf((*MockBot)(nil).GetThresholdsRepo))
+}
+
+// GetVersionsRepo mocks base method.
+func (m *MockBot) GetVersionsRepo() *store.VersionsRepo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVersionsRepo")
+	ret0, _ := ret[0].(*store.VersionsRepo)
+	return ret0
+}
+
+// GetVersionsRepo indicates an expected call of GetVersionsRepo.
+func (mr *MockBotMockRecorder) GetVersionsRepo() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVersionsRepo", reflect.TypeOf((*MockBot)(nil).GetVersionsRepo))
+}
+
+// IsMaintenance mocks base method.
+func (m *MockBot) IsMaintenance() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsMaintenance")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsMaintenance indicates an expected call of IsMaintenance.
+func (mr *MockBotMockRecorder) IsMaintenance() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsMaintenance", reflect.TypeOf((*MockBot)(nil).IsMaintenance))
+}
+
 // SetCommands mocks base method.
 func (m *MockBot) SetCommands(commands []common.Command) {
 	m.ctrl.T.Helper()
@@ -214,6 +341,18 @@ func (mr *MockBotMockRecorder) SetCommands(commands any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCommands", reflect.TypeOf((*MockBot)(nil).SetCommands), commands)
 }
 
+// SetMaintenance mocks base method.
+func (m *MockBot) SetMaintenance(enabled bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetMaintenance", enabled)
+}
+
+// SetMaintenance indicates an expected call of SetMaintenance.
+func (mr *MockBotMockRecorder) SetMaintenance(enabled any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMaintenance", reflect.TypeOf((*MockBot)(nil).SetMaintenance), enabled)
+}
+
 // Start mocks base method.
 func (m *MockBot) Start(ctx context.Context) error {
 	m.ctrl.T.Helper()
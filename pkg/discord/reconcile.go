@@ -0,0 +1,248 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/ethpandaops/panda-pulse/pkg/discord/cmd/common"
+	cmdhive "github.com/ethpandaops/panda-pulse/pkg/discord/cmd/hive"
+	"github.com/ethpandaops/panda-pulse/pkg/hive"
+	"github.com/ethpandaops/panda-pulse/pkg/store"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultOrphanedAlertsSchedule defines when alerts are checked for dead channels (daily at 6am UTC).
+	DefaultOrphanedAlertsSchedule = "0 6 * * *"
+	orphanedAlertsJobName         = "reconcile-orphaned-alerts"
+	orphanedAlertKindChecks       = "checks"
+	orphanedAlertKindHive         = "hive"
+	maxReportLength               = 2000
+	msgOrphanedAlertsHeader       = "🧹 Found **%d** alerts pointing at channels that no longer exist or can't be accessed:"
+	msgOrphanedAlertsDisabled     = "These alerts have been disabled."
+	msgOrphanedAlertsReported     = "These alerts are still enabled, deregister them or set `DISABLE_ORPHANED_ALERTS` to disable them automatically."
+)
+
+// orphanedAlert is an enabled alert whose channel no longer exists or can't be accessed.
+type orphanedAlert struct {
+	monitor *store.MonitorAlert    // Set for health check alerts.
+	hive    *hive.HiveSummaryAlert // Set for Hive summary alerts.
+	reason  string
+}
+
+// kind returns the kind of alert, used as the metric label.
+func (o *orphanedAlert) kind() string {
+	if o.monitor != nil {
+		return orphanedAlertKindChecks
+	}
+
+	return orphanedAlertKindHive
+}
+
+// String describes the orphaned alert for the admin report.
+func (o *orphanedAlert) String() string {
+	if o.monitor != nil {
+		return fmt.Sprintf("- checks **%s** %s → <#%s> (%s)", o.monitor.Network, o.monitor.Client, o.monitor.DiscordChannel, o.reason)
+	}
+
+	target := o.hive.Network
+	if o.hive.Suite != "" {
+		target = fmt.Sprintf("%s (%s)", o.hive.Network, o.hive.Suite)
+	}
+
+	return fmt.Sprintf("- hive **%s** → <#%s> (%s)", target, o.hive.DiscordChannel, o.reason)
+}
+
+// findOrphanedAlerts returns the enabled alerts whose channel lookup fails with a channel problem.
+// Each channel is only looked up once.
+func findOrphanedAlerts(
+	monitorAlerts []*store.MonitorAlert,
+	hiveAlerts []*hive.HiveSummaryAlert,
+	lookup func(channelID string) error,
+) []*orphanedAlert {
+	var (
+		orphans  []*orphanedAlert
+		problems = make(map[string]string)
+	)
+
+	problemFor := func(channelID string) string {
+		if problem, ok := problems[channelID]; ok {
+			return problem
+		}
+
+		problem := common.ChannelProblem(lookup(channelID))
+		problems[channelID] = problem
+
+		return problem
+	}
+
+	for _, alert := range monitorAlerts {
+		if !alert.Enabled {
+			continue
+		}
+
+		if reason := problemFor(alert.DiscordChannel); reason != "" {
+			orphans = append(orphans, &orphanedAlert{monitor: alert, reason: reason})
+		}
+	}
+
+	for _, alert := range hiveAlerts {
+		if !alert.Enabled {
+			continue
+		}
+
+		if reason := problemFor(alert.DiscordChannel); reason != "" {
+			orphans = append(orphans, &orphanedAlert{hive: alert, reason: reason})
+		}
+	}
+
+	return orphans
+}
+
+// formatOrphanedAlerts formats the admin report for orphaned alerts, keeping it within Discord's
+// message length limit.
+func formatOrphanedAlerts(orphans []*orphanedAlert, disabled bool) string {
+	var msg strings.Builder
+
+	footer := msgOrphanedAlertsReported
+	if disabled {
+		footer = msgOrphanedAlertsDisabled
+	}
+
+	fmt.Fprintf(&msg, msgOrphanedAlertsHeader, len(orphans))
+	msg.WriteString("\n")
+
+	for idx, orphan := range orphans {
+		line := orphan.String()
+
+		// Leave room for the footer and a truncation note.
+		if msg.Len()+len(line)+len(footer)+32 > maxReportLength {
+			fmt.Fprintf(&msg, "… and %d more\n", len(orphans)-idx)
+
+			break
+		}
+
+		msg.WriteString(line)
+		msg.WriteString("\n")
+	}
+
+	msg.WriteString(footer)
+
+	return msg.String()
+}
+
+// scheduleOrphanedAlertsReconciliation schedules the periodic check for alerts with dead channels.
+func (b *DiscordBot) scheduleOrphanedAlertsReconciliation() error {
+	schedule := b.config.OrphanedAlertsSchedule
+	if schedule == "" {
+		schedule = DefaultOrphanedAlertsSchedule
+	}
+
+	if err := b.scheduler.AddJob(orphanedAlertsJobName, schedule, b.reconcileOrphanedAlerts); err != nil {
+		return fmt.Errorf("failed to schedule orphaned alerts reconciliation: %w", err)
+	}
+
+	b.log.WithField("schedule", schedule).Info("Scheduled orphaned alerts reconciliation")
+
+	return nil
+}
+
+// reconcileOrphanedAlerts finds enabled alerts pointing at channels that no longer exist or can't be
+// accessed, disables them if configured to, and reports them to the admin channel.
+func (b *DiscordBot) reconcileOrphanedAlerts(ctx context.Context) error {
+	monitorAlerts, err := b.monitorRepo.List(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list alerts: %w", err)
+	}
+
+	hiveAlerts, err := b.hiveSummaryRepo.List(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list Hive summary alerts: %w", err)
+	}
+
+	orphans := findOrphanedAlerts(monitorAlerts, hiveAlerts, func(channelID string) error {
+		_, lookupErr := b.session.Channel(channelID, discordgo.WithContext(ctx))
+
+		return lookupErr
+	})
+
+	counts := map[string]int{orphanedAlertKindChecks: 0, orphanedAlertKindHive: 0}
+	for _, orphan := range orphans {
+		counts[orphan.kind()]++
+	}
+
+	for kind, count := range counts {
+		b.metrics.SetOrphanedAlerts(kind, count)
+	}
+
+	b.log.WithFields(logrus.Fields{
+		"checks": counts[orphanedAlertKindChecks],
+		"hive":   counts[orphanedAlertKindHive],
+	}).Info("Reconciled alert channels")
+
+	if len(orphans) == 0 {
+		return nil
+	}
+
+	// Orphans are left as they are during maintenance, rather than disabled without the report
+	// saying so. They're still orphaned on the next run, so are dealt with once maintenance ends.
+	if b.IsMaintenance() {
+		b.log.WithField("orphans", len(orphans)).Info("Maintenance mode enabled, skipped orphaned alerts report")
+
+		return nil
+	}
+
+	if b.config.DisableOrphanedAlerts {
+		for _, orphan := range orphans {
+			if disableErr := b.disableOrphanedAlert(ctx, orphan); disableErr != nil {
+				b.log.WithError(disableErr).Error("Failed to disable orphaned alert")
+			}
+		}
+	}
+
+	if b.config.AdminChannelID == "" {
+		return nil
+	}
+
+	if _, err := b.session.ChannelMessageSend(
+		b.config.AdminChannelID,
+		formatOrphanedAlerts(orphans, b.config.DisableOrphanedAlerts),
+	); err != nil {
+		return fmt.Errorf("failed to send orphaned alerts report: %w", err)
+	}
+
+	return nil
+}
+
+// disableOrphanedAlert disables an orphaned alert and removes its scheduled job.
+func (b *DiscordBot) disableOrphanedAlert(ctx context.Context, orphan *orphanedAlert) error {
+	now := time.Now()
+
+	if alert := orphan.monitor; alert != nil {
+		alert.Enabled = false
+		alert.UpdatedAt = now
+
+		if err := b.monitorRepo.Persist(ctx, alert); err != nil {
+			return fmt.Errorf("failed to persist alert: %w", err)
+		}
+
+		b.scheduler.RemoveJob(b.monitorRepo.Key(alert))
+
+		return nil
+	}
+
+	alert := orphan.hive
+	alert.Enabled = false
+	alert.UpdatedAt = now
+
+	if err := b.hiveSummaryRepo.Persist(ctx, alert); err != nil {
+		return fmt.Errorf("failed to persist Hive summary alert: %w", err)
+	}
+
+	b.scheduler.RemoveJob(cmdhive.SummaryJobName(alert.Network, alert.Suite))
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package discord
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/ethpandaops/panda-pulse/pkg/hive"
+	"github.com/ethpandaops/panda-pulse/pkg/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// restError builds a discordgo REST error carrying the given Discord error code.
+func restError(code int) error {
+	return &discordgo.RESTError{Message: &discordgo.APIErrorMessage{Code: code}}
+}
+
+func TestFindOrphanedAlerts(t *testing.T) {
+	monitorAlerts := []*store.MonitorAlert{
+		{Network: "devnet-0", Client: "geth", DiscordChannel: "live", Enabled: true},
+		{Network: "devnet-0", Client: "besu", DiscordChannel: "deleted", Enabled: true},
+		{Network: "devnet-0", Client: "reth", DiscordChannel: "deleted", Enabled: false},
+		{Network: "devnet-0", Client: "erigon", DiscordChannel: "flaky", Enabled: true},
+	}
+
+	hiveAlerts := []*hive.HiveSummaryAlert{
+		{Network: "devnet-0", DiscordChannel: "private", Enabled: true},
+		{Network: "devnet-0", Suite: "eest/consume-engine", DiscordChannel: "deleted", Enabled: true},
+	}
+
+	lookups := make(map[string]int)
+
+	orphans := findOrphanedAlerts(monitorAlerts, hiveAlerts, func(channelID string) error {
+		lookups[channelID]++
+
+		switch channelID {
+		case "deleted":
+			return restError(discordgo.ErrCodeUnknownChannel)
+		case "private":
+			return restError(discordgo.ErrCodeMissingAccess)
+		case "flaky":
+			return errors.New("connection reset")
+		default:
+			return nil
+		}
+	})
+
+	require.Len(t, orphans, 3)
+
+	assert.Equal(t, "besu", orphans[0].monitor.Client)
+	assert.Equal(t, "channel deleted", orphans[0].reason)
+	assert.Equal(t, orphanedAlertKindChecks, orphans[0].kind())
+
+	assert.Equal(t, "no access to channel", orphans[1].reason)
+	assert.Equal(t, orphanedAlertKindHive, orphans[1].kind())
+
+	assert.Equal(t, "eest/consume-engine", orphans[2].hive.Suite)
+
+	// Each channel is only looked up once, and disabled alerts aren't looked up at all.
+	for channelID, count := range lookups {
+		assert.Equal(t, 1, count, channelID)
+	}
+}
+
+func TestFormatOrphanedAlerts(t *testing.T) {
+	orphans := []*orphanedAlert{
+		{monitor: &store.MonitorAlert{Network: "devnet-0", Client: "besu", DiscordChannel: "123"}, reason: "channel deleted"},
+		{hive: &hive.HiveSummaryAlert{Network: "devnet-0", Suite: "eest/consume-engine", DiscordChannel: "456"}, reason: "no access to channel"},
+	}
+
+	t.Run("reported", func(t *testing.T) {
+		msg := formatOrphanedAlerts(orphans, false)
+
+		assert.Contains(t, msg, "Found **2** alerts")
+		assert.Contains(t, msg, "- checks **devnet-0** besu → <#123> (channel deleted)")
+		assert.Contains(t, msg, "- hive **devnet-0 (eest/consume-engine)** → <#456> (no access to channel)")
+		assert.True(t, strings.HasSuffix(msg, msgOrphanedAlertsReported))
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		assert.True(t, strings.HasSuffix(formatOrphanedAlerts(orphans, true), msgOrphanedAlertsDisabled))
+	})
+
+	t.Run("truncated to the discord message limit", func(t *testing.T) {
+		many := make([]*orphanedAlert, 0, 100)
+		for i := range 100 {
+			many = append(many, &orphanedAlert{
+				monitor: &store.MonitorAlert{Network: fmt.Sprintf("some-long-devnet-name-%d", i), Client: "nethermind", DiscordChannel: "1234567890"},
+				reason:  "channel deleted",
+			})
+		}
+
+		msg := formatOrphanedAlerts(many, false)
+
+		assert.LessOrEqual(t, len(msg), maxReportLength)
+		assert.Contains(t, msg, "more")
+	})
+}
@@ -0,0 +1,105 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// summaryRetentionSchedule defines when old Hive summary results are pruned (daily at 6:30am UTC).
+	summaryRetentionSchedule = "30 6 * * *"
+	summaryRetentionJobName  = "prune-hive-summary-results"
+	msgSummaryResultsPruned  = "🧹 Pruned **%d** old Hive summary results across %d networks, kept **%d**"
+)
+
+// scheduleSummaryRetention schedules the periodic pruning of old Hive summary results, unless
+// retention is disabled.
+func (b *DiscordBot) scheduleSummaryRetention() error {
+	if !b.config.SummaryRetention.Enabled() {
+		b.log.Info("Hive summary result pruning is disabled, results are kept forever")
+
+		return nil
+	}
+
+	if err := b.scheduler.AddJob(summaryRetentionJobName, summaryRetentionSchedule, b.pruneSummaryResults); err != nil {
+		return fmt.Errorf("failed to schedule Hive summary result pruning: %w", err)
+	}
+
+	b.log.WithFields(logrus.Fields{
+		"schedule": summaryRetentionSchedule,
+		"daily":    b.config.SummaryRetention.Daily,
+		"weekly":   b.config.SummaryRetention.Weekly,
+	}).Warn("Hive summary result pruning is enabled, older results will be deleted")
+
+	return nil
+}
+
+// pruneSummaryResults prunes the stored summary results of every network with a Hive summary
+// registered, reporting how many were pruned to the admin channel.
+func (b *DiscordBot) pruneSummaryResults(ctx context.Context) error {
+	alerts, err := b.hiveSummaryRepo.List(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list Hive summary alerts: %w", err)
+	}
+
+	networks := make([]string, 0)
+
+	for _, alert := range alerts {
+		if !slices.Contains(networks, alert.Network) {
+			networks = append(networks, alert.Network)
+		}
+	}
+
+	// Results are deleted for good, so make the first run since starting stand out.
+	if !b.summaryPruned.Swap(true) {
+		b.log.WithFields(logrus.Fields{
+			"networks": len(networks),
+			"daily":    b.config.SummaryRetention.Daily,
+			"weekly":   b.config.SummaryRetention.Weekly,
+		}).Warn("Pruning Hive summary results for the first time since starting, results past the daily retention are thinned to one a week")
+	}
+
+	var pruned, kept int
+
+	for _, network := range networks {
+		report, pruneErr := b.hiveSummaryRepo.PruneResults(ctx, network, b.config.SummaryRetention)
+		if report != nil {
+			pruned += report.Pruned
+			kept += report.Kept
+		}
+
+		if pruneErr != nil {
+			b.log.WithError(pruneErr).WithField("network", network).Error("Failed to prune Hive summary results")
+
+			continue
+		}
+
+		b.log.WithFields(logrus.Fields{
+			"network": network,
+			"pruned":  report.Pruned,
+			"kept":    report.Kept,
+		}).Debug("Pruned Hive summary results")
+	}
+
+	b.log.WithFields(logrus.Fields{
+		"networks": len(networks),
+		"pruned":   pruned,
+		"kept":     kept,
+	}).Info("Pruned Hive summary results")
+
+	if pruned == 0 || b.config.AdminChannelID == "" {
+		return nil
+	}
+
+	if _, err := b.session.ChannelMessageSend(
+		b.config.AdminChannelID,
+		fmt.Sprintf(msgSummaryResultsPruned, pruned, len(networks), kept),
+	); err != nil {
+		return fmt.Errorf("failed to send Hive summary pruning report: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,146 @@
+package grafana
+
+import (
+	"context"
+	"regexp"
+	"sync"
+	"time"
+)
+
+// DefaultQueryCacheTTL is how long a query's response is shared with identical queries.
+const DefaultQueryCacheTTL = 30 * time.Second
+
+// networkMatcher pulls the network out of a query's label matchers.
+var networkMatcher = regexp.MustCompile(`network=~?"([^"]*)"`)
+
+// cacheKey identifies a query within a time bucket.
+type cacheKey struct {
+	network string
+	query   string
+	bucket  time.Time
+}
+
+// cacheEntry is a query's response, or a query still in flight when done isn't closed yet.
+type cacheEntry struct {
+	done     chan struct{}
+	response *QueryResponse
+	err      error
+}
+
+// CachingClient is a Client sharing responses between identical queries made within the same time
+// bucket, so alerts for different clients on the same network running close together don't each
+// query Grafana for the same data. Identical queries made while one is in flight wait for it rather
+// than querying again. Errors aren't cached. Responses are shared, callers mustn't modify them.
+type CachingClient struct {
+	Client
+
+	ttl     time.Duration
+	metrics *Metrics
+	now     func() time.Time
+
+	mu      sync.Mutex
+	entries map[cacheKey]*cacheEntry
+}
+
+// NewCachingClient creates a new CachingClient, making queries through client and sharing their
+// responses for up to ttl. Metrics are optional.
+func NewCachingClient(client Client, ttl time.Duration, metrics *Metrics) *CachingClient {
+	return &CachingClient{
+		Client:  client,
+		ttl:     ttl,
+		metrics: metrics,
+		now:     time.Now,
+		entries: make(map[cacheKey]*cacheEntry),
+	}
+}
+
+// Query executes a Grafana query, or returns the response to an identical query made within the
+// same time bucket.
+func (c *CachingClient) Query(ctx context.Context, query string) (*QueryResponse, error) {
+	key := cacheKey{
+		network: queryNetwork(query),
+		query:   query,
+		bucket:  c.now().Truncate(c.ttl),
+	}
+
+	c.mu.Lock()
+
+	c.evictLocked(key.bucket)
+
+	if entry, ok := c.entries[key]; ok {
+		c.mu.Unlock()
+		c.recordLookup(key.network, true)
+
+		select {
+		case <-entry.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+
+		// The query we waited on failed, so make our own rather than sharing its error.
+		if entry.err != nil {
+			return c.Client.Query(ctx, query)
+		}
+
+		return entry.response, nil
+	}
+
+	entry := &cacheEntry{done: make(chan struct{})}
+	c.entries[key] = entry
+	c.setEntries(len(c.entries))
+
+	c.mu.Unlock()
+	c.recordLookup(key.network, false)
+
+	entry.response, entry.err = c.Client.Query(ctx, query)
+	close(entry.done)
+
+	if entry.err != nil {
+		c.mu.Lock()
+		if c.entries[key] == entry {
+			delete(c.entries, key)
+			c.setEntries(len(c.entries))
+		}
+		c.mu.Unlock()
+	}
+
+	return entry.response, entry.err
+}
+
+// evictLocked drops entries from earlier time buckets. The caller must hold c.mu.
+func (c *CachingClient) evictLocked(bucket time.Time) {
+	evicted := false
+
+	for key := range c.entries {
+		if key.bucket.Before(bucket) {
+			delete(c.entries, key)
+
+			evicted = true
+		}
+	}
+
+	if evicted {
+		c.setEntries(len(c.entries))
+	}
+}
+
+func (c *CachingClient) recordLookup(network string, hit bool) {
+	if c.metrics != nil {
+		c.metrics.RecordCacheLookup(network, hit)
+	}
+}
+
+func (c *CachingClient) setEntries(count int) {
+	if c.metrics != nil {
+		c.metrics.SetCacheEntries(count)
+	}
+}
+
+// queryNetwork returns the network a query is filtered to, or an empty string if it isn't.
+func queryNetwork(query string) string {
+	if match := networkMatcher.FindStringSubmatch(query); match != nil {
+		return match[1]
+	}
+
+	return ""
+}
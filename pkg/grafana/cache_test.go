@@ -0,0 +1,124 @@
+package grafana
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingClient counts the queries made through it, failing them while err is set.
+type countingClient struct {
+	queries atomic.Int32
+	err     error
+}
+
+func (c *countingClient) Query(_ context.Context, _ string) (*QueryResponse, error) {
+	c.queries.Add(1)
+
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	return &QueryResponse{}, nil
+}
+
+func (c *countingClient) GetBaseURL() string {
+	return "https://grafana.example.com"
+}
+
+func TestCachingClient(t *testing.T) {
+	const (
+		queryHolesky = `up{network=~"holesky"}`
+		queryHoodi   = `up{network=~"hoodi"}`
+	)
+
+	var (
+		ctx = context.Background()
+		now = time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	)
+
+	newClient := func(t *testing.T) (*CachingClient, *countingClient, *Metrics) {
+		t.Helper()
+
+		prometheus.DefaultRegisterer = prometheus.NewRegistry()
+
+		var (
+			upstream = &countingClient{}
+			metrics  = NewMetrics("test")
+			cache    = NewCachingClient(upstream, DefaultQueryCacheTTL, metrics)
+		)
+
+		cache.now = func() time.Time { return now }
+
+		return cache, upstream, metrics
+	}
+
+	t.Run("shares identical queries within a bucket", func(t *testing.T) {
+		cache, upstream, metrics := newClient(t)
+
+		first, err := cache.Query(ctx, queryHolesky)
+		require.NoError(t, err)
+
+		second, err := cache.Query(ctx, queryHolesky)
+		require.NoError(t, err)
+
+		assert.Same(t, first, second)
+		assert.Equal(t, int32(1), upstream.queries.Load())
+		assert.Equal(t, float64(1), testutil.ToFloat64(metrics.cacheLookupsTotal.WithLabelValues("holesky", cacheHit)))
+		assert.Equal(t, float64(1), testutil.ToFloat64(metrics.cacheLookupsTotal.WithLabelValues("holesky", cacheMiss)))
+	})
+
+	t.Run("queries networks separately", func(t *testing.T) {
+		cache, upstream, _ := newClient(t)
+
+		_, err := cache.Query(ctx, queryHolesky)
+		require.NoError(t, err)
+
+		_, err = cache.Query(ctx, queryHoodi)
+		require.NoError(t, err)
+
+		assert.Equal(t, int32(2), upstream.queries.Load())
+	})
+
+	t.Run("queries again in the next bucket", func(t *testing.T) {
+		cache, upstream, metrics := newClient(t)
+
+		_, err := cache.Query(ctx, queryHolesky)
+		require.NoError(t, err)
+
+		cache.now = func() time.Time { return now.Add(DefaultQueryCacheTTL) }
+
+		_, err = cache.Query(ctx, queryHolesky)
+		require.NoError(t, err)
+
+		assert.Equal(t, int32(2), upstream.queries.Load())
+		assert.Equal(t, float64(1), testutil.ToFloat64(metrics.cacheEntries))
+	})
+
+	t.Run("doesn't cache errors", func(t *testing.T) {
+		cache, upstream, _ := newClient(t)
+		upstream.err = assert.AnError
+
+		_, err := cache.Query(ctx, queryHolesky)
+		require.ErrorIs(t, err, assert.AnError)
+
+		upstream.err = nil
+
+		_, err = cache.Query(ctx, queryHolesky)
+		require.NoError(t, err)
+
+		assert.Equal(t, int32(2), upstream.queries.Load())
+	})
+}
+
+func TestQueryNetwork(t *testing.T) {
+	assert.Equal(t, "holesky", queryNetwork(`eth_con_sync_is_syncing{network=~"holesky", consensus_client=~"lighthouse"}`))
+	assert.Equal(t, "hoodi", queryNetwork(`up{network="hoodi"}`))
+	assert.Empty(t, queryNetwork(`up`))
+}
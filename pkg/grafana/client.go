@@ -73,6 +73,8 @@ func (c *client) Query(ctx context.Context, query string) (*QueryResponse, error
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
+	response.Raw = body
+
 	return &response, nil
 }
 
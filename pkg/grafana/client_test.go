@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -91,8 +92,36 @@ func TestQuery(t *testing.T) {
 			if tt.mockStatus == http.StatusOK {
 				expectedResp, ok := tt.mockResponse.(*QueryResponse)
 				require.True(t, ok)
-				assert.Equal(t, expectedResp, resp)
+				assert.Equal(t, expectedResp.Results, resp.Results)
+				assert.JSONEq(t, `{"results":{"pandaPulse":{"frames":[{"schema":{"fields":[{"name":"","type":"","labels":{"instance":"test"}}]},"data":{"values":[1]}}]}}}`, string(resp.Raw))
 			}
 		})
 	}
 }
+
+func TestQueryResponse_LatestTimestamp(t *testing.T) {
+	var response QueryResponse
+
+	// Shaped like a real data frame, a time column followed by a value column.
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"results": {
+			"pandaPulse": {
+				"frames": [
+					{
+						"schema": {"fields": [{"name": "Time", "type": "time"}, {"name": "Value", "type": "number", "labels": {"instance": "a"}}]},
+						"data": {"values": [[1735801200000, 1735801260000], [1, 1]]}
+					},
+					{
+						"schema": {"fields": [{"name": "Time", "type": "time"}, {"name": "Value", "type": "number", "labels": {"instance": "b"}}]},
+						"data": {"values": [[1735801140000], [1]]}
+					}
+				]
+			}
+		}
+	}`), &response))
+
+	assert.Equal(t, time.Date(2025, 1, 2, 7, 1, 0, 0, time.UTC), response.LatestTimestamp())
+
+	assert.True(t, (&QueryResponse{}).LatestTimestamp().IsZero())
+	assert.True(t, (*QueryResponse)(nil).LatestTimestamp().IsZero())
+}
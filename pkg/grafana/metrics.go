@@ -0,0 +1,56 @@
+package grafana
+
+import "github.com/prometheus/client_golang/prometheus"
+
+// Cache lookup results.
+const (
+	cacheHit  = "hit"
+	cacheMiss = "miss"
+)
+
+// Metrics for the Grafana query cache.
+type Metrics struct {
+	cacheLookupsTotal *prometheus.CounterVec
+	cacheEntries      prometheus.Gauge
+}
+
+// NewMetrics creates a new Grafana metrics instance.
+func NewMetrics(namespace string) *Metrics {
+	m := &Metrics{
+		cacheLookupsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "grafana",
+			Name:      "query_cache_lookups_total",
+			Help:      "Total number of Grafana queries looked up in the query cache, by network and whether they were cached",
+		}, []string{"network", "result"}),
+
+		cacheEntries: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "grafana",
+			Name:      "query_cache_entries",
+			Help:      "Current number of Grafana query responses held in the query cache",
+		}),
+	}
+
+	prometheus.MustRegister(
+		m.cacheLookupsTotal,
+		m.cacheEntries,
+	)
+
+	return m
+}
+
+// RecordCacheLookup increments the query cache lookup counter.
+func (m *Metrics) RecordCacheLookup(network string, hit bool) {
+	result := cacheMiss
+	if hit {
+		result = cacheHit
+	}
+
+	m.cacheLookupsTotal.WithLabelValues(network, result).Inc()
+}
+
+// SetCacheEntries sets the number of responses held in the query cache.
+func (m *Metrics) SetCacheEntries(count int) {
+	m.cacheEntries.Set(float64(count))
+}
@@ -0,0 +1,69 @@
+package grafana
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+// RecordedQuery is a query made through a Recorder, alongside what Grafana returned for it.
+type RecordedQuery struct {
+	Query     string          `json:"query"`
+	Timestamp time.Time       `json:"timestamp"`
+	Response  json.RawMessage `json:"response,omitempty"`
+	Error     string          `json:"error,omitempty"`
+}
+
+// Recorder is a Client that records the raw response to every query made through it, so what a
+// check's verdict was based on can be looked at after the fact.
+type Recorder struct {
+	Client
+
+	mu      sync.Mutex
+	queries []RecordedQuery
+}
+
+// NewRecorder creates a new Recorder, making queries through client.
+func NewRecorder(client Client) *Recorder {
+	return &Recorder{
+		Client:  client,
+		queries: make([]RecordedQuery, 0),
+	}
+}
+
+// Query executes a Grafana query, recording its response or error.
+func (r *Recorder) Query(ctx context.Context, query string) (*QueryResponse, error) {
+	recorded := RecordedQuery{
+		Query:     query,
+		Timestamp: time.Now(),
+	}
+
+	response, err := r.Client.Query(ctx, query)
+
+	switch {
+	case err != nil:
+		recorded.Error = err.Error()
+	case response != nil && len(response.Raw) > 0:
+		recorded.Response = response.Raw
+	case response != nil:
+		// Not every client keeps the body, so fall back to what was decoded from it.
+		if encoded, merr := json.Marshal(response); merr == nil {
+			recorded.Response = encoded
+		}
+	}
+
+	r.mu.Lock()
+	r.queries = append(r.queries, recorded)
+	r.mu.Unlock()
+
+	return response, err
+}
+
+// Queries returns the queries recorded so far, in the order they were made.
+func (r *Recorder) Queries() []RecordedQuery {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]RecordedQuery(nil), r.queries...)
+}
@@ -0,0 +1,60 @@
+package grafana
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubClient returns canned responses keyed by query.
+type stubClient struct {
+	responses map[string]*QueryResponse
+}
+
+func (s *stubClient) Query(_ context.Context, query string) (*QueryResponse, error) {
+	if response, ok := s.responses[query]; ok {
+		return response, nil
+	}
+
+	return nil, assert.AnError
+}
+
+func (s *stubClient) GetBaseURL() string {
+	return "https://grafana.example.com"
+}
+
+func TestRecorder(t *testing.T) {
+	recorder := NewRecorder(&stubClient{
+		responses: map[string]*QueryResponse{
+			"up":      {Raw: json.RawMessage(`{"results":{"extra":true}}`)},
+			"decoded": {},
+		},
+	})
+
+	_, err := recorder.Query(context.Background(), "up")
+	require.NoError(t, err)
+
+	_, err = recorder.Query(context.Background(), "decoded")
+	require.NoError(t, err)
+
+	_, err = recorder.Query(context.Background(), "broken")
+	require.ErrorIs(t, err, assert.AnError)
+
+	assert.Equal(t, "https://grafana.example.com", recorder.GetBaseURL())
+
+	queries := recorder.Queries()
+	require.Len(t, queries, 3)
+
+	assert.Equal(t, "up", queries[0].Query)
+	assert.JSONEq(t, `{"results":{"extra":true}}`, string(queries[0].Response))
+	assert.Empty(t, queries[0].Error)
+
+	assert.JSONEq(t, `{"results":{"pandaPulse":{"frames":null}}}`, string(queries[1].Response))
+
+	assert.Equal(t, "broken", queries[2].Query)
+	assert.Empty(t, queries[2].Response)
+	assert.Equal(t, assert.AnError.Error(), queries[2].Error)
+}
@@ -1,14 +1,27 @@
 package grafana
 
+import (
+	"encoding/json"
+	"time"
+)
+
+// fieldTypeTime is the type of the field holding a frame's data point timestamps.
+const fieldTypeTime = "time"
+
 // Config contains the configuration for the Grafana client.
 type Config struct {
 	Token            string
 	PromDatasourceID string
 	BaseURL          string
+	// QueryCacheTTL is how long a query's response is shared with identical queries, defaults to
+	// DefaultQueryCacheTTL. A negative value disables the cache.
+	QueryCacheTTL time.Duration
 }
 
 // QueryField represents a field in the Grafana response.
 type QueryField struct {
+	Name   string            `json:"name"`
+	Type   string            `json:"type"`
 	Labels map[string]string `json:"labels"`
 }
 
@@ -41,6 +54,8 @@ type QueryResults struct {
 // QueryResponse is the response from a Grafana query.
 type QueryResponse struct {
 	Results QueryResults `json:"results"`
+	// Raw is the response body as Grafana returned it, including anything not decoded above.
+	Raw json.RawMessage `json:"-"`
 }
 
 // queryPayload represents the common structure for Grafana queries.
@@ -59,3 +74,40 @@ type query struct {
 	Interval      string         `json:"interval"`
 	LegendFormat  string         `json:"legendFormat,omitempty"`
 }
+
+// LatestTimestamp returns the time of the most recent data point across all frames, or the zero
+// time if the response has no timestamped data.
+func (r *QueryResponse) LatestTimestamp() time.Time {
+	var latest time.Time
+
+	if r == nil {
+		return latest
+	}
+
+	for _, frame := range r.Results.PandaPulse.Frames {
+		for idx, field := range frame.Schema.Fields {
+			if field.Type != fieldTypeTime || idx >= len(frame.Data.Values) {
+				continue
+			}
+
+			// Timestamps are sent as a column of epoch milliseconds.
+			column, ok := frame.Data.Values[idx].([]any)
+			if !ok {
+				continue
+			}
+
+			for _, value := range column {
+				ms, ok := value.(float64)
+				if !ok {
+					continue
+				}
+
+				if ts := time.UnixMilli(int64(ms)).UTC(); ts.After(latest) {
+					latest = ts
+				}
+			}
+		}
+	}
+
+	return latest
+}
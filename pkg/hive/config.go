@@ -4,9 +4,14 @@ import (
 	"fmt"
 )
 
+// DefaultMaxConcurrentSnapshots is how many snapshots are taken at once, each one runs a Chrome process.
+const DefaultMaxConcurrentSnapshots = 3
+
 // Config contains configuration for Hive.
 type Config struct {
-	BaseURL string
+	BaseURL                string
+	MaxConcurrentSnapshots int      // Optional. Defaults to DefaultMaxConcurrentSnapshots.
+	FallbackNetworks       []string // Optional. Hive networks tried, in order, for networks Hive doesn't have.
 }
 
 // DiscoveryEntry represents an entry in the Hive discovery.json response.
@@ -21,6 +26,7 @@ type SnapshotConfig struct {
 	Network       string
 	ConsensusNode string
 	ExecutionNode string
+	Suite         string // Optional: capture the client's box for this suite, rather than the first one on the page
 }
 
 // Validate validates the snapshot configuration.
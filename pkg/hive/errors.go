@@ -0,0 +1,43 @@
+package hive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+)
+
+var (
+	// ErrNetworkNotFound is returned when Hive has no results for the requested network.
+	ErrNetworkNotFound = errors.New("network not found in hive")
+	// ErrTimeout is returned when a request to Hive times out.
+	ErrTimeout = errors.New("hive request timed out")
+	// ErrUnavailable is returned when Hive can't be reached or returns an unexpected response.
+	ErrUnavailable = errors.New("hive unavailable")
+)
+
+// requestError classifies a failed request to Hive as either a timeout or Hive being unavailable.
+func requestError(msg string, err error) error {
+	if isTimeout(err) {
+		return fmt.Errorf("%s: %w: %w", msg, ErrTimeout, err)
+	}
+
+	return fmt.Errorf("%s: %w: %w", msg, ErrUnavailable, err)
+}
+
+// statusError classifies an unexpected HTTP status from a network scoped Hive endpoint.
+func statusError(msg string, statusCode int) error {
+	if statusCode == http.StatusNotFound {
+		return fmt.Errorf("%s: %w", msg, ErrNetworkNotFound)
+	}
+
+	return fmt.Errorf("%s: %w: status code %d", msg, ErrUnavailable, statusCode)
+}
+
+// isTimeout returns true if the error was caused by a deadline being exceeded.
+func isTimeout(err error) bool {
+	var netErr net.Error
+
+	return errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &netErr) && netErr.Timeout())
+}
@@ -0,0 +1,95 @@
+package hive
+
+import (
+	"slices"
+	"strings"
+)
+
+// NormalizeClients lowercases, trims and de-duplicates a client filter, dropping empty names.
+func NormalizeClients(clients []string) []string {
+	normalized := make([]string, 0, len(clients))
+
+	for _, client := range clients {
+		client = strings.ToLower(strings.TrimSpace(client))
+		if client != "" && !slices.Contains(normalized, client) {
+			normalized = append(normalized, client)
+		}
+	}
+
+	return normalized
+}
+
+// FilterSummary returns a copy of the summary reduced to the given clients. Unless fullOverview is
+// set, the overview totals are recomputed from those clients alone, otherwise they still cover every
+// client on the network. An empty filter returns the summary as is.
+func FilterSummary(summary *SummaryResult, clients []string, fullOverview bool) *SummaryResult {
+	if summary == nil || len(clients) == 0 {
+		return summary
+	}
+
+	keep := clientSet(clients)
+
+	filtered := *summary
+	filtered.ClientResults = make(map[string]*ClientSummary, len(clients))
+
+	for name, result := range summary.ClientResults {
+		if keep[strings.ToLower(name)] {
+			filtered.ClientResults[name] = result
+		}
+	}
+
+	if fullOverview {
+		return &filtered
+	}
+
+	filtered.TotalTests, filtered.TotalPasses, filtered.TotalFails, filtered.OverallPassRate = 0, 0, 0, 0
+	filtered.TestTypes = make(map[string]struct{})
+
+	for _, result := range filtered.ClientResults {
+		filtered.TotalTests += result.TotalTests
+		filtered.TotalPasses += result.PassedTests
+		filtered.TotalFails += result.FailedTests
+
+		for _, testType := range result.TestTypes {
+			filtered.TestTypes[testType] = struct{}{}
+		}
+	}
+
+	if filtered.TotalTests > 0 {
+		filtered.OverallPassRate = float64(filtered.TotalPasses) / float64(filtered.TotalTests) * 100
+	}
+
+	return &filtered
+}
+
+// FilterResults returns the results involving any of the given clients, including suite-level
+// results such as consume-sync that ran against several clients at once. An empty filter returns
+// the results as is.
+func FilterResults(results []TestResult, clients []string) []TestResult {
+	if len(clients) == 0 {
+		return results
+	}
+
+	keep := clientSet(clients)
+
+	return slices.DeleteFunc(slices.Clone(results), func(result TestResult) bool {
+		if keep[strings.ToLower(result.Client)] {
+			return false
+		}
+
+		return !slices.ContainsFunc(result.Clients, func(client string) bool {
+			return keep[strings.ToLower(client)]
+		})
+	})
+}
+
+// clientSet returns the normalized clients as a set.
+func clientSet(clients []string) map[string]bool {
+	set := make(map[string]bool, len(clients))
+
+	for _, client := range NormalizeClients(clients) {
+		set[client] = true
+	}
+
+	return set
+}
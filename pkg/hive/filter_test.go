@@ -0,0 +1,95 @@
+package hive
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testSummary() *SummaryResult {
+	return &SummaryResult{
+		Network:         "fusaka-devnet-1",
+		TotalTests:      300,
+		TotalPasses:     270,
+		TotalFails:      30,
+		OverallPassRate: 90,
+		ClientResults: map[string]*ClientSummary{
+			"go-ethereum": {ClientName: "go-ethereum", TotalTests: 100, PassedTests: 100, TestTypes: []string{"engine"}},
+			"besu":        {ClientName: "besu", TotalTests: 100, PassedTests: 80, FailedTests: 20, TestTypes: []string{"engine", "rpc"}},
+			"nethermind":  {ClientName: "nethermind", TotalTests: 100, PassedTests: 90, FailedTests: 10, TestTypes: []string{"sync"}},
+		},
+		TestTypes: map[string]struct{}{"engine": {}, "rpc": {}, "sync": {}},
+	}
+}
+
+func TestNormalizeClients(t *testing.T) {
+	assert.Equal(t, []string{"besu", "go-ethereum"}, NormalizeClients([]string{" Besu", "go-ethereum", "", "besu "}))
+	assert.Empty(t, NormalizeClients(nil))
+}
+
+func TestFilterSummary(t *testing.T) {
+	t.Run("empty filter", func(t *testing.T) {
+		summary := testSummary()
+		assert.Same(t, summary, FilterSummary(summary, nil, false))
+	})
+
+	t.Run("nil summary", func(t *testing.T) {
+		assert.Nil(t, FilterSummary(nil, []string{"besu"}, false))
+	})
+
+	t.Run("filtered totals", func(t *testing.T) {
+		summary := testSummary()
+		filtered := FilterSummary(summary, []string{"Besu", "go-ethereum"}, false)
+
+		require.Len(t, filtered.ClientResults, 2)
+		assert.Contains(t, filtered.ClientResults, "besu")
+		assert.Contains(t, filtered.ClientResults, "go-ethereum")
+		assert.Equal(t, 200, filtered.TotalTests)
+		assert.Equal(t, 180, filtered.TotalPasses)
+		assert.Equal(t, 20, filtered.TotalFails)
+		assert.InDelta(t, 90.0, filtered.OverallPassRate, 0.001)
+		assert.Equal(t, map[string]struct{}{"engine": {}, "rpc": {}}, filtered.TestTypes)
+
+		// The original summary is left untouched, it's what gets stored.
+		assert.Len(t, summary.ClientResults, 3)
+		assert.Equal(t, 300, summary.TotalTests)
+	})
+
+	t.Run("full overview", func(t *testing.T) {
+		filtered := FilterSummary(testSummary(), []string{"nethermind"}, true)
+
+		require.Len(t, filtered.ClientResults, 1)
+		assert.Equal(t, 300, filtered.TotalTests)
+		assert.Equal(t, 30, filtered.TotalFails)
+		assert.Len(t, filtered.TestTypes, 3)
+	})
+
+	t.Run("no matching clients", func(t *testing.T) {
+		filtered := FilterSummary(testSummary(), []string{"reth"}, false)
+
+		assert.Empty(t, filtered.ClientResults)
+		assert.Zero(t, filtered.TotalTests)
+		assert.Zero(t, filtered.OverallPassRate)
+	})
+}
+
+func TestFilterResults(t *testing.T) {
+	results := []TestResult{
+		{Name: "engine", Client: "besu"},
+		{Name: "engine", Client: "go-ethereum"},
+		{Name: eelsConsumeSyncTest, Clients: []string{"go-ethereum", "nethermind"}},
+		{Name: eelsConsumeSyncTest, Clients: []string{"reth"}},
+	}
+
+	assert.Equal(t, results, FilterResults(results, nil))
+
+	filtered := FilterResults(results, []string{"nethermind", "besu"})
+	require.Len(t, filtered, 2)
+	assert.Equal(t, "besu", filtered[0].Client)
+	assert.Equal(t, []string{"go-ethereum", "nethermind"}, filtered[1].Clients)
+
+	// The input isn't modified.
+	assert.Len(t, results, 4)
+	assert.Equal(t, "go-ethereum", results[1].Client)
+}
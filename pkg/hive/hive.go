@@ -4,15 +4,20 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/chromedp/chromedp"
+	"github.com/sirupsen/logrus"
 )
 
 const (
@@ -42,12 +47,20 @@ type Hive interface {
 	FetchAvailableNetworks(ctx context.Context) ([]string, error)
 	// FetchAvailableSuites fetches unique test suite types for a network.
 	FetchAvailableSuites(ctx context.Context, network string) ([]string, error)
+	// FetchFailingTests fetches the failing test cases of a client's latest suite runs for a network.
+	FetchFailingTests(ctx context.Context, network, suite, client string) ([]FailingTest, error)
 }
 
 // hive is a Hive client implementation of Hive.
 type hive struct {
+	log        logrus.FieldLogger
 	baseURL    string
 	httpClient *http.Client
+	snapshots  chan struct{} // Limits how many snapshots are taken at once
+	fallbacks  []string      // Hive networks tried for networks Hive doesn't have
+
+	mu       sync.Mutex
+	resolved map[string]resolvedNetwork // Hive network each network was last found under
 }
 
 // clientNameMap maps our internal client names to Hive's client names, some of them differ slightly.
@@ -63,7 +76,7 @@ var networkNameMap = map[string]string{
 }
 
 // NewHive creates a new Hive client.
-func NewHive(cfg *Config, httpClient *http.Client) Hive {
+func NewHive(log logrus.FieldLogger, cfg *Config, httpClient *http.Client) Hive {
 	// Use provided HTTP client or create a default one
 	if httpClient == nil {
 		httpClient = &http.Client{
@@ -71,14 +84,31 @@ func NewHive(cfg *Config, httpClient *http.Client) Hive {
 		}
 	}
 
+	maxSnapshots := cfg.MaxConcurrentSnapshots
+	if maxSnapshots <= 0 {
+		maxSnapshots = DefaultMaxConcurrentSnapshots
+	}
+
 	return &hive{
+		log:        log.WithField("component", "hive"),
 		baseURL:    cfg.BaseURL,
 		httpClient: httpClient,
+		snapshots:  make(chan struct{}, maxSnapshots),
+		fallbacks:  cfg.FallbackNetworks,
+		resolved:   make(map[string]resolvedNetwork),
 	}
 }
 
-// MapNetworkName maps our fully qualified network name to Hive's simpler network name.
+// MapNetworkName maps our fully qualified network name to Hive's simpler network name, or the
+// fallback Hive was last found to have results under.
 func (h *hive) MapNetworkName(network string) string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if resolved, ok := h.resolved[network]; ok {
+		return resolved.name
+	}
+
 	return mapNetworkName(network)
 }
 
@@ -94,6 +124,14 @@ func (h *hive) Snapshot(ctx context.Context, cfg SnapshotConfig) ([]byte, error)
 		return nil, fmt.Errorf("invalid config: %w", err)
 	}
 
+	// Wait for a free slot, so a burst of snapshots doesn't spawn a Chrome process each.
+	select {
+	case h.snapshots <- struct{}{}:
+		defer func() { <-h.snapshots }()
+	case <-ctx.Done():
+		return nil, fmt.Errorf("cancelled waiting to take snapshot: %w", ctx.Err())
+	}
+
 	// Create browser context with mobile viewport.
 	allocCtx, cancel := chromedp.NewExecAllocator(context.Background(), getDefaultChromeOptions()...)
 	defer cancel()
@@ -114,102 +152,85 @@ func (h *hive) Snapshot(ctx context.Context, cfg SnapshotConfig) ([]byte, error)
 	}
 
 	// Map network name for Hive
-	hiveNetwork := mapNetworkName(cfg.Network)
+	hiveNetwork := h.resolveNetwork(ctx, cfg.Network)
 
 	// Build the URL + build a selector for both boxes (consume-engine and consume-rlp).
 	var (
-		pageURL  = fmt.Sprintf("%s/%s/index.html#summary-sort=name&group-by=client", h.baseURL, hiveNetwork)
-		selector = fmt.Sprintf(`div[data-client="%s_default"][class*="client-box"]`, clientName)
-		buf      []byte
-		exists   bool
+		pageURL        = fmt.Sprintf("%s/%s/index.html#summary-sort=name&group-by=client", h.baseURL, hiveNetwork)
+		selector       = fmt.Sprintf(`div[data-client="%s_default"][class*="client-box"]`, clientName)
+		parentSelector = suiteBoxSelector(clientName, cfg.Suite)
+		buf            []byte
+		exists         bool
 	)
 
-	// First check if the element exists.
+	// First check if the element exists, within the suite's box if there is one.
+	existsScript := fmt.Sprintf(`document.querySelector('%s') !== null`, selector)
+	if cfg.Suite != "" {
+		existsScript = fmt.Sprintf(
+			`document.evaluate(%s, document, null, XPathResult.FIRST_ORDERED_NODE_TYPE, null).singleNodeValue !== null`,
+			strconv.Quote(parentSelector),
+		)
+	}
+
 	if err := chromedp.Run(
 		timeoutCtx,
 		chromedp.Navigate(pageURL),
 		chromedp.WaitVisible(`div[class*="client-box"]`),
 		chromedp.WaitReady("body"),
-		chromedp.Evaluate(fmt.Sprintf(`document.querySelector('%s') !== null`, selector), &exists),
+		chromedp.Evaluate(existsScript, &exists),
 	); err != nil {
-		return nil, fmt.Errorf("failed to check element existence: %w", err)
+		return nil, requestError("failed to check element existence", err)
 	}
 
-	// Not all clients have hive tests, we're done.
+	// Not all clients have hive tests, or run every suite, we're done.
 	if !exists {
 		return nil, nil
 	}
 
-	// Get the parent div that contains both boxes.
-	parentSelector := fmt.Sprintf(
-		`//div[contains(@class, "client-box") and @data-client="%s_default"]/ancestor::div[contains(@class, "suite-box")]`,
-		clientName,
-	)
-
 	if err := chromedp.Run(
 		timeoutCtx,
 		chromedp.WaitVisible(selector),
 		chromedp.Screenshot(parentSelector, &buf, chromedp.NodeVisible, chromedp.BySearch),
 	); err != nil {
-		return nil, fmt.Errorf("failed to capture screenshot: %w", err)
+		return nil, requestError("failed to capture screenshot", err)
 	}
 
 	return buf, nil
 }
 
-// IsAvailable checks if Hive is available for a given network.
-func (h *hive) IsAvailable(ctx context.Context, network string) (bool, error) {
-	if network == "" {
-		return false, fmt.Errorf("network cannot be empty")
-	}
-
-	// Map network name for Hive
-	hiveNetwork := mapNetworkName(network)
-
-	// Create cache-busting timestamp
-	timestamp := time.Now().Unix()
-	discoveryURL := fmt.Sprintf("%s/discovery.json?t=%d", h.baseURL, timestamp)
+// suiteBoxSelector returns the XPath of the box containing both of the client's boxes (consume-engine
+// and consume-rlp). With a suite, only the box titled with that suite matches.
+func suiteBoxSelector(clientName, suite string) string {
+	clientBox := fmt.Sprintf(`div[contains(@class, "client-box") and @data-client="%s_default"]`, clientName)
 
-	req, err := http.NewRequestWithContext(
-		ctx,
-		http.MethodGet,
-		discoveryURL,
-		nil,
-	)
-	if err != nil {
-		return false, fmt.Errorf("failed to create request: %w", err)
+	if suite == "" {
+		return fmt.Sprintf(`//%s/ancestor::div[contains(@class, "suite-box")]`, clientBox)
 	}
 
-	resp, err := h.httpClient.Do(req)
-	if err != nil {
-		// If the request fails, we assume Hive is not available.
-		return false, nil
-	}
-	defer resp.Body.Close()
+	return fmt.Sprintf(
+		`//div[contains(@class, "suite-box") and .//*[normalize-space(text())="%s"] and .//%s]`,
+		suite, clientBox,
+	)
+}
 
-	if resp.StatusCode != http.StatusOK {
-		return false, nil
+// IsAvailable checks if Hive is available for a given network, under its mapping or, failing that,
+// one of its fallbacks.
+func (h *hive) IsAvailable(ctx context.Context, network string) (bool, error) {
+	if network == "" {
+		return false, fmt.Errorf("network cannot be empty")
 	}
 
-	// Parse the discovery response
-	body, err := io.ReadAll(resp.Body)
+	_, found, err := h.lookupNetwork(ctx, network)
 	if err != nil {
-		return false, fmt.Errorf("failed to read response body: %w", err)
-	}
-
-	var discoveries []DiscoveryEntry
-	if err := json.Unmarshal(body, &discoveries); err != nil {
-		return false, fmt.Errorf("failed to parse discovery JSON: %w", err)
-	}
-
-	// Check if the network exists in the discovery list
-	for _, entry := range discoveries {
-		if entry.Name == hiveNetwork {
-			return true, nil
+		// If the request fails, we assume Hive is not available.
+		if errors.Is(err, ErrUnavailable) || errors.Is(err, ErrTimeout) {
+			return false, nil
 		}
+
+		return false, err
 	}
 
-	return false, nil
+	return found, nil
 }
 
 // FetchAvailableNetworks fetches the list of available networks from discovery.json.
@@ -230,23 +251,23 @@ func (h *hive) FetchAvailableNetworks(ctx context.Context) ([]string, error) {
 
 	resp, err := h.httpClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch discovery: %w", err)
+		return nil, requestError("failed to fetch discovery", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("discovery endpoint returned status %d", resp.StatusCode)
+		return nil, fmt.Errorf("discovery endpoint returned status %d: %w", resp.StatusCode, ErrUnavailable)
 	}
 
 	// Parse the discovery response
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+		return nil, requestError("failed to read response body", err)
 	}
 
 	var discoveries []DiscoveryEntry
 	if err := json.Unmarshal(body, &discoveries); err != nil {
-		return nil, fmt.Errorf("failed to parse discovery JSON: %w", err)
+		return nil, requestError("failed to parse discovery JSON", err)
 	}
 
 	// Extract network names
@@ -265,7 +286,7 @@ func (h *hive) FetchAvailableSuites(ctx context.Context, network string) ([]stri
 	}
 
 	// Map network name for Hive
-	hiveNetwork := mapNetworkName(network)
+	hiveNetwork := h.resolveNetwork(ctx, network)
 
 	// Fetch the listing.jsonl file which contains all test results
 	listingURL := fmt.Sprintf("%s/%s/listing.jsonl", h.baseURL, hiveNetwork)
@@ -277,18 +298,18 @@ func (h *hive) FetchAvailableSuites(ctx context.Context, network string) ([]stri
 
 	resp, err := h.httpClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch test results: %w", err)
+		return nil, requestError("failed to fetch test results", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("failed to fetch test results: status code %d", resp.StatusCode)
+		return nil, statusError("failed to fetch test results", resp.StatusCode)
 	}
 
 	// Read and parse the JSONL file
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+		return nil, requestError("failed to read response body", err)
 	}
 
 	// Split by newlines and parse each line as JSON
@@ -317,8 +338,8 @@ func (h *hive) FetchAvailableSuites(ctx context.Context, network string) ([]stri
 		suites = append(suites, suite)
 	}
 
-	// Sort the suites alphabetically for consistent ordering
-	sort.Strings(suites)
+	// Sort the suites naturally for consistent ordering
+	slices.SortFunc(suites, NaturalCompare)
 
 	return suites, nil
 }
@@ -330,7 +351,7 @@ func (h *hive) FetchTestResults(ctx context.Context, network string, suiteFilter
 	}
 
 	// Map network name for Hive
-	hiveNetwork := mapNetworkName(network)
+	hiveNetwork := h.resolveNetwork(ctx, network)
 
 	// Fetch the listing.jsonl file which contains all test results
 	listingURL := fmt.Sprintf("%s/%s/listing.jsonl", h.baseURL, hiveNetwork)
@@ -342,18 +363,18 @@ func (h *hive) FetchTestResults(ctx context.Context, network string, suiteFilter
 
 	resp, err := h.httpClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch test results: %w", err)
+		return nil, requestError("failed to fetch test results", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("failed to fetch test results: status code %d", resp.StatusCode)
+		return nil, statusError("failed to fetch test results", resp.StatusCode)
 	}
 
 	// Read and parse the JSONL file
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+		return nil, requestError("failed to read response body", err)
 	}
 
 	// Split by newlines and parse each line as JSON
@@ -438,6 +459,107 @@ func (h *hive) FetchTestResults(ctx context.Context, network string, suiteFilter
 	return latestResults, nil
 }
 
+// FetchFailingTests fetches the failing test cases of a client's latest suite runs for a network,
+// optionally filtered to a single suite.
+func (h *hive) FetchFailingTests(ctx context.Context, network, suite, client string) ([]FailingTest, error) {
+	if client == "" {
+		return nil, fmt.Errorf("client cannot be empty")
+	}
+
+	results, err := h.FetchTestResults(ctx, network, suite)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		hiveNetwork = h.resolveNetwork(ctx, network)
+		hiveClient  = mapClientName(client)
+		failing     = make([]FailingTest, 0)
+	)
+
+	// Sort the results by suite name so the output is stable.
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Name < results[j].Name
+	})
+
+	for _, result := range results {
+		// Skip results for other clients, passing runs and anything we can't look up.
+		if result.Client != hiveClient || result.Fails == 0 || result.FileName == "" {
+			continue
+		}
+
+		suiteRes, fetchErr := h.fetchSuiteResult(ctx, hiveNetwork, result.FileName)
+		if fetchErr != nil {
+			return nil, fmt.Errorf("failed to fetch suite %s: %w", result.Name, fetchErr)
+		}
+
+		suiteURL := fmt.Sprintf(
+			"%s/%s/suite.html?suiteid=%s&suitename=%s",
+			h.baseURL, hiveNetwork, url.QueryEscape(result.FileName), url.QueryEscape(result.Name),
+		)
+
+		// Test case IDs are numeric strings, order them numerically.
+		ids := make([]string, 0, len(suiteRes.TestCases))
+		for id := range suiteRes.TestCases {
+			ids = append(ids, id)
+		}
+
+		sort.Slice(ids, func(i, j int) bool {
+			a, errA := strconv.Atoi(ids[i])
+			b, errB := strconv.Atoi(ids[j])
+
+			if errA != nil || errB != nil {
+				return ids[i] < ids[j]
+			}
+
+			return a < b
+		})
+
+		for _, id := range ids {
+			testCase := suiteRes.TestCases[id]
+			if testCase.SummaryResult.Pass {
+				continue
+			}
+
+			failing = append(failing, FailingTest{
+				Suite:    result.Name,
+				Name:     testCase.Name,
+				URL:      fmt.Sprintf("%s#test-%s", suiteURL, id),
+				SuiteURL: suiteURL,
+			})
+		}
+	}
+
+	return failing, nil
+}
+
+// fetchSuiteResult fetches and parses a single suite result file.
+func (h *hive) fetchSuiteResult(ctx context.Context, hiveNetwork, fileName string) (*suiteResult, error) {
+	resultURL := fmt.Sprintf("%s/%s/results/%s", h.baseURL, hiveNetwork, fileName)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, resultURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := h.httpClient.Do(req)
+	if err != nil {
+		return nil, requestError("failed to fetch suite result", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch suite result: %w: status code %d", ErrUnavailable, resp.StatusCode)
+	}
+
+	var result suiteResult
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, requestError("failed to parse suite result", err)
+	}
+
+	return &result, nil
+}
+
 // ProcessSummary processes test results into a summary.
 func (h *hive) ProcessSummary(results []TestResult) *SummaryResult {
 	if len(results) == 0 {
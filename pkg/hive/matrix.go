@@ -0,0 +1,72 @@
+package hive
+
+import (
+	"maps"
+	"slices"
+)
+
+// MatrixCell is the outcome of a single test type for a single client.
+type MatrixCell struct {
+	Passes int
+	Fails  int
+}
+
+// Matrix maps each client to the outcome of each test type it ran.
+type Matrix map[string]map[string]MatrixCell
+
+// ProcessMatrix processes test results into a client by test type matrix, using the latest result
+// of each test type for each client. Suite-level consume-sync results aren't attributed to any one
+// client, so they're left out.
+func ProcessMatrix(results []TestResult) Matrix {
+	matrix := make(Matrix)
+
+	for _, result := range filterLatestResults(results) {
+		if isConsumeSyncTest(result.Name) {
+			continue
+		}
+
+		if _, exists := matrix[result.Client]; !exists {
+			matrix[result.Client] = make(map[string]MatrixCell)
+		}
+
+		matrix[result.Client][result.Name] = MatrixCell{
+			Passes: result.Passes,
+			Fails:  result.Fails,
+		}
+	}
+
+	return matrix
+}
+
+// Clients returns the clients in the matrix, sorted.
+func (m Matrix) Clients() []string {
+	return slices.Sorted(maps.Keys(m))
+}
+
+// TestTypes returns every test type any client ran, sorted naturally.
+func (m Matrix) TestTypes() []string {
+	testTypes := make(map[string]struct{})
+
+	for _, cells := range m {
+		for testType := range cells {
+			testTypes[testType] = struct{}{}
+		}
+	}
+
+	return slices.SortedFunc(maps.Keys(testTypes), NaturalCompare)
+}
+
+// FailingCells returns how many client and test type combinations have failures.
+func (m Matrix) FailingCells() int {
+	var failing int
+
+	for _, cells := range m {
+		for _, cell := range cells {
+			if cell.Fails > 0 {
+				failing++
+			}
+		}
+	}
+
+	return failing
+}
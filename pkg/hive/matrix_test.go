@@ -0,0 +1,44 @@
+package hive
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProcessMatrix(t *testing.T) {
+	now := time.Now()
+
+	results := []TestResult{
+		{Name: "engine", Client: "besu", Passes: 90, Fails: 10, Timestamp: now.Add(-time.Hour)},
+		{Name: "engine", Client: "besu", Passes: 100, Fails: 0, Timestamp: now},
+		{Name: "rpc", Client: "besu", Passes: 40, Fails: 5, Timestamp: now},
+		{Name: "sync", Client: "go-ethereum", Passes: 1, Fails: 0, Timestamp: now},
+		{Name: eelsConsumeSyncTest, Client: "go-ethereum", Clients: []string{"go-ethereum", "besu"}, Passes: 3, Fails: 1, Timestamp: now},
+	}
+
+	matrix := ProcessMatrix(results)
+
+	assert.Equal(t, Matrix{
+		"besu": {
+			"engine": {Passes: 100, Fails: 0},
+			"rpc":    {Passes: 40, Fails: 5},
+		},
+		"go-ethereum": {
+			"sync": {Passes: 1, Fails: 0},
+		},
+	}, matrix)
+	assert.Equal(t, []string{"besu", "go-ethereum"}, matrix.Clients())
+	assert.Equal(t, []string{"engine", "rpc", "sync"}, matrix.TestTypes())
+	assert.Equal(t, 1, matrix.FailingCells())
+}
+
+func TestProcessMatrix_Empty(t *testing.T) {
+	matrix := ProcessMatrix(nil)
+
+	assert.Empty(t, matrix)
+	assert.Empty(t, matrix.Clients())
+	assert.Empty(t, matrix.TestTypes())
+	assert.Zero(t, matrix.FailingCells())
+}
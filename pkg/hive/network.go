@@ -0,0 +1,102 @@
+package hive
+
+import (
+	"context"
+	"regexp"
+	"slices"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// networkResolveTTL is how long a network's resolved Hive network is reused before Hive's networks
+// are checked again, so a devnet moves onto its own results once Hive has them.
+const networkResolveTTL = 10 * time.Minute
+
+// devnetSuffix matches a devnet's numbered suffix, eg the "-devnet-3" of "fusaka-devnet-3".
+var devnetSuffix = regexp.MustCompile(`^(.+)-devnet-\d+$`)
+
+// resolvedNetwork is the Hive network a network was found under, and when.
+type resolvedNetwork struct {
+	name string
+	at   time.Time
+}
+
+// networkCandidates returns the Hive networks to try for a network, in order: its mapping, or the
+// network itself if it has none, the network without its devnet suffix, then the fallbacks.
+func networkCandidates(network string, fallbacks []string) []string {
+	var (
+		candidates = []string{mapNetworkName(network)}
+		others     = slices.Clone(fallbacks)
+	)
+
+	if match := devnetSuffix.FindStringSubmatch(network); match != nil {
+		others = slices.Insert(others, 0, match[1])
+	}
+
+	for _, candidate := range others {
+		if candidate != "" && !slices.Contains(candidates, candidate) {
+			candidates = append(candidates, candidate)
+		}
+	}
+
+	return candidates
+}
+
+// lookupNetwork finds the first of the network's candidates Hive has, recording it for the network.
+// Returns false if Hive has none of them.
+func (h *hive) lookupNetwork(ctx context.Context, network string) (string, bool, error) {
+	available, err := h.FetchAvailableNetworks(ctx)
+	if err != nil {
+		return "", false, err
+	}
+
+	candidates := networkCandidates(network, h.fallbacks)
+
+	idx := slices.IndexFunc(candidates, func(candidate string) bool {
+		return slices.Contains(available, candidate)
+	})
+	if idx < 0 {
+		return "", false, nil
+	}
+
+	h.mu.Lock()
+	previous := h.resolved[network]
+	h.resolved[network] = resolvedNetwork{name: candidates[idx], at: time.Now()}
+	h.mu.Unlock()
+
+	// Only log falling back, and only when it changes, as networks are looked up on every check run.
+	if idx > 0 && previous.name != candidates[idx] {
+		h.log.WithFields(logrus.Fields{
+			"network":     network,
+			"hiveNetwork": candidates[idx],
+			"tried":       candidates[:idx],
+		}).Info("Hive doesn't have the network, using a fallback")
+	}
+
+	return candidates[idx], true, nil
+}
+
+// resolveNetwork returns the Hive network for a network, reusing a recent lookup. If Hive has none
+// of its candidates, or can't be reached, the network's mapping is used as is.
+func (h *hive) resolveNetwork(ctx context.Context, network string) string {
+	h.mu.Lock()
+	cached, ok := h.resolved[network]
+	h.mu.Unlock()
+
+	if ok && time.Since(cached.at) < networkResolveTTL {
+		return cached.name
+	}
+
+	// Hive being unreachable surfaces in the request made with the network, so isn't reported here.
+	resolved, found, _ := h.lookupNetwork(ctx, network)
+
+	switch {
+	case found:
+		return resolved
+	case ok:
+		return cached.name
+	default:
+		return mapNetworkName(network)
+	}
+}
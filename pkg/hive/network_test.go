@@ -0,0 +1,70 @@
+package hive
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNetworkCandidates(t *testing.T) {
+	assert.Equal(t, []string{"fusaka-devnet-3", "fusaka", "mainnet"}, networkCandidates("fusaka-devnet-3", []string{"mainnet", "fusaka"}))
+	assert.Equal(t, []string{"pectra"}, networkCandidates("pectra-devnet-6", []string{"pectra"}))
+	assert.Equal(t, []string{"hoodi"}, networkCandidates("hoodi", nil))
+}
+
+func TestResolveNetwork(t *testing.T) {
+	var requests atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		_, _ = w.Write([]byte(`[{"name": "fusaka"}, {"name": "hoodi"}]`))
+	}))
+	defer server.Close()
+
+	h, ok := NewHive(logrus.New(), &Config{BaseURL: server.URL, FallbackNetworks: []string{"mainnet"}}, nil).(*hive)
+	require.True(t, ok)
+
+	ctx := context.Background()
+
+	t.Run("direct", func(t *testing.T) {
+		available, err := h.IsAvailable(ctx, "hoodi")
+		require.NoError(t, err)
+		assert.True(t, available)
+		assert.Equal(t, "hoodi", h.MapNetworkName("hoodi"))
+	})
+
+	t.Run("devnet suffix stripped", func(t *testing.T) {
+		assert.Equal(t, "fusaka-devnet-9", h.MapNetworkName("fusaka-devnet-9"))
+
+		available, err := h.IsAvailable(ctx, "fusaka-devnet-9")
+		require.NoError(t, err)
+		assert.True(t, available)
+		assert.Equal(t, "fusaka", h.MapNetworkName("fusaka-devnet-9"))
+
+		// The lookup is reused rather than fetching discovery again.
+		before := requests.Load()
+		assert.Equal(t, "fusaka", h.resolveNetwork(ctx, "fusaka-devnet-9"))
+		assert.Equal(t, before, requests.Load())
+	})
+
+	t.Run("no candidate", func(t *testing.T) {
+		available, err := h.IsAvailable(ctx, "holesky")
+		require.NoError(t, err)
+		assert.False(t, available)
+		assert.Equal(t, "holesky", h.resolveNetwork(ctx, "holesky"))
+	})
+
+	t.Run("hive unavailable", func(t *testing.T) {
+		down := NewHive(logrus.New(), &Config{BaseURL: "http://127.0.0.1:1"}, nil)
+
+		available, err := down.IsAvailable(ctx, "hoodi")
+		require.NoError(t, err)
+		assert.False(t, available)
+	})
+}
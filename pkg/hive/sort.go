@@ -0,0 +1,62 @@
+package hive
+
+import (
+	"strings"
+)
+
+// NaturalCompare compares two strings treating runs of digits as numbers, so "eest-2" sorts before
+// "eest-10". Numbers equal in value but padded differently fall back to comparing lexically, keeping
+// the order total. It returns -1, 0 or +1, like strings.Compare.
+func NaturalCompare(a, b string) int {
+	for a != "" && b != "" {
+		if !isDigit(a[0]) || !isDigit(b[0]) {
+			if a[0] != b[0] {
+				return strings.Compare(a[:1], b[:1])
+			}
+
+			a, b = a[1:], b[1:]
+
+			continue
+		}
+
+		var numA, numB string
+
+		numA, a = splitDigits(a)
+		numB, b = splitDigits(b)
+
+		// Leading zeros don't change a number's value, so compare without them.
+		trimmedA, trimmedB := strings.TrimLeft(numA, "0"), strings.TrimLeft(numB, "0")
+
+		if len(trimmedA) != len(trimmedB) {
+			if len(trimmedA) < len(trimmedB) {
+				return -1
+			}
+
+			return 1
+		}
+
+		if c := strings.Compare(trimmedA, trimmedB); c != 0 {
+			return c
+		}
+
+		if c := strings.Compare(numA, numB); c != 0 {
+			return c
+		}
+	}
+
+	return strings.Compare(a, b)
+}
+
+// splitDigits splits the leading run of digits off s.
+func splitDigits(s string) (digits, rest string) {
+	end := 0
+	for end < len(s) && isDigit(s[end]) {
+		end++
+	}
+
+	return s[:end], s[end:]
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
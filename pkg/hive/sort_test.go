@@ -0,0 +1,41 @@
+package hive
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNaturalCompare(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        string
+		b        string
+		expected int
+	}{
+		{name: "equal", a: "eest-2", b: "eest-2", expected: 0},
+		{name: "numeric not lexical", a: "eest-2", b: "eest-10", expected: -1},
+		{name: "numeric greater", a: "eest-10", b: "eest-9", expected: 1},
+		{name: "several numbers", a: "eest/v1.10", b: "eest/v1.9", expected: 1},
+		{name: "text before numbers", a: "engine-2", b: "rpc-1", expected: -1},
+		{name: "prefix first", a: "eest", b: "eest-1", expected: -1},
+		{name: "padded equal value", a: "eest-02", b: "eest-2", expected: -1},
+		{name: "no numbers", a: "engine", b: "sync", expected: -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, NaturalCompare(tt.a, tt.b))
+			assert.Equal(t, -tt.expected, NaturalCompare(tt.b, tt.a))
+		})
+	}
+}
+
+func TestNaturalCompareSort(t *testing.T) {
+	testTypes := []string{"eest-10", "rpc", "eest-2", "eest-1", "engine", "eest-20"}
+
+	slices.SortFunc(testTypes, NaturalCompare)
+
+	assert.Equal(t, []string{"eest-1", "eest-2", "eest-10", "eest-20", "engine", "rpc"}, testTypes)
+}
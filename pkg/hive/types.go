@@ -44,12 +44,50 @@ type SummaryResult struct {
 
 // HiveSummaryAlert represents a Hive summary alert configuration.
 type HiveSummaryAlert struct {
+	SchemaVersion  int       `json:"schemaVersion"`
 	Network        string    `json:"network"`
-	Suite          string    `json:"suite,omitempty"` // Optional suite filter - empty means all suites
+	Suite          string    `json:"suite,omitempty"`        // Optional suite filter - empty means all suites
+	Clients        []string  `json:"clients,omitempty"`      // Optional client filter - empty means all clients
+	FullOverview   bool      `json:"fullOverview,omitempty"` // Keep overview totals for all clients when filtering
 	DiscordChannel string    `json:"discordChannel"`
 	DiscordGuildID string    `json:"discordGuildId"`
 	Enabled        bool      `json:"enabled"`
 	Schedule       string    `json:"schedule"`
 	CreatedAt      time.Time `json:"createdAt"`
 	UpdatedAt      time.Time `json:"updatedAt"`
+
+	// RegressionsOnly only posts clients that newly regressed since the previous summary, in place
+	// of the full summary, staying silent otherwise.
+	RegressionsOnly bool `json:"regressionsOnly,omitempty"`
+	// ReportedRegressions are the regressions already posted for a regressions only alert, keyed by
+	// client, so a standing regression isn't posted again.
+	ReportedRegressions map[string]ReportedRegression `json:"reportedRegressions,omitempty"`
+}
+
+// ReportedRegression is a client's regression posted by a regressions only alert.
+type ReportedRegression struct {
+	From int `json:"from"` // Failures before the client regressed.
+	To   int `json:"to"`   // Failures when the regression was last posted.
+}
+
+// FailingTest represents a single failing test case from a Hive suite run.
+type FailingTest struct {
+	Suite    string // Name of the suite the test belongs to.
+	Name     string // Name of the test case.
+	URL      string // Link to the test case in the Hive UI.
+	SuiteURL string // Link to the full suite run in the Hive UI.
+}
+
+// suiteResult represents the subset of a Hive suite result file we care about.
+type suiteResult struct {
+	Name      string                   `json:"name"`
+	TestCases map[string]suiteTestCase `json:"testCases"`
+}
+
+// suiteTestCase represents a single test case within a Hive suite result file.
+type suiteTestCase struct {
+	Name          string `json:"name"`
+	SummaryResult struct {
+		Pass bool `json:"pass"`
+	} `json:"summaryResult"`
 }
@@ -0,0 +1,113 @@
+package hive
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// versionCore matches the major.minor[.patch] core of a version string.
+var versionCore = regexp.MustCompile(`(\d+)\.(\d+)(?:\.(\d+))?`)
+
+// CleanVersion cleans up the version strings clients report to make them more readable.
+func CleanVersion(version string) string {
+	if version == "" || version == "unknown" {
+		return ""
+	}
+
+	// Generic pattern: client/version/platform
+	// Examples:
+	// - Geth/v1.15.0-unstable-7f0dd394-20250204/linux-amd64/...
+	// - besu/v25.3-develop-083b1d3/linux-x86_64/openjdk-java...
+	// - nimbus-eth1/v0.1.0-45767278/linux-amd64/Nim-2.0.14...
+	if strings.Contains(version, "/") {
+		parts := strings.Split(version, "/")
+		if len(parts) >= 2 {
+			// Check if the second part looks like a version (starts with v or has digits)
+			if strings.HasPrefix(parts[1], "v") || containsDigit(parts[1]) {
+				return parts[1] // Return the version part
+			}
+		}
+	}
+
+	// Handle colon-separated formats
+	// Examples:
+	// - reth Version: 1.2.2
+	// - geth Version: 1.22
+	// - version: 1.09
+	// - Platform: Linux x64
+	if strings.Contains(version, ":") {
+		parts := strings.Split(version, ":")
+		if len(parts) >= 2 {
+			return strings.TrimSpace(parts[1]) // Return whatever is after the colon
+		}
+	}
+
+	// Limit length, without cutting a multi-byte character in half.
+	maxLen := 30
+	if runes := []rune(version); len(runes) > maxLen {
+		version = string(runes[:maxLen]) + "..."
+	}
+
+	return strings.TrimSpace(version)
+}
+
+// CompareVersions compares the major.minor.patch cores of two version strings, as reported by
+// clients, returning -1, 0 or 1 if a is older than, the same as or newer than b. Pre-release and
+// build suffixes are ignored, as devnets mostly run unstable builds. ok is false if either version
+// can't be parsed.
+func CompareVersions(a, b string) (result int, ok bool) {
+	av, aok := parseVersionCore(a)
+	bv, bok := parseVersionCore(b)
+
+	if !aok || !bok {
+		return 0, false
+	}
+
+	for i := range av {
+		switch {
+		case av[i] < bv[i]:
+			return -1, true
+		case av[i] > bv[i]:
+			return 1, true
+		}
+	}
+
+	return 0, true
+}
+
+// parseVersionCore extracts the major, minor and patch numbers from a version string.
+func parseVersionCore(version string) ([3]int, bool) {
+	var core [3]int
+
+	match := versionCore.FindStringSubmatch(CleanVersion(version))
+	if match == nil {
+		return core, false
+	}
+
+	for i, part := range match[1:] {
+		if part == "" {
+			continue
+		}
+
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return core, false
+		}
+
+		core[i] = n
+	}
+
+	return core, true
+}
+
+// containsDigit checks if a string contains at least one digit.
+func containsDigit(s string) bool {
+	for _, c := range s {
+		if c >= '0' && c <= '9' {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,55 @@
+package hive
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCleanVersion(t *testing.T) {
+	tests := []struct {
+		version  string
+		expected string
+	}{
+		{version: "", expected: ""},
+		{version: "unknown", expected: ""},
+		{version: "Geth/v1.15.0-unstable-7f0dd394-20250204/linux-amd64/go1.23.5", expected: "v1.15.0-unstable-7f0dd394-20250204"},
+		{version: "nimbus-eth1/v0.1.0-45767278/linux-amd64/Nim-2.0.14", expected: "v0.1.0-45767278"},
+		{version: "reth Version: 1.2.2", expected: "1.2.2"},
+		{version: "1.30.3", expected: "1.30.3"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			assert.Equal(t, tt.expected, CleanVersion(tt.version))
+		})
+	}
+}
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        string
+		b        string
+		expected int
+		ok       bool
+	}{
+		{name: "older patch", a: "v1.15.0", b: "v1.15.1", expected: -1, ok: true},
+		{name: "newer minor", a: "1.16.0", b: "1.15.9", expected: 1, ok: true},
+		{name: "numeric not lexical", a: "1.10.0", b: "1.9.0", expected: 1, ok: true},
+		{name: "missing patch", a: "besu/v25.3-develop-083b1d3/linux-x86_64", b: "25.3.0", expected: 0, ok: true},
+		{name: "suffixes ignored", a: "Geth/v1.15.0-unstable-7f0dd394/linux-amd64", b: "Geth/v1.15.0-stable/linux-amd64", expected: 0, ok: true},
+		{name: "colon format downgrade", a: "reth Version: 1.2.2", b: "reth Version: 1.3.0", expected: -1, ok: true},
+		{name: "client name digits ignored", a: "nimbus-eth1/v0.1.0-45767278/linux-amd64", b: "nimbus-eth1/v0.2.0-12345678/linux-amd64", expected: -1, ok: true},
+		{name: "unknown", a: "unknown", b: "1.0.0", ok: false},
+		{name: "unparseable", a: "develop", b: "1.0.0", ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, ok := CompareVersions(tt.a, tt.b)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -6,6 +6,7 @@ type Metrics struct {
 	queuedTotal    *prometheus.CounterVec
 	processedTotal *prometheus.CounterVec
 	failuresTotal  *prometheus.CounterVec
+	timeoutsTotal  *prometheus.CounterVec
 	queueLength    prometheus.Gauge
 	processingTime *prometheus.HistogramVec
 	skipsDueToLock *prometheus.CounterVec
@@ -34,6 +35,13 @@ func NewMetrics(namespace string) *Metrics {
 			Help:      "Total number of check failures",
 		}, []string{"network", "client", "error_type"}),
 
+		timeoutsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "queue",
+			Name:      "checks_timeouts_total",
+			Help:      "Total number of checks that exceeded their run timeout",
+		}, []string{"network", "client"}),
+
 		queueLength: prometheus.NewGauge(prometheus.GaugeOpts{
 			Namespace: namespace,
 			Subsystem: "queue",
@@ -61,6 +69,7 @@ func NewMetrics(namespace string) *Metrics {
 		m.queuedTotal,
 		m.processedTotal,
 		m.failuresTotal,
+		m.timeoutsTotal,
 		m.queueLength,
 		m.processingTime,
 		m.skipsDueToLock,
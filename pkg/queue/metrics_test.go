@@ -39,6 +39,10 @@ test_queue_length_current 0
 		m.failuresTotal.WithLabelValues("testnet", "client1", "worker_error").Inc()
 		assert.Equal(t, float64(1), testutil.ToFloat64(m.failuresTotal.WithLabelValues("testnet", "client1", "worker_error")))
 
+		// Test timeoutsTotal
+		m.timeoutsTotal.WithLabelValues("testnet", "client1").Inc()
+		assert.Equal(t, float64(1), testutil.ToFloat64(m.timeoutsTotal.WithLabelValues("testnet", "client1")))
+
 		// Test skipsDueToLock
 		m.skipsDueToLock.WithLabelValues("testnet", "client1").Inc()
 		assert.Equal(t, float64(1), testutil.ToFloat64(m.skipsDueToLock.WithLabelValues("testnet", "client1")))
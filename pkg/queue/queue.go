@@ -2,6 +2,7 @@ package queue
 
 import (
 	"context"
+	"errors"
 	"sync"
 	"time"
 
@@ -96,7 +97,15 @@ func (q *Queue[T]) processQueue(ctx context.Context) {
 			q.metrics.processingTime.WithLabelValues(q.getItemNetwork(item), q.getItemClient(item)).Observe(duration)
 
 			if err != nil {
-				q.metrics.failuresTotal.WithLabelValues(q.getItemNetwork(item), q.getItemClient(item), "worker_error").Inc()
+				errType := "worker_error"
+
+				if errors.Is(err, context.DeadlineExceeded) {
+					errType = "timeout"
+
+					q.metrics.timeoutsTotal.WithLabelValues(q.getItemNetwork(item), q.getItemClient(item)).Inc()
+				}
+
+				q.metrics.failuresTotal.WithLabelValues(q.getItemNetwork(item), q.getItemClient(item), errType).Inc()
 				q.log.WithError(err).Error("Failed to process item")
 			}
 
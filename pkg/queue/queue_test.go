@@ -2,12 +2,14 @@ package queue
 
 import (
 	"context"
+	"fmt"
 	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/ethpandaops/panda-pulse/pkg/store"
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 )
@@ -92,6 +94,23 @@ func TestQueue(t *testing.T) {
 		time.Sleep(3 * time.Second)
 		assert.Equal(t, int32(0), atomic.LoadInt32(&processed))
 	})
+
+	t.Run("records worker timeouts", func(t *testing.T) {
+		setupTest(t)
+		worker := func(ctx context.Context, alert *store.MonitorAlert) (bool, error) {
+			return false, fmt.Errorf("failed to run checks: %w", context.DeadlineExceeded)
+		}
+
+		m := NewMetrics("test")
+		q := NewQueue[*store.MonitorAlert](logrus.New(), worker, m)
+		q.Start(t.Context())
+
+		q.Enqueue(&store.MonitorAlert{Network: "net1", Client: "client1"})
+		time.Sleep(500 * time.Millisecond)
+
+		assert.Equal(t, float64(1), testutil.ToFloat64(m.timeoutsTotal.WithLabelValues("net1", "client1")))
+		assert.Equal(t, float64(1), testutil.ToFloat64(m.failuresTotal.WithLabelValues("net1", "client1", "timeout")))
+	})
 }
 
 func TestGetAlertKey(t *testing.T) {
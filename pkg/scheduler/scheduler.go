@@ -3,13 +3,18 @@ package scheduler
 import (
 	"context"
 	"fmt"
+	"hash/fnv"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/robfig/cron/v3"
 	"github.com/sirupsen/logrus"
 )
 
+// JobFunc is the function a job runs.
+type JobFunc func(context.Context) error
+
 type Job struct {
 	Name     string
 	Schedule string
@@ -33,44 +38,121 @@ func NewScheduler(log *logrus.Logger, metrics *Metrics) *Scheduler {
 	}
 }
 
-func (s *Scheduler) AddJob(name, schedule string, run func(context.Context) error) error {
+func (s *Scheduler) AddJob(name, schedule string, run JobFunc) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	if id, exists := s.jobs[name]; exists {
-		s.cron.Remove(id)
-		s.metrics.activeJobs.Dec()
+	s.removeLocked(name)
+
+	id, err := s.cron.AddFunc(schedule, s.execute(name, schedule, run))
+	if err != nil {
+		return fmt.Errorf("failed to add job %s: %w", name, err)
 	}
 
-	id, err := s.cron.AddFunc(schedule, func() {
-		ctx := context.Background()
-		start := time.Now()
+	s.jobs[name] = id
+	s.metrics.jobsTotal.WithLabelValues(schedule).Inc()
+	s.metrics.activeJobs.Inc()
 
-		s.metrics.jobExecutions.WithLabelValues(name, schedule).Inc()
-		s.metrics.lastExecutionTS.WithLabelValues(name, schedule).Set(float64(time.Now().Unix()))
+	return nil
+}
 
-		if err := run(ctx); err != nil {
-			s.metrics.jobFailures.WithLabelValues(name, schedule).Inc()
-			s.log.Errorf("job %s failed: %v", name, err)
-		}
+// AddSpreadJob adds a recurring job like AddJob, but delays its first run by an offset within the
+// spread, so jobs added together on the same schedule don't all fire at once. The offset is derived
+// from the job's name, so it stays the same across restarts. Later runs are on the schedule itself.
+func (s *Scheduler) AddSpreadJob(name, schedule string, spread time.Duration, run JobFunc) error {
+	if spread <= 0 {
+		return s.AddJob(name, schedule, run)
+	}
 
-		s.metrics.executionTime.WithLabelValues(name).Observe(time.Since(start).Seconds())
-	})
+	sched, err := cron.ParseStandard(schedule)
 	if err != nil {
 		return fmt.Errorf("failed to add job %s: %w", name, err)
 	}
 
-	s.jobs[name] = id
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.removeLocked(name)
+
+	s.jobs[name] = s.cron.Schedule(
+		&offsetFirstSchedule{schedule: sched, offset: startupOffset(name, spread)},
+		cron.FuncJob(s.execute(name, schedule, run)),
+	)
 	s.metrics.jobsTotal.WithLabelValues(schedule).Inc()
 	s.metrics.activeJobs.Inc()
 
 	return nil
 }
 
+// AddOneShot adds a job that runs once at the given time, or as soon as the scheduler is running
+// if that time has passed, and is then removed. It shares names with recurring jobs, so adding a
+// job with the same name replaces it and RemoveJob cancels it before it fires.
+func (s *Scheduler) AddOneShot(name string, at time.Time, run JobFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.removeLocked(name)
+
+	var (
+		id      cron.EntryID
+		execute = s.execute(name, oneShotSchedule, run)
+	)
+
+	id = s.cron.Schedule(&onceSchedule{at: at}, cron.FuncJob(func() {
+		// Stop tracking the job as it fires, unless it has already been replaced or removed.
+		s.mu.Lock()
+		if s.jobs[name] == id {
+			s.removeLocked(name)
+		}
+		s.mu.Unlock()
+
+		execute()
+	}))
+
+	s.jobs[name] = id
+	s.metrics.jobsTotal.WithLabelValues(oneShotSchedule).Inc()
+	s.metrics.activeJobs.Inc()
+}
+
 func (s *Scheduler) RemoveJob(name string) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
+	s.removeLocked(name)
+}
+
+// HasJob reports whether a job with the given name is scheduled. One-shot jobs stop being
+// scheduled once they fire.
+func (s *Scheduler) HasJob(name string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, exists := s.jobs[name]
+
+	return exists
+}
+
+// NextRun returns when the job with the given name next runs, as the running scheduler has it.
+func (s *Scheduler) NextRun(name string) (time.Time, error) {
+	s.mu.Lock()
+	id, exists := s.jobs[name]
+	s.mu.Unlock()
+
+	if !exists {
+		return time.Time{}, fmt.Errorf("job %s is not scheduled", name)
+	}
+
+	// Cron only works out the next run once it's running, and no longer has one for fired one-shots.
+	next := s.cron.Entry(id).Next
+	if next.IsZero() {
+		return time.Time{}, fmt.Errorf("job %s has no upcoming run", name)
+	}
+
+	return next, nil
+}
+
+// removeLocked removes the job with the given name, if there is one. The caller must hold s.mu.
+func (s *Scheduler) removeLocked(name string) {
 	if id, exists := s.jobs[name]; exists {
 		s.cron.Remove(id)
 		delete(s.jobs, name)
@@ -78,6 +160,24 @@ func (s *Scheduler) RemoveJob(name string) {
 	}
 }
 
+// execute wraps run with logging and metrics.
+func (s *Scheduler) execute(name, schedule string, run JobFunc) func() {
+	return func() {
+		ctx := context.Background()
+		start := time.Now()
+
+		s.metrics.jobExecutions.WithLabelValues(name, schedule).Inc()
+		s.metrics.lastExecutionTS.WithLabelValues(name, schedule).Set(float64(time.Now().Unix()))
+
+		if err := run(ctx); err != nil {
+			s.metrics.jobFailures.WithLabelValues(name, schedule).Inc()
+			s.log.Errorf("job %s failed: %v", name, err)
+		}
+
+		s.metrics.executionTime.WithLabelValues(name).Observe(time.Since(start).Seconds())
+	}
+}
+
 func (s *Scheduler) Start() {
 	s.cron.Start()
 }
@@ -85,3 +185,73 @@ func (s *Scheduler) Start() {
 func (s *Scheduler) Stop() {
 	s.cron.Stop()
 }
+
+// oneShotSchedule is the schedule label recorded in metrics for one-shot jobs.
+const oneShotSchedule = "oneshot"
+
+// onceSchedule is a cron.Schedule that fires once. Cron asks for the next run when the job is
+// added, or when the scheduler starts, and again after each run, so only the first answer is a time.
+type onceSchedule struct {
+	at        time.Time
+	scheduled atomic.Bool
+}
+
+// Next returns the time the job fires, or the zero time once it has been scheduled.
+func (o *onceSchedule) Next(t time.Time) time.Time {
+	if o.scheduled.Swap(true) {
+		return time.Time{}
+	}
+
+	if o.at.Before(t) {
+		return t
+	}
+
+	return o.at
+}
+
+// offsetFirstSchedule is a cron.Schedule delaying the first run of another by an offset. The offset
+// is kept short of the gap to the run after, so no run is skipped.
+type offsetFirstSchedule struct {
+	schedule cron.Schedule
+	offset   time.Duration
+	started  atomic.Bool
+}
+
+// Next returns the next run of the schedule, delayed by the offset the first time it's asked.
+func (o *offsetFirstSchedule) Next(t time.Time) time.Time {
+	next := o.schedule.Next(t)
+	if o.started.Swap(true) || next.IsZero() {
+		return next
+	}
+
+	offset := o.offset
+	if gap := o.schedule.Next(next).Sub(next); gap > 0 {
+		offset %= gap
+	}
+
+	return next.Add(offset)
+}
+
+// startupOffset returns how long to delay the first run of the named job, spreading jobs evenly but
+// deterministically across the spread.
+func startupOffset(name string, spread time.Duration) time.Duration {
+	if spread <= 0 {
+		return 0
+	}
+
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(name))
+
+	return time.Duration(h.Sum64() % uint64(spread))
+}
+
+// MissedRun reports whether a job on the given schedule has missed a run, that is whether a run
+// was due between its last successful run and now.
+func MissedRun(schedule string, lastSuccess, now time.Time) (bool, error) {
+	sched, err := cron.ParseStandard(schedule)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse schedule %s: %w", schedule, err)
+	}
+
+	return !sched.Next(lastSuccess).After(now), nil
+}
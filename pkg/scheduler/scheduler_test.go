@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/robfig/cron/v3"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -85,6 +87,33 @@ func TestScheduler(t *testing.T) {
 		assert.NotEqual(t, firstID, s.jobs["test"])
 	})
 
+	t.Run("NextRun", func(t *testing.T) {
+		setupTest(t)
+		s := NewScheduler(logrus.New(), NewMetrics("test"))
+
+		require.NoError(t, s.AddJob("test", "0 7 * * *", func(ctx context.Context) error {
+			return nil
+		}))
+
+		// Not running yet, so there's no next run.
+		_, err := s.NextRun("test")
+		require.Error(t, err)
+
+		s.Start()
+		defer s.Stop()
+
+		sched, err := cron.ParseStandard("0 7 * * *")
+		require.NoError(t, err)
+
+		next, err := s.NextRun("test")
+		require.NoError(t, err)
+		assert.Equal(t, sched.Next(time.Now()), next)
+
+		_, err = s.NextRun("nonexistent")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "job nonexistent is not scheduled")
+	})
+
 	t.Run("RemoveJob", func(t *testing.T) {
 		setupTest(t)
 		s := NewScheduler(logrus.New(), NewMetrics("test"))
@@ -116,6 +145,81 @@ func TestScheduler(t *testing.T) {
 		s.RemoveJob("nonexistent")
 	})
 
+	t.Run("AddOneShot", func(t *testing.T) {
+		setupTest(t)
+		s := NewScheduler(logrus.New(), NewMetrics("test"))
+		s.Start()
+		defer s.Stop()
+
+		var runs atomic.Int32
+
+		jobRan := make(chan struct{}, 1)
+		s.AddOneShot("test", time.Now().Add(100*time.Millisecond), func(ctx context.Context) error {
+			runs.Add(1)
+			jobRan <- struct{}{}
+
+			return nil
+		})
+		require.True(t, s.HasJob("test"))
+
+		select {
+		case <-jobRan:
+		case <-time.After(2 * time.Second):
+			t.Fatal("one-shot job did not run within expected time")
+		}
+
+		// Give it the chance to run again, it shouldn't.
+		time.Sleep(1500 * time.Millisecond)
+		assert.Equal(t, int32(1), runs.Load())
+
+		assert.False(t, s.HasJob("test"))
+		assert.Empty(t, s.cron.Entries())
+	})
+
+	t.Run("AddOneShot_Past", func(t *testing.T) {
+		setupTest(t)
+		s := NewScheduler(logrus.New(), NewMetrics("test"))
+
+		jobRan := make(chan struct{}, 1)
+		s.AddOneShot("test", time.Now().Add(-time.Hour), func(ctx context.Context) error {
+			jobRan <- struct{}{}
+
+			return nil
+		})
+
+		s.Start()
+		defer s.Stop()
+
+		select {
+		case <-jobRan:
+		case <-time.After(2 * time.Second):
+			t.Fatal("overdue one-shot job did not run once started")
+		}
+	})
+
+	t.Run("AddOneShot_Removed", func(t *testing.T) {
+		setupTest(t)
+		s := NewScheduler(logrus.New(), NewMetrics("test"))
+		s.Start()
+		defer s.Stop()
+
+		jobRan := make(chan struct{}, 1)
+		s.AddOneShot("test", time.Now().Add(200*time.Millisecond), func(ctx context.Context) error {
+			jobRan <- struct{}{}
+
+			return nil
+		})
+
+		s.RemoveJob("test")
+		assert.False(t, s.HasJob("test"))
+
+		select {
+		case <-jobRan:
+			t.Fatal("cancelled one-shot job ran")
+		case <-time.After(time.Second):
+		}
+	})
+
 	t.Run("Job_Execution", func(t *testing.T) {
 		setupTest(t)
 		s := NewScheduler(logrus.New(), NewMetrics("test"))
@@ -195,3 +299,93 @@ func TestScheduler(t *testing.T) {
 		wg.Wait()
 	})
 }
+
+func TestMissedRun(t *testing.T) {
+	// Daily at 07:00 UTC.
+	const schedule = "0 7 * * *"
+
+	lastSuccess := time.Date(2025, 1, 2, 7, 0, 30, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		now      time.Time
+		expected bool
+	}{
+		{name: "before the next run", now: time.Date(2025, 1, 3, 6, 59, 0, 0, time.UTC), expected: false},
+		{name: "at the next run", now: time.Date(2025, 1, 3, 7, 0, 0, 0, time.UTC), expected: true},
+		{name: "days later", now: time.Date(2025, 1, 6, 12, 0, 0, 0, time.UTC), expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			missed, err := MissedRun(schedule, lastSuccess, tt.now)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, missed)
+		})
+	}
+
+	t.Run("invalid schedule", func(t *testing.T) {
+		_, err := MissedRun("invalid", lastSuccess, lastSuccess)
+		assert.Error(t, err)
+	})
+}
+
+func TestOffsetFirstSchedule(t *testing.T) {
+	daily, err := cron.ParseStandard("0 7 * * *")
+	require.NoError(t, err)
+
+	now := time.Date(2025, 1, 2, 12, 0, 0, 0, time.UTC)
+
+	t.Run("first run offset, later runs on schedule", func(t *testing.T) {
+		sched := &offsetFirstSchedule{schedule: daily, offset: 20 * time.Minute}
+
+		first := sched.Next(now)
+		assert.Equal(t, time.Date(2025, 1, 3, 7, 20, 0, 0, time.UTC), first)
+		assert.Equal(t, time.Date(2025, 1, 4, 7, 0, 0, 0, time.UTC), sched.Next(first))
+	})
+
+	t.Run("offset kept short of the next run", func(t *testing.T) {
+		every, err := cron.ParseStandard("*/10 * * * *")
+		require.NoError(t, err)
+
+		sched := &offsetFirstSchedule{schedule: every, offset: 25 * time.Minute}
+		assert.Equal(t, now.Add(15*time.Minute), sched.Next(now))
+	})
+}
+
+func TestStartupOffset(t *testing.T) {
+	spread := 30 * time.Minute
+
+	assert.Zero(t, startupOffset("job", 0))
+	assert.Equal(t, startupOffset("job", spread), startupOffset("job", spread), "offsets are deterministic")
+
+	offsets := make(map[time.Duration]bool)
+
+	for i := range 20 {
+		offset := startupOffset(fmt.Sprintf("networks/devnet-0/monitor/client-%d.json", i), spread)
+		assert.GreaterOrEqual(t, offset, time.Duration(0))
+		assert.Less(t, offset, spread)
+
+		offsets[offset] = true
+	}
+
+	assert.Greater(t, len(offsets), 1, "jobs are spread out")
+}
+
+func TestAddSpreadJob(t *testing.T) {
+	setupTest(t)
+
+	s := NewScheduler(logrus.New(), NewMetrics("test"))
+
+	require.NoError(t, s.AddSpreadJob("spread", "0 7 * * *", 30*time.Minute, func(context.Context) error { return nil }))
+	require.NoError(t, s.AddSpreadJob("unspread", "0 7 * * *", 0, func(context.Context) error { return nil }))
+	require.Error(t, s.AddSpreadJob("invalid", "invalid", 30*time.Minute, func(context.Context) error { return nil }))
+
+	assert.True(t, s.HasJob("spread"))
+	assert.True(t, s.HasJob("unspread"))
+	assert.False(t, s.HasJob("invalid"))
+
+	// Replacing a spread job keeps a single job.
+	require.NoError(t, s.AddSpreadJob("spread", "0 8 * * *", 30*time.Minute, func(context.Context) error { return nil }))
+	assert.Len(t, s.cron.Entries(), 2)
+}
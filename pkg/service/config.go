@@ -2,31 +2,100 @@ package service
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/ethpandaops/panda-pulse/pkg/cartographoor"
 	"github.com/ethpandaops/panda-pulse/pkg/discord"
+	"github.com/ethpandaops/panda-pulse/pkg/discord/cmd/checks"
+	"github.com/ethpandaops/panda-pulse/pkg/discord/cmd/common"
+	cmdhive "github.com/ethpandaops/panda-pulse/pkg/discord/cmd/hive"
+	"github.com/ethpandaops/panda-pulse/pkg/discord/message"
 	"github.com/ethpandaops/panda-pulse/pkg/grafana"
 	"github.com/ethpandaops/panda-pulse/pkg/hive"
+	"github.com/ethpandaops/panda-pulse/pkg/slack"
 	"github.com/ethpandaops/panda-pulse/pkg/store"
+	"github.com/robfig/cron/v3"
 )
 
 // Config contains the configuration for the service.
 type Config struct {
-	GrafanaToken       string
-	DiscordToken       string
-	DiscordGuildIDs    []string // Optional: if set, commands will be registered to these guilds only
-	GrafanaBaseURL     string
-	PromDatasourceID   string
-	AccessKeyID        string
-	SecretAccessKey    string
-	GithubToken        string
-	S3Bucket           string
-	S3BucketPrefix     string
-	S3Region           string
-	S3EndpointURL      string
-	ClientsDataURL     string
-	MetricsAddress     string // Defaults to :9091
-	HealthCheckAddress string // Defaults to :9191
+	GrafanaToken           string
+	DiscordToken           string
+	DiscordGuildIDs        []string // Optional: if set, commands will be registered to these guilds only
+	DiscordIntents         []string // Optional: gateway intent names, defaults to discord.DefaultIntents
+	CommandPermissions     string   // Optional: comma-separated "command subcommand=level" permission overrides
+	GrafanaBaseURL         string
+	PromDatasourceID       string
+	GrafanaQueryCacheTTL   time.Duration // Defaults to grafana.DefaultQueryCacheTTL, negative disables
+	GrafanaDashboardUID    string        // Defaults to message.DefaultDashboardUID
+	GrafanaLogsDashboard   string        // Defaults to message.DefaultLogsDashboardUID
+	GrafanaNetDashboards   string        // Optional: JSON object of network to dashboard UID the Grafana button links to
+	AccessKeyID            string
+	SecretAccessKey        string
+	GithubToken            string
+	S3Bucket               string
+	S3BucketPrefix         string
+	S3Region               string
+	S3EndpointURL          string
+	S3RewriteMigrated      bool   // Optional: rewrite records upgraded to a newer schema version on read
+	StoreBackend           string // Defaults to store.BackendS3
+	StoreDirectory         string // Required by store.BackendFS: directory objects are kept under
+	ClientsDataURL         string
+	ClientsDataAttempts    int           // Defaults to 3: times the initial clients data fetch is tried at startup
+	ClientsDataDegraded    bool          // Optional: start without clients data if it can't be fetched, rather than fail
+	MetricsAddress         string        // Defaults to :9091
+	HealthCheckAddress     string        // Defaults to :9191
+	ChecksRunTimeout       time.Duration // Defaults to checks.DefaultRunTimeout
+	MaintenanceMode        bool          // Optional: start with notifications suppressed
+	DiscordAdminChannelID  string        // Optional: channel operational reports are sent to
+	OrphanedAlertsSchedule string        // Defaults to discord.DefaultOrphanedAlertsSchedule
+	DisableOrphanedAlerts  bool          // Optional: disable alerts whose channel no longer exists
+	CatchUpMissedRuns      bool          // Optional: on startup, run alerts once that missed a scheduled run
+	StartupSpread          time.Duration // Optional: spread the first run of existing alerts over this long
+	ChecksThreadName       string        // Defaults to checks.DefaultThreadNameTemplate
+	ChecksCooldown         time.Duration // Defaults to checks.DefaultNotificationCooldown, negative disables
+	ChecksMaxThreadMsgs    int           // Defaults to checks.DefaultMaxThreadMessages, negative disables
+	ChecksAlertsPerMinute  int           // Defaults to checks.DefaultAlertsPerMinute, negative disables
+	ChecksStaleData        time.Duration // Defaults to checks.DefaultStaleDataThreshold, negative disables
+	ChecksNetworkMinNodes  int           // Defaults to checks.DefaultNetworkMinHealthyNodes, negative disables
+	ChecksErrorGrace       int           // Defaults to checks.DefaultCheckErrorGrace, negative disables
+	ChecksErrorChannelID   string        // Optional: channel errored checks are notified in, defaults to the alert's
+	ChecksCriticalChecks   []string      // Optional: names of checks that always alert when failing
+	CrossNetworkChannelID  string        // Optional: channel cross-network incidents are posted to, enables them
+	CrossNetworkMin        int           // Defaults to checks.DefaultCrossNetworkMinimum
+	CrossNetworkWindow     time.Duration // Defaults to checks.DefaultCrossNetworkWindow
+	EscalationChannelID    string        // Optional: channel prolonged incidents are escalated to, enables escalation
+	EscalationAfter        string        // Optional: comma-separated durations incidents are escalated after
+	ChecksRemediationCmd   string        // Defaults to message.DefaultRemediationCommand
+	ChecksFlatInstances    bool          // Optional: list affected instances together rather than by likely cause
+	ChecksNoHiveScreenshot bool          // Optional: don't attach Hive screenshots to alerts
+	ChecksScreenshotHours  string        // Optional: window Hive screenshots are taken in, defaults to any time
+	ChecksPersistQueries   bool          // Optional: persist raw Grafana query responses alongside check logs
+	ChecksEnforceClients   bool          // Optional: reject registering clients the network isn't known to run
+	ChecksRunDetails       bool          // Optional: list the checks that ran when a manual run passes
+	ChecksWeightedFailures bool          // Optional: weight analyzer pair failures by their failing instances
+	InstanceNamePatterns   string        // Optional: JSON object of network to instance name regex
+	InstanceRegionPattern  string        // Defaults to message.DefaultRegionPattern
+	ChecksClientPresets    string        // Optional: JSON object of preset name to clients
+	ChecksNameAliases      string        // Optional: JSON object of check name or prefix to the name listed
+	AlertTemplatesFile     string        // Optional: JSON file overriding the wording of alert messages
+	AlertEmbedFields       string        // Defaults to message.DefaultEmbedFields
+	FlappingWindow         time.Duration // Defaults to pkg/checks.DefaultFlappingWindow
+	FlappingThreshold      int           // Defaults to pkg/checks.DefaultFlappingThreshold
+	HiveThreadName         string        // Defaults to cmdhive.DefaultThreadNameTemplate
+	HiveConcurrency        int           // Defaults to cmdhive.DefaultConcurrency
+	HiveFallbackNetworks   []string      // Optional: Hive networks tried for networks Hive doesn't have
+	HiveStaleThreshold     time.Duration // Defaults to cmdhive.DefaultStaleThreshold, negative disables
+	HivePassRateGood       float64       // Defaults to cmdhive.DefaultGoodPassRate
+	HivePassRateWarning    float64       // Defaults to cmdhive.DefaultWarningPassRate
+	HiveSummaryDateFormat  string        // Defaults to store.DefaultSummaryDateFormat
+	HiveSummaryTimezone    string        // Defaults to UTC
+	HiveRetentionDaily     time.Duration // Optional: how long every Hive summary result is kept, nothing is pruned if unset
+	HiveRetentionWeekly    time.Duration // Optional: how long weekly results are kept past the daily ones, forever if unset
+	SlackToken             string        // Optional: Slack bot token, alerts are also posted to Slack when set
+	SlackWebhookURL        string        // Optional: Slack incoming webhook, used when no token is set
+	SlackChannels          string        // Optional: comma-separated key=channel mappings, required with a token
+	DiscordAlertsDisabled  bool          // Optional: only post alerts to Slack, commands still run in Discord
 }
 
 // AsS3Config converts the configuration to an S3Config.
@@ -38,15 +107,101 @@ func (c *Config) AsS3Config() *store.S3Config {
 		Prefix:          c.S3BucketPrefix,
 		Region:          c.S3Region,
 		EndpointURL:     c.S3EndpointURL,
+		RewriteMigrated: c.S3RewriteMigrated,
+		Backend:         c.StoreBackend,
+		Directory:       c.StoreDirectory,
+
+		SummaryDateFormat: c.HiveSummaryDateFormat,
+		SummaryTimezone:   c.HiveSummaryTimezone,
 	}
 }
 
-// AsDiscordConfig converts the configuration to a DiscordConfig.
+// AsDiscordConfig converts the configuration to a DiscordConfig. Permissions are checked by Validate.
 func (c *Config) AsDiscordConfig() *discord.Config {
+	permissions, _ := common.ParsePermissions(c.CommandPermissions)
+
 	return &discord.Config{
-		DiscordToken: c.DiscordToken,
-		GithubToken:  c.GithubToken,
-		GuildIDs:     c.DiscordGuildIDs,
+		DiscordToken:           c.DiscordToken,
+		GithubToken:            c.GithubToken,
+		GuildIDs:               c.DiscordGuildIDs,
+		Intents:                c.DiscordIntents,
+		Maintenance:            c.MaintenanceMode,
+		AdminChannelID:         c.DiscordAdminChannelID,
+		OrphanedAlertsSchedule: c.OrphanedAlertsSchedule,
+		DisableOrphanedAlerts:  c.DisableOrphanedAlerts,
+		CatchUpMissedRuns:      c.CatchUpMissedRuns,
+		StartupSpread:          c.StartupSpread,
+		Permissions:            permissions,
+		SummaryRetention: store.SummaryRetention{
+			Daily:  c.HiveRetentionDaily,
+			Weekly: c.HiveRetentionWeekly,
+		},
+	}
+}
+
+// AsChecksConfig converts the configuration to a checks command Config. Instance name and region
+// patterns, network dashboards, client presets, check name aliases, embed fields, screenshot hours
+// and escalation thresholds are checked by Validate.
+func (c *Config) AsChecksConfig() *checks.Config {
+	var (
+		instancePatterns, _ = message.ParseInstancePatterns(c.InstanceNamePatterns)
+		regionPattern, _    = message.ParseRegionPattern(c.InstanceRegionPattern)
+		dashboards, _       = message.ParseNetworkDashboards(c.GrafanaNetDashboards)
+		clientPresets, _    = checks.ParseClientPresets(c.ChecksClientPresets)
+		nameAliases, _      = message.ParseCheckNameAliases(c.ChecksNameAliases)
+		embedFields, _      = message.ParseEmbedFields(c.AlertEmbedFields)
+		screenshotHours, _  = checks.ParseScreenshotHours(c.ChecksScreenshotHours)
+		escalateAfter, _    = checks.ParseEscalationThresholds(c.EscalationAfter)
+	)
+
+	return &checks.Config{
+		RunTimeout:             c.ChecksRunTimeout,
+		ThreadNameTemplate:     c.ChecksThreadName,
+		FlappingWindow:         c.FlappingWindow,
+		FlappingThreshold:      c.FlappingThreshold,
+		NotificationCooldown:   c.ChecksCooldown,
+		MaxThreadMessages:      c.ChecksMaxThreadMsgs,
+		AlertsPerMinute:        c.ChecksAlertsPerMinute,
+		StaleDataThreshold:     c.ChecksStaleData,
+		NetworkMinHealthyNodes: c.ChecksNetworkMinNodes,
+		CheckErrorGrace:        c.ChecksErrorGrace,
+		CheckErrorChannelID:    c.ChecksErrorChannelID,
+		RemediationCommand:     c.ChecksRemediationCmd,
+		CriticalChecks:         c.ChecksCriticalChecks,
+		CheckNameAliases:       nameAliases,
+		EmbedFields:            embedFields,
+		CrossNetworkChannelID:  c.CrossNetworkChannelID,
+		CrossNetworkMinimum:    c.CrossNetworkMin,
+		CrossNetworkWindow:     c.CrossNetworkWindow,
+		EscalationChannelID:    c.EscalationChannelID,
+		EscalationThresholds:   escalateAfter,
+		FlatInstanceList:       c.ChecksFlatInstances,
+		DisableHiveScreenshots: c.ChecksNoHiveScreenshot,
+		HiveScreenshotHours:    screenshotHours,
+		PersistQueries:         c.ChecksPersistQueries,
+		InstancePatterns:       instancePatterns,
+		RegionPattern:          regionPattern,
+		DashboardUID:           c.GrafanaDashboardUID,
+		LogsDashboardUID:       c.GrafanaLogsDashboard,
+		NetworkDashboards:      dashboards,
+		ClientPresets:          clientPresets,
+		DiscordDisabled:        c.DiscordAlertsDisabled,
+		EnforceNetworkClients:  c.ChecksEnforceClients,
+		ManualRunDetails:       c.ChecksRunDetails,
+		WeightedFailures:       c.ChecksWeightedFailures,
+	}
+}
+
+// AsHiveCommandConfig converts the configuration to a hive command Config.
+func (c *Config) AsHiveCommandConfig() *cmdhive.Config {
+	return &cmdhive.Config{
+		ThreadNameTemplate: c.HiveThreadName,
+		Concurrency:        c.HiveConcurrency,
+		StaleThreshold:     c.HiveStaleThreshold,
+		PassRates: cmdhive.PassRateThresholds{
+			Good:    c.HivePassRateGood,
+			Warning: c.HivePassRateWarning,
+		},
 	}
 }
 
@@ -56,20 +211,36 @@ func (c *Config) AsGrafanaConfig() *grafana.Config {
 		Token:            c.GrafanaToken,
 		PromDatasourceID: c.PromDatasourceID,
 		BaseURL:          c.GrafanaBaseURL,
+		QueryCacheTTL:    c.GrafanaQueryCacheTTL,
 	}
 }
 
 // AsHiveConfig converts the configuration to a HiveConfig.
 func (c *Config) AsHiveConfig() *hive.Config {
 	return &hive.Config{
-		BaseURL: hive.BaseURL,
+		BaseURL:                hive.BaseURL,
+		MaxConcurrentSnapshots: c.HiveConcurrency,
+		FallbackNetworks:       c.HiveFallbackNetworks,
+	}
+}
+
+// AsSlackConfig converts the configuration to a SlackConfig. Channel mappings are checked by Validate.
+func (c *Config) AsSlackConfig() *slack.Config {
+	channels, _ := slack.ParseChannels(c.SlackChannels)
+
+	return &slack.Config{
+		Token:      c.SlackToken,
+		WebhookURL: c.SlackWebhookURL,
+		Channels:   channels,
 	}
 }
 
 // AsCartographoorConfig converts the configuration to a CartographoorConfig.
 func (c *Config) AsCartographoorConfig() cartographoor.ServiceConfig {
 	return cartographoor.ServiceConfig{
-		SourceURL: c.ClientsDataURL,
+		SourceURL:          c.ClientsDataURL,
+		StartupAttempts:    c.ClientsDataAttempts,
+		AllowDegradedStart: c.ClientsDataDegraded,
 	}
 }
 
@@ -83,21 +254,107 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("DISCORD_BOT_TOKEN environment variable is required")
 	}
 
-	if c.AccessKeyID == "" {
-		return fmt.Errorf("AWS_ACCESS_KEY_ID environment variable is required")
+	if err := store.ValidateBackend(c.StoreBackend, c.StoreDirectory); err != nil {
+		return fmt.Errorf("STORE_BACKEND is invalid: %w", err)
 	}
 
-	if c.SecretAccessKey == "" {
-		return fmt.Errorf("AWS_SECRET_ACCESS_KEY environment variable is required")
-	}
+	// The fs backend keeps everything locally, so needs no AWS credentials.
+	if c.StoreBackend != store.BackendFS {
+		if c.AccessKeyID == "" {
+			return fmt.Errorf("AWS_ACCESS_KEY_ID environment variable is required")
+		}
+
+		if c.SecretAccessKey == "" {
+			return fmt.Errorf("AWS_SECRET_ACCESS_KEY environment variable is required")
+		}
 
-	if c.S3Bucket == "" {
-		return fmt.Errorf("S3_BUCKET environment variable is required")
+		if c.S3Bucket == "" {
+			return fmt.Errorf("S3_BUCKET environment variable is required")
+		}
 	}
 
 	if c.GithubToken == "" {
 		return fmt.Errorf("GITHUB_TOKEN environment variable is required")
 	}
 
+	if _, err := discord.ParseIntents(c.DiscordIntents); err != nil {
+		return fmt.Errorf("DISCORD_INTENTS is invalid: %w", err)
+	}
+
+	if _, err := common.ParsePermissions(c.CommandPermissions); err != nil {
+		return fmt.Errorf("COMMAND_PERMISSIONS is invalid: %w", err)
+	}
+
+	if err := store.ValidateSummaryDates(c.HiveSummaryDateFormat, c.HiveSummaryTimezone); err != nil {
+		return fmt.Errorf("HIVE_SUMMARY_DATE_FORMAT or HIVE_SUMMARY_TIMEZONE is invalid: %w", err)
+	}
+
+	if _, err := message.ParseInstancePatterns(c.InstanceNamePatterns); err != nil {
+		return fmt.Errorf("INSTANCE_NAME_PATTERNS is invalid: %w", err)
+	}
+
+	if _, err := message.ParseRegionPattern(c.InstanceRegionPattern); err != nil {
+		return fmt.Errorf("INSTANCE_REGION_PATTERN is invalid: %w", err)
+	}
+
+	if _, err := message.ParseNetworkDashboards(c.GrafanaNetDashboards); err != nil {
+		return fmt.Errorf("GRAFANA_NETWORK_DASHBOARDS is invalid: %w", err)
+	}
+
+	if _, err := checks.ParseClientPresets(c.ChecksClientPresets); err != nil {
+		return fmt.Errorf("CHECKS_CLIENT_PRESETS is invalid: %w", err)
+	}
+
+	if _, err := message.ParseCheckNameAliases(c.ChecksNameAliases); err != nil {
+		return fmt.Errorf("CHECKS_NAME_ALIASES is invalid: %w", err)
+	}
+
+	if _, err := message.ParseEmbedFields(c.AlertEmbedFields); err != nil {
+		return fmt.Errorf("ALERT_EMBED_FIELDS is invalid: %w", err)
+	}
+
+	if _, err := checks.ParseScreenshotHours(c.ChecksScreenshotHours); err != nil {
+		return fmt.Errorf("CHECKS_HIVE_SCREENSHOT_HOURS is invalid: %w", err)
+	}
+
+	if _, err := checks.ParseEscalationThresholds(c.EscalationAfter); err != nil {
+		return fmt.Errorf("CHECKS_ESCALATION_AFTER is invalid: %w", err)
+	}
+
+	if c.ChecksThreadName != "" {
+		if err := common.ValidateThreadNameTemplate(c.ChecksThreadName); err != nil {
+			return fmt.Errorf("CHECKS_THREAD_NAME_TEMPLATE is invalid: %w", err)
+		}
+	}
+
+	if err := c.AsHiveCommandConfig().PassRates.Validate(); err != nil {
+		return fmt.Errorf("HIVE_PASS_RATE_GOOD or HIVE_PASS_RATE_WARNING is invalid: %w", err)
+	}
+
+	if c.HiveThreadName != "" {
+		if err := common.ValidateThreadNameTemplate(c.HiveThreadName); err != nil {
+			return fmt.Errorf("HIVE_THREAD_NAME_TEMPLATE is invalid: %w", err)
+		}
+	}
+
+	if _, err := slack.ParseChannels(c.SlackChannels); err != nil {
+		return fmt.Errorf("SLACK_CHANNELS is invalid: %w", err)
+	}
+
+	slackConfig := c.AsSlackConfig()
+	if err := slackConfig.Validate(); err != nil {
+		return fmt.Errorf("SLACK_CHANNELS is invalid: %w", err)
+	}
+
+	if c.DiscordAlertsDisabled && !slackConfig.Enabled() {
+		return fmt.Errorf("DISCORD_ALERTS_DISABLED requires SLACK_TOKEN or SLACK_WEBHOOK_URL, alerts would go nowhere")
+	}
+
+	if c.OrphanedAlertsSchedule != "" {
+		if _, err := cron.ParseStandard(c.OrphanedAlertsSchedule); err != nil {
+			return fmt.Errorf("ORPHANED_ALERTS_SCHEDULE is invalid: %w", err)
+		}
+	}
+
 	return nil
 }
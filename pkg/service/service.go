@@ -1,6 +1,7 @@
 package service
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"net/http"
@@ -8,15 +9,18 @@ import (
 
 	"github.com/ethpandaops/panda-pulse/pkg/cartographoor"
 	"github.com/ethpandaops/panda-pulse/pkg/discord"
+	"github.com/ethpandaops/panda-pulse/pkg/discord/cmd/admin"
 	"github.com/ethpandaops/panda-pulse/pkg/discord/cmd/build"
 	"github.com/ethpandaops/panda-pulse/pkg/discord/cmd/checks"
 	"github.com/ethpandaops/panda-pulse/pkg/discord/cmd/common"
 	cmdhive "github.com/ethpandaops/panda-pulse/pkg/discord/cmd/hive"
 	"github.com/ethpandaops/panda-pulse/pkg/discord/cmd/mentions"
+	"github.com/ethpandaops/panda-pulse/pkg/discord/message"
 	"github.com/ethpandaops/panda-pulse/pkg/grafana"
 	"github.com/ethpandaops/panda-pulse/pkg/hive"
 	httpclient "github.com/ethpandaops/panda-pulse/pkg/http"
 	"github.com/ethpandaops/panda-pulse/pkg/scheduler"
+	"github.com/ethpandaops/panda-pulse/pkg/slack"
 	"github.com/ethpandaops/panda-pulse/pkg/store"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/sirupsen/logrus"
@@ -40,6 +44,13 @@ type Service struct {
 	checksRepo           *store.ChecksRepo
 	mentionsRepo         *store.MentionsRepo
 	hiveSummaryRepo      *store.HiveSummaryRepo
+	routesRepo           *store.RoutesRepo
+	versionsRepo         *store.VersionsRepo
+	notificationsRepo    *store.NotificationsRepo
+	jobRunsRepo          *store.JobRunsRepo
+	thresholdsRepo       *store.ThresholdsRepo
+	guildConfigRepo      *store.GuildConfigRepo
+	incidentsRepo        *store.IncidentsRepo
 	cartographoorService *cartographoor.Service
 	healthSrv            *http.Server
 	metricsSrv           *http.Server
@@ -54,6 +65,7 @@ func NewService(ctx context.Context, log *logrus.Logger, cfg *Config) (*Service,
 	schedulerMetrics := scheduler.NewMetrics("panda_pulse")
 	discordMetrics := discord.NewMetrics("panda_pulse")
 	httpMetrics := httpclient.NewMetrics("panda_pulse")
+	grafanaMetrics := grafana.NewMetrics("panda_pulse")
 
 	// Create a function to generate service-specific HTTP clients with metrics
 	createServiceClient := func(serviceName string) *http.Client {
@@ -73,6 +85,7 @@ func NewService(ctx context.Context, log *logrus.Logger, cfg *Config) (*Service,
 	hiveHTTPClient := createServiceClient("hive")
 	githubHTTPClient := createServiceClient("github")
 	clientsHTTPClient := createServiceClient("clients")
+	slackHTTPClient := createServiceClient("slack")
 
 	// Create cartographoor service
 	cartographoorConfig := cfg.AsCartographoorConfig()
@@ -108,11 +121,55 @@ func NewService(ctx context.Context, log *logrus.Logger, cfg *Config) (*Service,
 		return nil, fmt.Errorf("failed to create hive summary repo: %w", err)
 	}
 
+	routesRepo, err := store.NewRoutesRepo(ctx, log, cfg.AsS3Config(), storeMetrics)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create routes repo: %w", err)
+	}
+
+	versionsRepo, err := store.NewVersionsRepo(ctx, log, cfg.AsS3Config(), storeMetrics)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create versions repo: %w", err)
+	}
+
+	notificationsRepo, err := store.NewNotificationsRepo(ctx, log, cfg.AsS3Config(), storeMetrics)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create notifications repo: %w", err)
+	}
+
+	jobRunsRepo, err := store.NewJobRunsRepo(ctx, log, cfg.AsS3Config(), storeMetrics)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create job runs repo: %w", err)
+	}
+
+	thresholdsRepo, err := store.NewThresholdsRepo(ctx, log, cfg.AsS3Config(), storeMetrics)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create thresholds repo: %w", err)
+	}
+
+	guildConfigRepo, err := store.NewGuildConfigRepo(ctx, log, cfg.AsS3Config(), storeMetrics)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create guild config repo: %w", err)
+	}
+
+	incidentsRepo, err := store.NewIncidentsRepo(ctx, log, cfg.AsS3Config(), storeMetrics)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create incidents repo: %w", err)
+	}
+
 	// Create Grafana client with service-specific HTTP client.
-	grafanaClient := grafana.NewClient(cfg.AsGrafanaConfig(), grafanaHTTPClient)
+	grafanaConfig := cfg.AsGrafanaConfig()
+	grafanaClient := grafana.NewClient(grafanaConfig, grafanaHTTPClient)
+
+	// Share responses between alerts on the same network running close together.
+	if ttl := cmp.Or(grafanaConfig.QueryCacheTTL, grafana.DefaultQueryCacheTTL); ttl > 0 {
+		grafanaClient = grafana.NewCachingClient(grafanaClient, ttl, grafanaMetrics)
+	}
 
 	// Create Hive client with service-specific HTTP client.
-	hiveClient := hive.NewHive(cfg.AsHiveConfig(), hiveHTTPClient)
+	hiveClient := hive.NewHive(log, cfg.AsHiveConfig(), hiveHTTPClient)
+
+	// Create Slack client, nil unless a token or webhook is configured.
+	slackClient := slack.NewSlack(cfg.AsSlackConfig(), slackHTTPClient)
 
 	// Check S3 connection health, no point in continuing if we can't access the store.
 	if verr := monitorRepo.VerifyConnection(ctx); verr != nil {
@@ -131,8 +188,16 @@ func NewService(ctx context.Context, log *logrus.Logger, cfg *Config) (*Service,
 		checksRepo,
 		mentionsRepo,
 		hiveSummaryRepo,
+		routesRepo,
+		versionsRepo,
+		notificationsRepo,
+		jobRunsRepo,
+		thresholdsRepo,
+		guildConfigRepo,
+		incidentsRepo,
 		grafanaClient,
 		hiveClient,
+		slackClient,
 		discordMetrics,
 		cartographoorService,
 	)
@@ -140,12 +205,22 @@ func NewService(ctx context.Context, log *logrus.Logger, cfg *Config) (*Service,
 		return nil, fmt.Errorf("failed to create bot: %w", err)
 	}
 
+	// Load the alert wording up front, a broken template should stop startup rather than an alert.
+	templates, err := message.LoadTemplates(cfg.AlertTemplatesFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load alert templates: %w", err)
+	}
+
+	checksConfig := cfg.AsChecksConfig()
+	checksConfig.Templates = templates
+
 	// Tell the bot about our commands.
 	bot.SetCommands([]common.Command{
-		checks.NewChecksCommand(log, bot),
+		checks.NewChecksCommand(log, bot, checksConfig),
 		mentions.NewMentionsCommand(log, bot),
-		cmdhive.NewHiveCommand(log, bot, cfg.GithubToken, githubHTTPClient),
+		cmdhive.NewHiveCommand(log, bot, cfg.GithubToken, githubHTTPClient, cfg.AsHiveCommandConfig()),
 		build.NewBuildCommand(log, bot, cfg.GithubToken, githubHTTPClient),
+		admin.NewAdminCommand(log, bot),
 	})
 
 	return &Service{
@@ -157,6 +232,13 @@ func NewService(ctx context.Context, log *logrus.Logger, cfg *Config) (*Service,
 		checksRepo:           checksRepo,
 		mentionsRepo:         mentionsRepo,
 		hiveSummaryRepo:      hiveSummaryRepo,
+		routesRepo:           routesRepo,
+		versionsRepo:         versionsRepo,
+		notificationsRepo:    notificationsRepo,
+		jobRunsRepo:          jobRunsRepo,
+		thresholdsRepo:       thresholdsRepo,
+		guildConfigRepo:      guildConfigRepo,
+		incidentsRepo:        incidentsRepo,
 		cartographoorService: cartographoorService,
 	}, nil
 }
@@ -253,9 +335,14 @@ func (s *Service) startHealthServer() *http.Server {
 	}
 
 	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		body := "ok"
+		if s.bot.IsMaintenance() {
+			body = "ok (maintenance mode)"
+		}
+
 		w.WriteHeader(http.StatusOK)
 
-		if _, err := w.Write([]byte("ok")); err != nil {
+		if _, err := w.Write([]byte(body)); err != nil {
 			s.log.Errorf("Failed to write health check response: %v", err)
 		}
 	})
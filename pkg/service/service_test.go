@@ -162,6 +162,7 @@ func TestService(t *testing.T) {
 		mockBot.EXPECT().GetSession().Return(nil).AnyTimes()
 		mockBot.EXPECT().GetHive().Return(nil).AnyTimes()
 		mockBot.EXPECT().GetQueues().Return([]queue.Queuer{}).Times(2) // Called during Start and Stop
+		mockBot.EXPECT().IsMaintenance().Return(false).AnyTimes()
 
 		svc.bot = mockBot
 
@@ -0,0 +1,68 @@
+package slack
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// DefaultChannelKey is the channel mapping key matching any alert without a more specific mapping.
+const DefaultChannelKey = "*"
+
+// Config contains configuration for Slack.
+type Config struct {
+	Token      string            // Bot token used to post via the Slack API, enables threaded replies.
+	WebhookURL string            // Incoming webhook, used when no token is set. Posts to the webhook's own channel.
+	Channels   map[string]string // Maps "network/client", "network" or DefaultChannelKey to a channel, API only.
+}
+
+// Enabled returns true if either a token or webhook is configured.
+func (c *Config) Enabled() bool {
+	return c.Token != "" || c.WebhookURL != ""
+}
+
+// Validate validates the configuration.
+func (c *Config) Validate() error {
+	if c.Token != "" && len(c.Channels) == 0 {
+		return errors.New("slack channels are required when posting with a token")
+	}
+
+	return nil
+}
+
+// Channel returns the channel alerts for the client on the network are posted to, preferring a
+// mapping for the client on the network, then the network, then the default. Returns false if
+// nothing matches.
+func (c *Config) Channel(network, client string) (string, bool) {
+	for _, key := range []string{network + "/" + client, network, DefaultChannelKey} {
+		if channel, ok := c.Channels[key]; ok {
+			return channel, true
+		}
+	}
+
+	return "", false
+}
+
+// ParseChannels parses a comma-separated list of key=channel mappings, such as
+// "fusaka-devnet-1/lighthouse=C0123,fusaka-devnet-1=C0456,*=C0789".
+func ParseChannels(value string) (map[string]string, error) {
+	channels := make(map[string]string)
+
+	for entry := range strings.SplitSeq(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		key, channel, ok := strings.Cut(entry, "=")
+		key, channel = strings.TrimSpace(key), strings.TrimSpace(channel)
+
+		if !ok || key == "" || channel == "" {
+			return nil, fmt.Errorf("invalid slack channel mapping %q, expected key=channel", entry)
+		}
+
+		channels[key] = channel
+	}
+
+	return channels, nil
+}
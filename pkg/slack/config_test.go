@@ -0,0 +1,56 @@
+package slack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseChannels(t *testing.T) {
+	channels, err := ParseChannels(" devnet-0/lighthouse=C1, devnet-0=C2,,*=C3 ")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"devnet-0/lighthouse": "C1", "devnet-0": "C2", "*": "C3"}, channels)
+
+	channels, err = ParseChannels("")
+	require.NoError(t, err)
+	assert.Empty(t, channels)
+
+	for _, invalid := range []string{"devnet-0", "=C1", "devnet-0="} {
+		_, err = ParseChannels(invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestConfig_Channel(t *testing.T) {
+	cfg := &Config{Channels: map[string]string{"devnet-0/lighthouse": "C1", "devnet-0": "C2"}}
+
+	tests := []struct {
+		network, client string
+		want            string
+		ok              bool
+	}{
+		{network: "devnet-0", client: "lighthouse", want: "C1", ok: true},
+		{network: "devnet-0", client: "teku", want: "C2", ok: true},
+		{network: "devnet-1", client: "lighthouse"},
+	}
+
+	for _, tt := range tests {
+		channel, ok := cfg.Channel(tt.network, tt.client)
+		assert.Equal(t, tt.want, channel)
+		assert.Equal(t, tt.ok, ok)
+	}
+
+	cfg.Channels[DefaultChannelKey] = "C3"
+
+	channel, ok := cfg.Channel("devnet-1", "lighthouse")
+	assert.True(t, ok)
+	assert.Equal(t, "C3", channel)
+}
+
+func TestConfig_Validate(t *testing.T) {
+	require.NoError(t, (&Config{}).Validate())
+	require.NoError(t, (&Config{WebhookURL: "https://hooks.slack.com/services/x"}).Validate())
+	require.Error(t, (&Config{Token: "xoxb-token"}).Validate())
+	require.NoError(t, (&Config{Token: "xoxb-token", Channels: map[string]string{"*": "C1"}}).Validate())
+}
@@ -0,0 +1,130 @@
+package slack
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// MaxMessageLength keeps messages under the length Slack recommends for a message's text.
+const MaxMessageLength = 3900
+
+var (
+	markdownLink      = regexp.MustCompile(`\[([^\]]+)\]\((?:&lt;)?([^)\s]+?)(?:&gt;)?\)`)
+	markdownBold      = regexp.MustCompile(`\*\*(.+?)\*\*`)
+	markdownUnderline = regexp.MustCompile(`__(.+?)__`)
+	markdownStrike    = regexp.MustCompile(`~~(.+?)~~`)
+	markdownHeading   = regexp.MustCompile(`(?m)^#{1,3} +(.+)$`)
+	mrkdwnEscaper     = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+)
+
+// Mrkdwn converts the Markdown used in Discord messages to Slack's mrkdwn. Code blocks are escaped
+// but otherwise left as they are.
+func Mrkdwn(markdown string) string {
+	parts := strings.Split(markdown, "```")
+
+	for idx, part := range parts {
+		part = mrkdwnEscaper.Replace(part)
+
+		// Odd parts sit between a pair of fences.
+		if idx%2 == 0 {
+			part = markdownLink.ReplaceAllString(part, "<$2|$1>")
+			part = markdownHeading.ReplaceAllString(part, "**$1**")
+			part = markdownBold.ReplaceAllString(part, "*$1*")
+			part = markdownUnderline.ReplaceAllString(part, "_${1}_")
+			part = markdownStrike.ReplaceAllString(part, "~$1~")
+		}
+
+		parts[idx] = part
+	}
+
+	return strings.Join(parts, "```")
+}
+
+// Chunk splits text into messages of at most limit bytes, breaking between lines where it can.
+func Chunk(text string, limit int) []string {
+	var (
+		chunks []string
+		sb     strings.Builder
+	)
+
+	for line := range strings.Lines(text) {
+		// A single line over the limit has to be broken up.
+		for len(line) > limit {
+			if sb.Len() > 0 {
+				chunks = append(chunks, sb.String())
+				sb.Reset()
+			}
+
+			// Don't split a multi-byte character.
+			cut := limit
+			for cut > 0 && !utf8.RuneStart(line[cut]) {
+				cut--
+			}
+
+			if cut == 0 {
+				cut = limit
+			}
+
+			chunks = append(chunks, line[:cut])
+			line = line[cut:]
+		}
+
+		if sb.Len()+len(line) > limit {
+			chunks = append(chunks, sb.String())
+			sb.Reset()
+		}
+
+		sb.WriteString(line)
+	}
+
+	if sb.Len() > 0 {
+		chunks = append(chunks, sb.String())
+	}
+
+	return chunks
+}
+
+// Pack combines messages into as few as possible of at most limit bytes each, keeping each message
+// whole unless it's over the limit by itself.
+func Pack(messages []string, limit int) []string {
+	var (
+		packed []string
+		sb     strings.Builder
+	)
+
+	for _, msg := range messages {
+		msg = strings.TrimSpace(msg)
+		if msg == "" {
+			continue
+		}
+
+		if len(msg) > limit {
+			if sb.Len() > 0 {
+				packed = append(packed, sb.String())
+				sb.Reset()
+			}
+
+			packed = append(packed, Chunk(msg, limit)...)
+
+			continue
+		}
+
+		if sb.Len() > 0 && sb.Len()+len("\n\n")+len(msg) > limit {
+			packed = append(packed, sb.String())
+			sb.Reset()
+		}
+
+		if sb.Len() > 0 {
+			sb.WriteString("\n\n")
+		}
+
+		sb.WriteString(msg)
+	}
+
+	if sb.Len() > 0 {
+		packed = append(packed, sb.String())
+	}
+
+	return packed
+}
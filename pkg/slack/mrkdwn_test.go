@@ -0,0 +1,50 @@
+package slack
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMrkdwn(t *testing.T) {
+	tests := []struct {
+		name     string
+		markdown string
+		want     string
+	}{
+		{name: "bold", markdown: "**Affected instances**", want: "*Affected instances*"},
+		{name: "link", markdown: "see [Grafana](https://grafana.example.com/d/x?a=1&b=2)", want: "see <https://grafana.example.com/d/x?a=1&amp;b=2|Grafana>"},
+		{name: "link without preview", markdown: "- [lighthouse-geth-1](<https://grafana.example.com/d/x?a=1>)", want: "- <https://grafana.example.com/d/x?a=1|lighthouse-geth-1>"},
+		{name: "heading", markdown: "## Issues", want: "*Issues*"},
+		{name: "strike and underline", markdown: "~~gone~~ __here__", want: "~gone~ _here_"},
+		{name: "escaping", markdown: "a < b && c > d", want: "a &lt; b &amp;&amp; c &gt; d"},
+		{name: "code block", markdown: "**x**\n```bash\nssh **host** <x>\n```\n**y**", want: "*x*\n```bash\nssh **host** &lt;x&gt;\n```\n*y*"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Mrkdwn(tt.markdown))
+		})
+	}
+}
+
+func TestChunk(t *testing.T) {
+	assert.Equal(t, []string{"a\nb\n", "c"}, Chunk("a\nb\nc", 4))
+	assert.Equal(t, []string{"short"}, Chunk("short", MaxMessageLength))
+	assert.Empty(t, Chunk("", MaxMessageLength))
+
+	// Long lines are split without breaking multi-byte characters.
+	chunks := Chunk(strings.Repeat("é", 5), 3)
+	assert.Equal(t, []string{"é", "é", "é", "é", "é"}, chunks)
+
+	for _, chunk := range Chunk(strings.Repeat("line of text\n", 1000), MaxMessageLength) {
+		assert.LessOrEqual(t, len(chunk), MaxMessageLength)
+	}
+}
+
+func TestPack(t *testing.T) {
+	assert.Equal(t, []string{"one\n\ntwo", "three"}, Pack([]string{"one", "\n\ntwo\n", "", "three"}, 10))
+	assert.Equal(t, []string{"a", "bbbb\n", "cccc", "d"}, Pack([]string{"a", "bbbb\ncccc", "d"}, 5))
+	assert.Empty(t, Pack(nil, MaxMessageLength))
+}
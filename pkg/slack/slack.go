@@ -0,0 +1,164 @@
+package slack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	// APIURL is the Slack Web API base URL.
+	APIURL      = "https://slack.com/api"
+	httpTimeout = 30 * time.Second
+)
+
+// ErrRateLimited is returned when Slack asks us to slow down.
+var ErrRateLimited = errors.New("slack rate limited")
+
+// Slack is the interface for posting to Slack.
+type Slack interface {
+	// PostMessage posts mrkdwn text to the channel, replying in the thread if threadTS is set. Returns
+	// the timestamp identifying the message, used to reply to it. Webhooks can't thread, so they
+	// return an empty timestamp and ignore the channel and threadTS.
+	PostMessage(ctx context.Context, channel, text, threadTS string) (string, error)
+	// Channel returns the channel alerts for the client on the network are posted to.
+	Channel(network, client string) (string, bool)
+	// SupportsThreads returns true if messages can be threaded, false when posting via a webhook.
+	SupportsThreads() bool
+}
+
+// slack is a Slack client implementation of Slack.
+type slack struct {
+	cfg        *Config
+	apiURL     string
+	httpClient *http.Client
+}
+
+// postMessageRequest is the body of a chat.postMessage or webhook request.
+type postMessageRequest struct {
+	Channel     string `json:"channel,omitempty"`
+	Text        string `json:"text"`
+	ThreadTS    string `json:"thread_ts,omitempty"` //nolint:tagliatelle // API uses snake_case
+	Mrkdwn      bool   `json:"mrkdwn"`
+	UnfurlLinks bool   `json:"unfurl_links"` //nolint:tagliatelle // API uses snake_case
+}
+
+// postMessageResponse is the response to a chat.postMessage request.
+type postMessageResponse struct {
+	OK    bool   `json:"ok"`
+	Error string `json:"error"`
+	TS    string `json:"ts"`
+}
+
+// NewSlack creates a new Slack client, or returns nil if Slack isn't configured.
+func NewSlack(cfg *Config, httpClient *http.Client) Slack {
+	if cfg == nil || !cfg.Enabled() {
+		return nil
+	}
+
+	// Use provided HTTP client or create a default one
+	if httpClient == nil {
+		httpClient = &http.Client{
+			Timeout: httpTimeout,
+		}
+	}
+
+	return &slack{
+		cfg:        cfg,
+		apiURL:     APIURL,
+		httpClient: httpClient,
+	}
+}
+
+// Channel returns the channel alerts for the client on the network are posted to.
+func (s *slack) Channel(network, client string) (string, bool) {
+	// A webhook always posts to its own channel.
+	if !s.SupportsThreads() {
+		return "", true
+	}
+
+	return s.cfg.Channel(network, client)
+}
+
+// SupportsThreads returns true if messages can be threaded.
+func (s *slack) SupportsThreads() bool {
+	return s.cfg.Token != ""
+}
+
+// PostMessage posts mrkdwn text to the channel.
+func (s *slack) PostMessage(ctx context.Context, channel, text, threadTS string) (string, error) {
+	if !s.SupportsThreads() {
+		return "", s.post(ctx, s.cfg.WebhookURL, "", &postMessageRequest{Text: text, Mrkdwn: true}, nil)
+	}
+
+	var resp postMessageResponse
+
+	if err := s.post(ctx, s.apiURL+"/chat.postMessage", s.cfg.Token, &postMessageRequest{
+		Channel:  channel,
+		Text:     text,
+		ThreadTS: threadTS,
+		Mrkdwn:   true,
+	}, &resp); err != nil {
+		return "", err
+	}
+
+	if !resp.OK {
+		return "", fmt.Errorf("failed to post message: %s", resp.Error)
+	}
+
+	return resp.TS, nil
+}
+
+// post sends the request as JSON, decoding the response into out if it's set.
+func (s *slack) post(ctx context.Context, url, token string, body *postMessageRequest, out any) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return fmt.Errorf("%w, retry after %ss", ErrRateLimited, resp.Header.Get("Retry-After"))
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	if err := json.Unmarshal(respBody, out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSlack_Disabled(t *testing.T) {
+	assert.Nil(t, NewSlack(nil, nil))
+	assert.Nil(t, NewSlack(&Config{}, nil))
+}
+
+func TestPostMessage_API(t *testing.T) {
+	var received postMessageRequest
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/chat.postMessage", r.URL.Path)
+		assert.Equal(t, "Bearer xoxb-token", r.Header.Get("Authorization"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+
+		_, _ = w.Write([]byte(`{"ok":true,"ts":"1700000000.000100"}`))
+	}))
+	defer server.Close()
+
+	client := NewSlack(&Config{Token: "xoxb-token", Channels: map[string]string{"*": "C123"}}, server.Client())
+	client.(*slack).apiURL = server.URL
+
+	assert.True(t, client.SupportsThreads())
+
+	ts, err := client.PostMessage(context.Background(), "C123", "*hello*", "1699999999.000100")
+	require.NoError(t, err)
+	assert.Equal(t, "1700000000.000100", ts)
+	assert.Equal(t, postMessageRequest{Channel: "C123", Text: "*hello*", ThreadTS: "1699999999.000100", Mrkdwn: true}, received)
+}
+
+func TestPostMessage_APIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"ok":false,"error":"channel_not_found"}`))
+	}))
+	defer server.Close()
+
+	client := NewSlack(&Config{Token: "xoxb-token"}, server.Client())
+	client.(*slack).apiURL = server.URL
+
+	_, err := client.PostMessage(context.Background(), "C404", "hello", "")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "channel_not_found")
+}
+
+func TestPostMessage_RateLimited(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Retry-After", "30")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client := NewSlack(&Config{WebhookURL: server.URL}, server.Client())
+
+	_, err := client.PostMessage(context.Background(), "", "hello", "")
+	require.ErrorIs(t, err, ErrRateLimited)
+	assert.Contains(t, err.Error(), "retry after 30s")
+}
+
+func TestPostMessage_Webhook(t *testing.T) {
+	var received postMessageRequest
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("Authorization"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	client := NewSlack(&Config{WebhookURL: server.URL}, server.Client())
+
+	assert.False(t, client.SupportsThreads())
+
+	channel, ok := client.Channel("devnet-0", "lighthouse")
+	assert.True(t, ok)
+	assert.Empty(t, channel)
+
+	ts, err := client.PostMessage(context.Background(), "C123", "hello", "1699999999.000100")
+	require.NoError(t, err)
+	assert.Empty(t, ts)
+	assert.Equal(t, postMessageRequest{Text: "hello", Mrkdwn: true}, received)
+}
@@ -0,0 +1,19 @@
+package store
+
+import "time"
+
+// AlertAction records someone acknowledging, snoozing or muting an alert from its notification.
+// Checks keep running and incidents are still tracked, only the alert's notifications are held:
+//   - Acknowledged holds them until the client recovers.
+//   - Snoozed holds them until the action's Until.
+//   - Muted holds them until the alert is unmuted.
+type AlertAction struct {
+	User  string    `json:"user"`
+	At    time.Time `json:"at"`
+	Until time.Time `json:"until,omitzero"` // When a snooze expires.
+}
+
+// IsSnoozed returns true if the alert's notifications are snoozed at the given time.
+func (a *MonitorAlert) IsSnoozed(now time.Time) bool {
+	return a.Snoozed != nil && now.Before(a.Snoozed.Until)
+}
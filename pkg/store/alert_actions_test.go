@@ -0,0 +1,48 @@
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMonitorRepo_AlertActions(t *testing.T) {
+	setupTest(t)
+
+	ctx := context.Background()
+
+	repo, err := NewMonitorRepo(ctx, logrus.New(), &S3Config{
+		Bucket:    testBucket,
+		Prefix:    "test",
+		Backend:   BackendFS,
+		Directory: t.TempDir(),
+	}, NewMetrics("test"))
+	require.NoError(t, err)
+
+	missing, err := repo.Get(ctx, "devnet-0", "geth")
+	require.NoError(t, err)
+	assert.Nil(t, missing)
+
+	now := time.Now().UTC().Truncate(time.Second)
+
+	require.NoError(t, repo.Persist(ctx, &MonitorAlert{
+		Network: "devnet-0",
+		Client:  "geth",
+		Enabled: true,
+		Snoozed: &AlertAction{User: "alice", At: now, Until: now.Add(24 * time.Hour)},
+		Muted:   &AlertAction{User: "bob", At: now},
+	}))
+
+	alert, err := repo.Get(ctx, "devnet-0", "geth")
+	require.NoError(t, err)
+	require.NotNil(t, alert)
+	assert.Nil(t, alert.Acknowledged)
+	assert.Equal(t, "bob", alert.Muted.User)
+	assert.True(t, alert.Muted.Until.IsZero())
+	assert.True(t, alert.IsSnoozed(now))
+	assert.False(t, alert.IsSnoozed(now.Add(25*time.Hour)))
+}
@@ -2,6 +2,7 @@ package store
 
 import (
 	"bytes"
+	"compress/gzip"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -15,12 +16,20 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
+const (
+	// artifactEncodingKey is the S3 metadata key marking how an artifact's content is encoded.
+	artifactEncodingKey = "content-encoding"
+	// artifactEncodingGzip marks gzip compressed content. Artifacts without the marker were stored
+	// before logs were compressed, and are read back as-is.
+	artifactEncodingGzip = "gzip"
+)
+
 // CheckArtifact represents a single artifact from a check run.
 type CheckArtifact struct {
 	Network   string    `json:"network"`
 	Client    string    `json:"client"`
 	CheckID   string    `json:"checkId"`
-	Type      string    `json:"type"` // log, png, etc
+	Type      string    `json:"type"` // log, query, png, etc
 	CreatedAt time.Time `json:"createdAt"`
 	UpdatedAt time.Time `json:"updatedAt"`
 	Content   []byte    `json:"content"`
@@ -139,12 +148,25 @@ func (s *ChecksRepo) Persist(ctx context.Context, artifact *CheckArtifact) error
 	}
 
 	if len(artifact.Content) > 0 {
-		contentType := http.DetectContentType(artifact.Content)
+		content := artifact.Content
+		put.ContentType = aws.String(http.DetectContentType(content))
+
+		// Logs, query responses and structured results are verbose plain text and compress well.
+		if artifact.Type == "log" || artifact.Type == "query" || artifact.Type == "results" {
+			compressed, err := compressArtifact(content)
+			if err != nil {
+				s.observeOperation("persist", "checks", err)
+
+				return fmt.Errorf("failed to compress artifact: %w", err)
+			}
+
+			content = compressed
+			put.Metadata = map[string]string{artifactEncodingKey: artifactEncodingGzip}
+		}
 
-		put.Body = bytes.NewReader(artifact.Content)
-		put.ContentType = aws.String(contentType)
+		put.Body = bytes.NewReader(content)
 
-		s.metrics.objectSizeBytes.WithLabelValues("checks").Observe(float64(len(artifact.Content)))
+		s.metrics.objectSizeBytes.WithLabelValues("checks").Observe(float64(len(content)))
 	}
 
 	if _, err := s.store.PutObject(ctx, put); err != nil {
@@ -233,8 +255,8 @@ func (s *ChecksRepo) GetPrefix() string {
 	return s.prefix
 }
 
-// GetStore returns the S3 client.
-func (s *ChecksRepo) GetStore() *s3.Client {
+// GetStore returns the underlying object store.
+func (s *ChecksRepo) GetStore() ObjectStore {
 	return s.store
 }
 
@@ -264,9 +286,19 @@ func (s *ChecksRepo) GetArtifact(ctx context.Context, network, client, checkID,
 		return nil, fmt.Errorf("failed to read artifact content: %w", err)
 	}
 
-	s.observeOperation("get", "checks", nil)
 	s.metrics.objectSizeBytes.WithLabelValues("checks").Observe(float64(len(content)))
 
+	if output.Metadata[artifactEncodingKey] == artifactEncodingGzip {
+		content, err = decompressArtifact(content)
+		if err != nil {
+			s.observeOperation("get", "checks", err)
+
+			return nil, fmt.Errorf("failed to decompress artifact: %w", err)
+		}
+	}
+
+	s.observeOperation("get", "checks", nil)
+
 	return &CheckArtifact{
 		Network:   network,
 		Client:    client,
@@ -277,3 +309,32 @@ func (s *ChecksRepo) GetArtifact(ctx context.Context, network, client, checkID,
 		Content:   content,
 	}, nil
 }
+
+// compressArtifact gzips an artifact's content.
+func compressArtifact(content []byte) ([]byte, error) {
+	var buf bytes.Buffer
+
+	gz := gzip.NewWriter(&buf)
+
+	if _, err := gz.Write(content); err != nil {
+		return nil, err
+	}
+
+	if err := gz.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// decompressArtifact reverses compressArtifact.
+func decompressArtifact(content []byte) ([]byte, error) {
+	gz, err := gzip.NewReader(bytes.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+
+	defer gz.Close()
+
+	return io.ReadAll(gz)
+}
@@ -1,10 +1,16 @@
 package store
 
 import (
+	"bytes"
 	"context"
+	"fmt"
+	"io"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -84,6 +90,69 @@ func TestChecksRepo(t *testing.T) {
 		assert.Equal(t, content, retrieved.Content)
 	})
 
+	t.Run("Log_Compression", func(t *testing.T) {
+		setupTest(t)
+		repo, err := NewChecksRepo(ctx, helper.log, helper.cfg, NewMetrics("test"))
+		require.NoError(t, err)
+
+		artifact := &CheckArtifact{
+			Network:   "test-net",
+			Client:    "test-client",
+			CheckID:   "test-check",
+			Type:      "log",
+			CreatedAt: time.Now().UTC(),
+			UpdatedAt: time.Now().UTC(),
+			Content:   largeLog(),
+		}
+
+		err = repo.Persist(ctx, artifact)
+		require.NoError(t, err)
+
+		// The stored object is compressed and marked as such.
+		output, err := repo.GetStore().GetObject(ctx, &s3.GetObjectInput{
+			Bucket: aws.String(repo.GetBucket()),
+			Key:    aws.String(repo.Key(artifact)),
+		})
+		require.NoError(t, err)
+
+		defer output.Body.Close()
+
+		stored, err := io.ReadAll(output.Body)
+		require.NoError(t, err)
+		assert.Less(t, len(stored), len(artifact.Content))
+		assert.Equal(t, artifactEncodingGzip, output.Metadata[artifactEncodingKey])
+
+		retrieved, err := repo.GetArtifact(ctx, artifact.Network, artifact.Client, artifact.CheckID, artifact.Type)
+		require.NoError(t, err)
+		assert.Equal(t, artifact.Content, retrieved.Content)
+	})
+
+	t.Run("Legacy_Uncompressed_Log", func(t *testing.T) {
+		setupTest(t)
+		repo, err := NewChecksRepo(ctx, helper.log, helper.cfg, NewMetrics("test"))
+		require.NoError(t, err)
+
+		artifact := &CheckArtifact{
+			Network: "test-net",
+			Client:  "test-client",
+			CheckID: "test-check",
+			Type:    "log",
+		}
+		content := []byte("legacy log content")
+
+		// Written the way logs were before compression was added.
+		_, err = repo.GetStore().PutObject(ctx, &s3.PutObjectInput{
+			Bucket: aws.String(repo.GetBucket()),
+			Key:    aws.String(repo.Key(artifact)),
+			Body:   bytes.NewReader(content),
+		})
+		require.NoError(t, err)
+
+		retrieved, err := repo.GetArtifact(ctx, artifact.Network, artifact.Client, artifact.CheckID, artifact.Type)
+		require.NoError(t, err)
+		assert.Equal(t, content, retrieved.Content)
+	})
+
 	t.Run("Purge", func(t *testing.T) {
 		setupTest(t)
 		repo, err := NewChecksRepo(ctx, helper.log, helper.cfg, NewMetrics("test"))
@@ -163,3 +232,29 @@ func TestChecksRepo(t *testing.T) {
 		assert.NotNil(t, repo.GetStore())
 	})
 }
+
+func TestCompressArtifact(t *testing.T) {
+	content := largeLog()
+
+	compressed, err := compressArtifact(content)
+	require.NoError(t, err)
+	assert.Less(t, len(compressed), len(content))
+
+	decompressed, err := decompressArtifact(compressed)
+	require.NoError(t, err)
+	assert.Equal(t, content, decompressed)
+
+	_, err = decompressArtifact(content)
+	require.Error(t, err)
+}
+
+// largeLog returns a check log large enough to be worth compressing.
+func largeLog() []byte {
+	var sb strings.Builder
+
+	for idx := range 10000 {
+		fmt.Fprintf(&sb, "time=\"2025-01-01T00:00:00Z\" level=info msg=\"Running check\" check=%d network=test-net client=test-client\n", idx)
+	}
+
+	return []byte(sb.String())
+}
@@ -1,6 +1,13 @@
 package store
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrNoPreviousSummary is returned when a network has no stored Hive summary to compare against,
+// such as on its first summary run.
+var ErrNoPreviousSummary = errors.New("no previous summary results found")
 
 // AlertAlreadyRegisteredError represents an error when trying to register an alert that already exists.
 type AlertAlreadyRegisteredError struct {
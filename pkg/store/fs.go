@@ -0,0 +1,297 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+const (
+	// fsMetadataSuffix names the sidecar file an object's metadata is kept in, alongside the object.
+	fsMetadataSuffix = ".metadata.json"
+	// fsMaxKeys is the most keys a listing returns at once when not asked for fewer, as with S3.
+	fsMaxKeys = 1000
+)
+
+// FSStore is an ObjectStore keeping objects as files under a directory, at the same key paths they'd
+// have in S3. It's meant for local development and deployments without S3, the bucket is ignored.
+// Files and directories starting with a dot are skipped when listing, they hold object metadata and
+// in-progress writes.
+type FSStore struct {
+	root string
+}
+
+// NewFSStore creates a new FSStore under the given directory, creating it if need be.
+func NewFSStore(dir string) (*FSStore, error) {
+	if dir == "" {
+		return nil, errors.New("no directory given")
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	return &FSStore{root: dir}, nil
+}
+
+// ListBuckets implements ObjectStore, there's only ever the directory.
+func (f *FSStore) ListBuckets(_ context.Context, _ *s3.ListBucketsInput, _ ...func(*s3.Options)) (*s3.ListBucketsOutput, error) {
+	return &s3.ListBucketsOutput{}, nil
+}
+
+// HeadBucket implements ObjectStore, checking the directory is still there.
+func (f *FSStore) HeadBucket(_ context.Context, _ *s3.HeadBucketInput, _ ...func(*s3.Options)) (*s3.HeadBucketOutput, error) {
+	info, err := os.Stat(f.root)
+	if err != nil {
+		return nil, err
+	}
+
+	if !info.IsDir() {
+		return nil, fmt.Errorf("%s is not a directory", f.root)
+	}
+
+	return &s3.HeadBucketOutput{}, nil
+}
+
+// PutObject implements ObjectStore. The object is written to a temporary file first, so a failed
+// write never leaves a partial object behind.
+func (f *FSStore) PutObject(_ context.Context, input *s3.PutObjectInput, _ ...func(*s3.Options)) (*s3.PutObjectOutput, error) {
+	name, err := f.path(aws.ToString(input.Key))
+	if err != nil {
+		return nil, err
+	}
+
+	var data []byte
+
+	if input.Body != nil {
+		if data, err = io.ReadAll(input.Body); err != nil {
+			return nil, fmt.Errorf("failed to read body: %w", err)
+		}
+	}
+
+	if err = os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	if err = writeFileAtomic(name, data); err != nil {
+		return nil, err
+	}
+
+	// Drop any metadata left by an earlier version of the object.
+	if len(input.Metadata) == 0 {
+		if err = os.Remove(metadataPath(name)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("failed to remove metadata: %w", err)
+		}
+
+		return &s3.PutObjectOutput{}, nil
+	}
+
+	metadata, err := json.Marshal(input.Metadata)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal metadata: %w", err)
+	}
+
+	if err = writeFileAtomic(metadataPath(name), metadata); err != nil {
+		return nil, err
+	}
+
+	return &s3.PutObjectOutput{}, nil
+}
+
+// GetObject implements ObjectStore, returning a types.NoSuchKey error for missing objects as S3
+// does. The caller must close the body.
+func (f *FSStore) GetObject(_ context.Context, input *s3.GetObjectInput, _ ...func(*s3.Options)) (*s3.GetObjectOutput, error) {
+	name, err := f.path(aws.ToString(input.Key))
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(name)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, &types.NoSuchKey{Message: aws.String(fmt.Sprintf("key %s not found", aws.ToString(input.Key)))}
+		}
+
+		return nil, err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+
+		return nil, err
+	}
+
+	var metadata map[string]string
+
+	if data, rerr := os.ReadFile(metadataPath(name)); rerr == nil {
+		if err = json.Unmarshal(data, &metadata); err != nil {
+			file.Close()
+
+			return nil, fmt.Errorf("failed to decode metadata: %w", err)
+		}
+	}
+
+	return &s3.GetObjectOutput{
+		Body:          file,
+		ContentLength: aws.Int64(info.Size()),
+		LastModified:  aws.Time(info.ModTime()),
+		Metadata:      metadata,
+	}, nil
+}
+
+// DeleteObject implements ObjectStore. Deleting a missing object isn't an error, as with S3.
+func (f *FSStore) DeleteObject(_ context.Context, input *s3.DeleteObjectInput, _ ...func(*s3.Options)) (*s3.DeleteObjectOutput, error) {
+	name, err := f.path(aws.ToString(input.Key))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, file := range []string{name, metadataPath(name)} {
+		if err := os.Remove(file); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+	}
+
+	return &s3.DeleteObjectOutput{}, nil
+}
+
+// ListObjectsV2 implements ObjectStore, listing the keys starting with the prefix in the same
+// order as S3 and paginating the same way. Delimiters aren't supported.
+func (f *FSStore) ListObjectsV2(
+	_ context.Context,
+	input *s3.ListObjectsV2Input,
+	_ ...func(*s3.Options),
+) (*s3.ListObjectsV2Output, error) {
+	var (
+		prefix  = aws.ToString(input.Prefix)
+		after   = max(aws.ToString(input.StartAfter), aws.ToString(input.ContinuationToken))
+		maxKeys = fsMaxKeys
+		objects = make([]types.Object, 0)
+	)
+
+	if input.MaxKeys != nil && *input.MaxKeys > 0 {
+		maxKeys = int(*input.MaxKeys)
+	}
+
+	// Only walk the deepest directory the prefix is sure to be under.
+	dir, err := f.path(path.Dir(prefix + "x"))
+	if err != nil {
+		return nil, err
+	}
+
+	err = filepath.WalkDir(dir, func(name string, entry fs.DirEntry, werr error) error {
+		if werr != nil {
+			return werr
+		}
+
+		if name != dir && strings.HasPrefix(entry.Name(), ".") {
+			if entry.IsDir() {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if entry.IsDir() {
+			return nil
+		}
+
+		rel, rerr := filepath.Rel(f.root, name)
+		if rerr != nil {
+			return rerr
+		}
+
+		key := filepath.ToSlash(rel)
+		if !strings.HasPrefix(key, prefix) || key <= after {
+			return nil
+		}
+
+		info, ierr := entry.Info()
+		if ierr != nil {
+			return ierr
+		}
+
+		objects = append(objects, types.Object{
+			Key:          aws.String(key),
+			Size:         aws.Int64(info.Size()),
+			LastModified: aws.Time(info.ModTime()),
+		})
+
+		return nil
+	})
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("failed to walk %s: %w", dir, err)
+	}
+
+	slices.SortFunc(objects, func(a, b types.Object) int {
+		return strings.Compare(*a.Key, *b.Key)
+	})
+
+	output := &s3.ListObjectsV2Output{
+		Prefix:      input.Prefix,
+		IsTruncated: aws.Bool(len(objects) > maxKeys),
+	}
+
+	if len(objects) > maxKeys {
+		objects = objects[:maxKeys]
+		output.NextContinuationToken = objects[maxKeys-1].Key
+	}
+
+	output.Contents = objects
+	output.KeyCount = aws.Int32(int32(len(objects))) //nolint:gosec // Capped at maxKeys.
+
+	return output, nil
+}
+
+// path returns the file a key is kept in, refusing keys that would escape the directory.
+func (f *FSStore) path(key string) (string, error) {
+	if !filepath.IsLocal(filepath.FromSlash(key)) {
+		return "", fmt.Errorf("invalid key %q", key)
+	}
+
+	return filepath.Join(f.root, filepath.FromSlash(key)), nil
+}
+
+// metadataPath returns the sidecar file an object's metadata is kept in.
+func metadataPath(name string) string {
+	return filepath.Join(filepath.Dir(name), "."+filepath.Base(name)+fsMetadataSuffix)
+}
+
+// writeFileAtomic writes data to a temporary file next to name, then renames it into place.
+func writeFileAtomic(name string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(name), "."+filepath.Base(name)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file: %w", err)
+	}
+
+	defer os.Remove(tmp.Name())
+
+	if _, err = tmp.Write(data); err != nil {
+		tmp.Close()
+
+		return fmt.Errorf("failed to write temporary file: %w", err)
+	}
+
+	if err = tmp.Close(); err != nil {
+		return fmt.Errorf("failed to close temporary file: %w", err)
+	}
+
+	if err = os.Rename(tmp.Name(), name); err != nil {
+		return fmt.Errorf("failed to rename temporary file: %w", err)
+	}
+
+	return nil
+}
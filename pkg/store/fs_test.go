@@ -0,0 +1,180 @@
+package store
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFSStore(t *testing.T) {
+	ctx := context.Background()
+
+	newConfig := func(t *testing.T) *S3Config {
+		t.Helper()
+
+		return &S3Config{
+			Bucket:    testBucket,
+			Prefix:    "test",
+			Backend:   BackendFS,
+			Directory: t.TempDir(),
+		}
+	}
+
+	t.Run("MonitorRepo", func(t *testing.T) {
+		setupTest(t)
+
+		cfg := newConfig(t)
+
+		repo, err := NewMonitorRepo(ctx, logrus.New(), cfg, NewMetrics("test"))
+		require.NoError(t, err)
+		require.NoError(t, repo.VerifyConnection(ctx))
+		assert.Nil(t, repo.GetS3Client())
+
+		alerts, err := repo.List(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, alerts)
+
+		alert := &MonitorAlert{
+			Network:        "test-net",
+			Client:         "test-client",
+			Enabled:        true,
+			DiscordChannel: "test-channel",
+			Interval:       time.Hour,
+		}
+
+		require.NoError(t, repo.Persist(ctx, alert))
+		assert.FileExists(t, filepath.Join(cfg.Directory, "test", "networks", "test-net", "monitor", "test-client.json"))
+
+		alerts, err = repo.List(ctx)
+		require.NoError(t, err)
+		require.Len(t, alerts, 1)
+		assert.Equal(t, alert.DiscordChannel, alerts[0].DiscordChannel)
+		assert.Equal(t, alert.Interval, alerts[0].Interval)
+
+		require.NoError(t, repo.Purge(ctx, alert.Network, alert.Client))
+
+		alerts, err = repo.List(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, alerts)
+	})
+
+	t.Run("ChecksRepo_Compressed", func(t *testing.T) {
+		setupTest(t)
+
+		repo, err := NewChecksRepo(ctx, logrus.New(), newConfig(t), NewMetrics("test"))
+		require.NoError(t, err)
+
+		artifact := &CheckArtifact{
+			Network: "test-net",
+			Client:  "test-client",
+			CheckID: "check-1",
+			Type:    "log",
+			Content: []byte("some verbose check log"),
+		}
+
+		require.NoError(t, repo.Persist(ctx, artifact))
+
+		got, err := repo.GetArtifact(ctx, artifact.Network, artifact.Client, artifact.CheckID, artifact.Type)
+		require.NoError(t, err)
+		assert.Equal(t, artifact.Content, got.Content)
+	})
+
+	t.Run("Missing_Key", func(t *testing.T) {
+		store, err := NewFSStore(t.TempDir())
+		require.NoError(t, err)
+
+		_, err = store.GetObject(ctx, &s3.GetObjectInput{Key: aws.String("test/missing.json")})
+
+		var noSuchKey *types.NoSuchKey
+		require.ErrorAs(t, err, &noSuchKey)
+
+		_, err = store.DeleteObject(ctx, &s3.DeleteObjectInput{Key: aws.String("test/missing.json")})
+		require.NoError(t, err)
+	})
+
+	t.Run("Invalid_Key", func(t *testing.T) {
+		store, err := NewFSStore(t.TempDir())
+		require.NoError(t, err)
+
+		_, err = store.GetObject(ctx, &s3.GetObjectInput{Key: aws.String("../outside.json")})
+		require.Error(t, err)
+	})
+
+	t.Run("Metadata_Replaced", func(t *testing.T) {
+		dir := t.TempDir()
+
+		store, err := NewFSStore(dir)
+		require.NoError(t, err)
+
+		_, err = store.PutObject(ctx, &s3.PutObjectInput{
+			Key:      aws.String("test/a.log"),
+			Metadata: map[string]string{artifactEncodingKey: artifactEncodingGzip},
+		})
+		require.NoError(t, err)
+
+		_, err = store.PutObject(ctx, &s3.PutObjectInput{Key: aws.String("test/a.log")})
+		require.NoError(t, err)
+
+		output, err := store.GetObject(ctx, &s3.GetObjectInput{Key: aws.String("test/a.log")})
+		require.NoError(t, err)
+		require.NoError(t, output.Body.Close())
+		assert.Empty(t, output.Metadata)
+
+		// Only the object itself is left behind.
+		entries, err := os.ReadDir(filepath.Join(dir, "test"))
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		assert.Equal(t, "a.log", entries[0].Name())
+	})
+
+	t.Run("List_Paginated", func(t *testing.T) {
+		store, err := NewFSStore(t.TempDir())
+		require.NoError(t, err)
+
+		keys := []string{"test/b/2.json", "test/a.json", "test/b/1.json", "other/c.json"}
+		for _, key := range keys {
+			_, err = store.PutObject(ctx, &s3.PutObjectInput{Key: aws.String(key)})
+			require.NoError(t, err)
+		}
+
+		var (
+			listed    []string
+			paginator = s3.NewListObjectsV2Paginator(store, &s3.ListObjectsV2Input{
+				Prefix:  aws.String("test/"),
+				MaxKeys: aws.Int32(2),
+			})
+			pages int
+		)
+
+		for paginator.HasMorePages() {
+			page, perr := paginator.NextPage(ctx)
+			require.NoError(t, perr)
+
+			for _, obj := range page.Contents {
+				listed = append(listed, *obj.Key)
+			}
+
+			pages++
+		}
+
+		assert.Equal(t, []string{"test/a.json", "test/b/1.json", "test/b/2.json"}, listed)
+		assert.Equal(t, 2, pages)
+	})
+}
+
+func TestValidateBackend(t *testing.T) {
+	require.NoError(t, ValidateBackend("", ""))
+	require.NoError(t, ValidateBackend(BackendS3, ""))
+	require.NoError(t, ValidateBackend(BackendFS, "/data"))
+	require.Error(t, ValidateBackend(BackendFS, ""))
+	require.Error(t, ValidateBackend("gcs", ""))
+}
@@ -0,0 +1,184 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/sirupsen/logrus"
+)
+
+// GuildConfig holds a guild's overrides of the global config, keyed by setting name. Settings
+// without an override fall back to the global config.
+type GuildConfig struct {
+	GuildID   string            `json:"guildId"`
+	Overrides map[string]string `json:"overrides"`
+	UpdatedBy string            `json:"updatedBy"` // Discord user ID of whoever last changed the overrides.
+	UpdatedAt time.Time         `json:"updatedAt"`
+}
+
+// GuildConfigRepo implements Repository[*GuildConfig].
+type GuildConfigRepo struct {
+	BaseRepo
+}
+
+// NewGuildConfigRepo creates a new GuildConfigRepo.
+func NewGuildConfigRepo(ctx context.Context, log *logrus.Logger, cfg *S3Config, metrics *Metrics) (*GuildConfigRepo, error) {
+	baseRepo, err := NewBaseRepo(ctx, log, cfg, metrics)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create base repo: %w", err)
+	}
+
+	return &GuildConfigRepo{
+		BaseRepo: baseRepo,
+	}, nil
+}
+
+// List implements Repository[*GuildConfig].
+func (s *GuildConfigRepo) List(ctx context.Context) ([]*GuildConfig, error) {
+	defer s.trackDuration("list", "guild_config")()
+
+	var (
+		input = &s3.ListObjectsV2Input{
+			Bucket: aws.String(s.bucket),
+			Prefix: aws.String(fmt.Sprintf("%s/guilds/", s.prefix)),
+		}
+		records   []*GuildConfig
+		paginator = s3.NewListObjectsV2Paginator(s.store, input)
+	)
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			s.observeOperation("list", "guild_config", err)
+
+			return nil, fmt.Errorf("failed to list guild config: %w", err)
+		}
+
+		for _, obj := range page.Contents {
+			if !strings.HasSuffix(*obj.Key, "/config.json") {
+				continue
+			}
+
+			record, err := s.getGuildConfig(ctx, *obj.Key)
+			if err != nil {
+				s.log.Errorf("Failed to get guild config %s: %v", *obj.Key, err)
+
+				continue
+			}
+
+			records = append(records, record)
+		}
+	}
+
+	s.metrics.objectsTotal.WithLabelValues("guild_config").Set(float64(len(records)))
+
+	return records, nil
+}
+
+// Get retrieves a guild's config overrides, or nil if it has none.
+func (s *GuildConfigRepo) Get(ctx context.Context, guildID string) (*GuildConfig, error) {
+	defer s.trackDuration("get", "guild_config")()
+
+	record, err := s.getGuildConfig(ctx, s.Key(&GuildConfig{GuildID: guildID}))
+	if err != nil {
+		var noSuchKey *types.NoSuchKey
+
+		if errors.As(err, &noSuchKey) {
+			s.observeOperation("get", "guild_config", nil) // Not really an error in this case
+
+			return nil, nil
+		}
+
+		s.observeOperation("get", "guild_config", err)
+
+		return nil, err
+	}
+
+	s.observeOperation("get", "guild_config", nil)
+
+	return record, nil
+}
+
+// Persist implements Repository[*GuildConfig].
+func (s *GuildConfigRepo) Persist(ctx context.Context, record *GuildConfig) error {
+	defer s.trackDuration("persist", "guild_config")()
+
+	data, err := json.Marshal(record)
+	if err != nil {
+		s.observeOperation("persist", "guild_config", err)
+
+		return fmt.Errorf("failed to marshal guild config: %w", err)
+	}
+
+	s.metrics.objectSizeBytes.WithLabelValues("guild_config").Observe(float64(len(data)))
+
+	if _, err = s.store.PutObject(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.Key(record)),
+		Body:   bytes.NewReader(data),
+	}); err != nil {
+		s.observeOperation("persist", "guild_config", err)
+
+		return fmt.Errorf("failed to put guild config: %w", err)
+	}
+
+	s.observeOperation("persist", "guild_config", nil)
+
+	return nil
+}
+
+// Purge implements Repository[*GuildConfig].
+func (s *GuildConfigRepo) Purge(ctx context.Context, identifiers ...string) error {
+	if len(identifiers) != 1 {
+		return fmt.Errorf("expected guild identifier, got %d identifiers", len(identifiers))
+	}
+
+	guildID := identifiers[0]
+
+	if _, err := s.store.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.Key(&GuildConfig{GuildID: guildID})),
+	}); err != nil {
+		return fmt.Errorf("failed to delete guild config: %w", err)
+	}
+
+	return nil
+}
+
+// Key implements Repository[*GuildConfig].
+func (s *GuildConfigRepo) Key(record *GuildConfig) string {
+	if record == nil {
+		s.log.Error("guild config is nil")
+
+		return ""
+	}
+
+	return fmt.Sprintf("%s/guilds/%s/config.json", s.prefix, record.GuildID)
+}
+
+func (s *GuildConfigRepo) getGuildConfig(ctx context.Context, key string) (*GuildConfig, error) {
+	output, err := s.store.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get guild config: %w", err)
+	}
+
+	defer output.Body.Close()
+
+	var record GuildConfig
+	if err := json.NewDecoder(output.Body).Decode(&record); err != nil {
+		return nil, fmt.Errorf("failed to decode guild config: %w", err)
+	}
+
+	return &record, nil
+}
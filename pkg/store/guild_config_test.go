@@ -0,0 +1,73 @@
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGuildConfigRepo(t *testing.T) {
+	ctx := context.Background()
+	helper := newTestHelper(t)
+	helper.setup(ctx)
+	defer helper.teardown(ctx)
+
+	t.Run("Get_No_Overrides", func(t *testing.T) {
+		setupTest(t)
+		repo, err := NewGuildConfigRepo(ctx, helper.log, helper.cfg, NewMetrics("test"))
+		require.NoError(t, err)
+
+		record, err := repo.Get(ctx, "test-guild")
+		require.NoError(t, err)
+		assert.Nil(t, record)
+	})
+
+	t.Run("Persist_And_Get", func(t *testing.T) {
+		setupTest(t)
+		repo, err := NewGuildConfigRepo(ctx, helper.log, helper.cfg, NewMetrics("test"))
+		require.NoError(t, err)
+
+		record := &GuildConfig{
+			GuildID:   "test-guild",
+			Overrides: map[string]string{"grafana-url": "https://grafana.example.com"},
+			UpdatedBy: "user",
+			UpdatedAt: time.Now().UTC().Truncate(time.Second),
+		}
+
+		require.NoError(t, repo.Persist(ctx, record))
+
+		got, err := repo.Get(ctx, "test-guild")
+		require.NoError(t, err)
+		require.NotNil(t, got)
+		assert.Equal(t, record.Overrides, got.Overrides)
+		assert.True(t, record.UpdatedAt.Equal(got.UpdatedAt))
+
+		records, err := repo.List(ctx)
+		require.NoError(t, err)
+		assert.Len(t, records, 1)
+	})
+
+	t.Run("Purge", func(t *testing.T) {
+		setupTest(t)
+		repo, err := NewGuildConfigRepo(ctx, helper.log, helper.cfg, NewMetrics("test"))
+		require.NoError(t, err)
+
+		require.NoError(t, repo.Purge(ctx, "test-guild"))
+
+		records, err := repo.List(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, records)
+	})
+
+	t.Run("Key_Generation", func(t *testing.T) {
+		setupTest(t)
+		repo, err := NewGuildConfigRepo(ctx, helper.log, helper.cfg, NewMetrics("test"))
+		require.NoError(t, err)
+
+		key := repo.Key(&GuildConfig{GuildID: "test-guild"})
+		assert.Equal(t, "test/guilds/test-guild/config.json", key)
+	})
+}
@@ -0,0 +1,169 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+// SummaryRetention controls how long Hive summary results are kept. Results are kept daily for a
+// while, then thinned out to the newest of each week, so trends over the longer term can still be
+// looked at without the results growing forever. Pruning is opt in, nothing is pruned unless Daily
+// is set.
+type SummaryRetention struct {
+	// Daily is how long every result is kept. Unset, or not positive, keeps everything.
+	Daily time.Duration `yaml:"daily"`
+	// Weekly is how long a result a week is kept for, after which they're pruned too. Zero keeps
+	// them forever.
+	Weekly time.Duration `yaml:"weekly"`
+}
+
+// Enabled returns whether any results are pruned.
+func (r SummaryRetention) Enabled() bool {
+	return r.Daily > 0
+}
+
+// PruneReport counts the summary results pruned, and kept, by PruneResults.
+type PruneReport struct {
+	Pruned int
+	Kept   int
+}
+
+// summaryResultDate is a stored summary result's key and the day it's for.
+type summaryResultDate struct {
+	key  string
+	date time.Time
+}
+
+// PruneResults prunes the network's stored summary results, for every suite, according to the
+// retention policy. Results whose day can't be parsed are left alone.
+func (s *HiveSummaryRepo) PruneResults(ctx context.Context, network string, policy SummaryRetention) (*PruneReport, error) {
+	defer s.trackDuration("prune", "hive_summary_result")()
+
+	report := &PruneReport{}
+
+	if !policy.Enabled() {
+		return report, nil
+	}
+
+	results, err := s.listResultDates(ctx, network)
+	if err != nil {
+		s.observeOperation("prune", "hive_summary_result", err)
+
+		return nil, err
+	}
+
+	for _, dates := range results {
+		prunable := selectPrunableResults(dates, policy, time.Now().In(s.dates.location))
+
+		for _, key := range prunable {
+			if _, err := s.store.DeleteObject(ctx, &s3.DeleteObjectInput{
+				Bucket: aws.String(s.bucket),
+				Key:    aws.String(key),
+			}); err != nil {
+				s.observeOperation("prune", "hive_summary_result", err)
+
+				return report, fmt.Errorf("failed to delete %s: %w", key, err)
+			}
+
+			report.Pruned++
+		}
+
+		report.Kept += len(dates) - len(prunable)
+	}
+
+	s.observeOperation("prune", "hive_summary_result", nil)
+
+	return report, nil
+}
+
+// listResultDates lists the network's stored summary results, grouped by the directory they're
+// kept in, so results for each suite are pruned separately.
+func (s *HiveSummaryRepo) listResultDates(ctx context.Context, network string) (map[string][]summaryResultDate, error) {
+	var (
+		results   = make(map[string][]summaryResultDate)
+		paginator = s3.NewListObjectsV2Paginator(s.store, &s3.ListObjectsV2Input{
+			Bucket: aws.String(s.bucket),
+			Prefix: aws.String(fmt.Sprintf("%s/networks/%s/hive_summary/", s.prefix, network)),
+		})
+	)
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list summary results: %w", err)
+		}
+
+		for _, obj := range page.Contents {
+			var (
+				key      = aws.ToString(obj.Key)
+				dir      = path.Dir(key)
+				filename = path.Base(key)
+			)
+
+			if path.Base(dir) != "results" || !strings.HasSuffix(filename, ".json") {
+				continue
+			}
+
+			date, ok := s.dates.parse(strings.TrimSuffix(filename, ".json"))
+			if !ok {
+				continue
+			}
+
+			results[dir] = append(results[dir], summaryResultDate{key: key, date: date})
+		}
+	}
+
+	return results, nil
+}
+
+// selectPrunableResults returns the keys of the results the policy no longer keeps. Results within
+// the daily retention are all kept, older ones only if they're the newest of their week and within
+// the weekly retention.
+func selectPrunableResults(results []summaryResultDate, policy SummaryRetention, now time.Time) []string {
+	var (
+		dailyCutoff  = now.Add(-policy.Daily)
+		weeklyCutoff time.Time
+		newest       = make(map[string]summaryResultDate)
+	)
+
+	if policy.Weekly > 0 {
+		weeklyCutoff = now.Add(-policy.Weekly)
+	}
+
+	for _, result := range results {
+		if !result.date.Before(dailyCutoff) {
+			continue
+		}
+
+		if current, ok := newest[isoWeek(result.date)]; !ok || result.date.After(current.date) {
+			newest[isoWeek(result.date)] = result
+		}
+	}
+
+	prunable := make([]string, 0)
+
+	for _, result := range results {
+		if !result.date.Before(dailyCutoff) {
+			continue
+		}
+
+		if newest[isoWeek(result.date)].key != result.key || result.date.Before(weeklyCutoff) {
+			prunable = append(prunable, result.key)
+		}
+	}
+
+	return prunable
+}
+
+// isoWeek returns the ISO week a day falls in, such as "2025-W47".
+func isoWeek(date time.Time) string {
+	year, week := date.ISOWeek()
+
+	return fmt.Sprintf("%d-W%02d", year, week)
+}
@@ -0,0 +1,110 @@
+package store
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/ethpandaops/panda-pulse/pkg/hive"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelectPrunableResults(t *testing.T) {
+	var (
+		now     = time.Date(2025, time.November, 23, 12, 0, 0, 0, time.UTC) // A Sunday.
+		results = make([]summaryResultDate, 0)
+	)
+
+	// A result a day for the last 60 days.
+	for days := range 60 {
+		date := now.AddDate(0, 0, -days).Truncate(24 * time.Hour)
+		results = append(results, summaryResultDate{key: date.Format(DefaultSummaryDateFormat), date: date})
+	}
+
+	t.Run("daily then weekly", func(t *testing.T) {
+		prunable := selectPrunableResults(results, SummaryRetention{Daily: 30 * 24 * time.Hour}, now)
+
+		kept := make([]string, 0)
+
+		for _, result := range results {
+			if !slices.Contains(prunable, result.key) {
+				kept = append(kept, result.key)
+			}
+		}
+
+		// The last 30 days are all kept, older ones only on the last day of their week.
+		assert.Len(t, kept, 30+5)
+		assert.Contains(t, kept, "2025-10-25")
+		assert.Contains(t, kept, "2025-10-24", "the week straddling the cutoff keeps its newest older day")
+		assert.NotContains(t, kept, "2025-10-23")
+		assert.Contains(t, kept, "2025-10-19")
+		assert.Contains(t, kept, "2025-09-28")
+		assert.NotContains(t, kept, "2025-09-25")
+	})
+
+	t.Run("weekly expires", func(t *testing.T) {
+		prunable := selectPrunableResults(results, SummaryRetention{Daily: 30 * 24 * time.Hour, Weekly: 40 * 24 * time.Hour}, now)
+
+		assert.Len(t, prunable, 60-30-2)
+		assert.Contains(t, prunable, "2025-10-05")
+		assert.NotContains(t, prunable, "2025-10-19")
+	})
+
+	t.Run("nothing old enough", func(t *testing.T) {
+		assert.Empty(t, selectPrunableResults(results, SummaryRetention{Daily: 90 * 24 * time.Hour}, now))
+	})
+}
+
+func TestHiveSummaryRepo_PruneResults(t *testing.T) {
+	setupTest(t)
+
+	ctx := context.Background()
+
+	repo, err := NewHiveSummaryRepo(ctx, logrus.New(), &S3Config{
+		Bucket:    testBucket,
+		Prefix:    "test",
+		Backend:   BackendFS,
+		Directory: t.TempDir(),
+	}, NewMetrics("test"))
+	require.NoError(t, err)
+
+	now := time.Now().UTC()
+
+	for days := range 45 {
+		result := &hive.SummaryResult{Network: "devnet-0", Timestamp: now.AddDate(0, 0, -days)}
+
+		require.NoError(t, repo.StoreSummaryResult(ctx, result))
+		require.NoError(t, repo.StoreSummaryResultWithSuite(ctx, result, "eest"))
+	}
+
+	policy := SummaryRetention{Daily: 30 * 24 * time.Hour}
+
+	report, err := repo.PruneResults(ctx, "devnet-0", policy)
+	require.NoError(t, err)
+	assert.Equal(t, 2*45, report.Pruned+report.Kept)
+	assert.Greater(t, report.Pruned, 0)
+
+	// Each suite is pruned the same way, and the most recent results are untouched.
+	for _, suite := range []string{"", "eest"} {
+		results, rerr := repo.GetRecentSummaryResultsWithSuite(ctx, "devnet-0", suite, 0)
+		require.NoError(t, rerr)
+		assert.Len(t, results, report.Kept/2)
+		assert.Equal(t, now.Format(DefaultSummaryDateFormat), results[0].Timestamp.Format(DefaultSummaryDateFormat))
+	}
+
+	// Pruning again changes nothing.
+	again, err := repo.PruneResults(ctx, "devnet-0", policy)
+	require.NoError(t, err)
+	assert.Zero(t, again.Pruned)
+	assert.Equal(t, report.Kept, again.Kept)
+
+	// Pruning is opt in, so nothing is pruned without a daily retention.
+	for _, disabledPolicy := range []SummaryRetention{{}, {Daily: -1}, {Weekly: time.Hour}} {
+		disabled, derr := repo.PruneResults(ctx, "devnet-0", disabledPolicy)
+		require.NoError(t, derr)
+		assert.Equal(t, &PruneReport{}, disabled)
+	}
+}
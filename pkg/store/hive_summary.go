@@ -2,6 +2,7 @@ package store
 
 import (
 	"bytes"
+	"cmp"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -15,9 +16,81 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
+const (
+	summaryPutAttempts = 3
+	summaryPutBackoff  = time.Second // Doubled after each failed attempt.
+
+	// DefaultSummaryDateFormat is the date format summary results are keyed by.
+	DefaultSummaryDateFormat = "2006-01-02"
+)
+
 // HiveSummaryRepo implements Repository for Hive summary alerts.
 type HiveSummaryRepo struct {
 	BaseRepo
+	dates *summaryDates
+}
+
+// summaryDates buckets summary results into days, in a fixed timezone so a run near midnight is
+// always stored under, and compared against, the same day regardless of its timestamp's zone.
+type summaryDates struct {
+	format   string
+	location *time.Location
+}
+
+// newSummaryDates creates the summary result date bucketing, defaulting to DefaultSummaryDateFormat
+// in UTC.
+func newSummaryDates(format, timezone string) (*summaryDates, error) {
+	dates := &summaryDates{
+		format:   cmp.Or(format, DefaultSummaryDateFormat),
+		location: time.UTC,
+	}
+
+	if timezone != "" {
+		location, err := time.LoadLocation(timezone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid timezone: %w", err)
+		}
+
+		dates.location = location
+	}
+
+	// The format has to identify a single day, and be usable as a key's filename.
+	reference := time.Date(2025, time.November, 23, 12, 0, 0, 0, dates.location)
+	formatted := reference.Format(dates.format)
+
+	if strings.Contains(formatted, "/") {
+		return nil, fmt.Errorf("invalid date format %q, dates can't contain a /", dates.format)
+	}
+
+	if parsed, ok := dates.parse(formatted); !ok || parsed.Format(DefaultSummaryDateFormat) != reference.Format(DefaultSummaryDateFormat) {
+		return nil, fmt.Errorf("invalid date format %q, it needs a year, month and day", dates.format)
+	}
+
+	return dates, nil
+}
+
+// ValidateSummaryDates checks a summary result date format and timezone are usable.
+func ValidateSummaryDates(format, timezone string) error {
+	_, err := newSummaryDates(format, timezone)
+
+	return err
+}
+
+// key returns the day a summary result with the given timestamp is stored under.
+func (d *summaryDates) key(timestamp time.Time) string {
+	return timestamp.In(d.location).Format(d.format)
+}
+
+// parse parses the day a summary result is stored under. Keys written in the default format are
+// still understood, so changing the format doesn't lose history.
+func (d *summaryDates) parse(date string) (time.Time, bool) {
+	for _, format := range []string{d.format, DefaultSummaryDateFormat} {
+		if parsed, err := time.ParseInLocation(format, date, d.location); err == nil {
+			return parsed, true
+		}
+	}
+
+	return time.Time{}, false
 }
 
 // NewHiveSummaryRepo creates a new HiveSummaryRepo.
@@ -27,8 +100,14 @@ func NewHiveSummaryRepo(ctx context.Context, log *logrus.Logger, cfg *S3Config,
 		return nil, fmt.Errorf("failed to create base repo: %w", err)
 	}
 
+	dates, err := newSummaryDates(cfg.SummaryDateFormat, cfg.SummaryTimezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid summary result dates: %w", err)
+	}
+
 	return &HiveSummaryRepo{
 		BaseRepo: baseRepo,
+		dates:    dates,
 	}, nil
 }
 
@@ -78,6 +157,8 @@ func (s *HiveSummaryRepo) List(ctx context.Context) ([]*hive.HiveSummaryAlert, e
 func (s *HiveSummaryRepo) Persist(ctx context.Context, alert *hive.HiveSummaryAlert) error {
 	defer s.trackDuration("persist", "hive_summary")()
 
+	alert.SchemaVersion = HiveSummaryAlertSchemaVersion
+
 	data, err := json.Marshal(alert)
 	if err != nil {
 		s.observeOperation("persist", "hive_summary", err)
@@ -193,12 +274,18 @@ func (s *HiveSummaryRepo) getAlert(ctx context.Context, key string) (*hive.HiveS
 
 	defer output.Body.Close()
 
-	var alert hive.HiveSummaryAlert
-	if err := json.NewDecoder(output.Body).Decode(&alert); err != nil {
-		return nil, fmt.Errorf("failed to decode alert: %w", err)
+	alert, migrated, err := decodeHiveSummaryAlert(output.Body)
+	if err != nil {
+		return nil, err
 	}
 
-	return &alert, nil
+	if migrated {
+		s.persistMigrated(ctx, key, "hive_summary", func() error {
+			return s.Persist(ctx, alert)
+		})
+	}
+
+	return alert, nil
 }
 
 // StoreSummaryResult stores a summary result for historical tracking.
@@ -214,9 +301,8 @@ func (s *HiveSummaryRepo) StoreSummaryResultWithSuite(ctx context.Context, resul
 		return fmt.Errorf("result is nil")
 	}
 
-	// Format date as YYYY-MM-DD using the timestamp from the result
-	// This ensures we store it under the date the tests were actually run
-	dateStr := result.Timestamp.Format("2006-01-02")
+	// Key by the day the tests were actually run, rather than when they were summarised.
+	dateStr := s.dates.key(result.Timestamp)
 
 	var key string
 	if suite != "" {
@@ -234,12 +320,9 @@ func (s *HiveSummaryRepo) StoreSummaryResultWithSuite(ctx context.Context, resul
 
 	s.metrics.objectSizeBytes.WithLabelValues("hive_summary_result").Observe(float64(len(data)))
 
-	if _, err = s.store.PutObject(ctx, &s3.PutObjectInput{
-		Bucket: aws.String(s.bucket),
-		Key:    aws.String(key),
-		Body:   bytes.NewReader(data),
-	}); err != nil {
+	if err = s.putSummaryResult(ctx, key, data); err != nil {
 		s.observeOperation("persist", "hive_summary_result", err)
+		s.metrics.summaryStoreFailures.WithLabelValues(result.Network, suite).Inc()
 
 		return fmt.Errorf("failed to put result: %w", err)
 	}
@@ -249,6 +332,39 @@ func (s *HiveSummaryRepo) StoreSummaryResultWithSuite(ctx context.Context, resul
 	return nil
 }
 
+// putSummaryResult uploads a marshalled summary result, retrying transient failures with backoff.
+// The result is fully buffered before uploading so a failed attempt can't leave a partial object
+// behind, and each day has a single key so retrying just overwrites it.
+func (s *HiveSummaryRepo) putSummaryResult(ctx context.Context, key string, data []byte) error {
+	backoff := summaryPutBackoff
+
+	for attempt := 1; ; attempt++ {
+		_, err := s.store.PutObject(ctx, &s3.PutObjectInput{
+			Bucket:        aws.String(s.bucket),
+			Key:           aws.String(key),
+			Body:          bytes.NewReader(data),
+			ContentLength: aws.Int64(int64(len(data))),
+			ContentType:   aws.String("application/json"),
+		})
+		if err == nil || attempt == summaryPutAttempts {
+			return err
+		}
+
+		s.log.WithError(err).WithFields(logrus.Fields{
+			"key":     key,
+			"attempt": attempt,
+		}).Warn("Failed to put summary result, retrying")
+
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return fmt.Errorf("%w (gave up retrying: %w)", err, ctx.Err())
+		}
+
+		backoff *= 2
+	}
+}
+
 // GetPreviousSummaryResult retrieves the previous summary result.
 func (s *HiveSummaryRepo) GetPreviousSummaryResult(ctx context.Context, network string) (*hive.SummaryResult, error) {
 	return s.GetPreviousSummaryResultWithSuite(ctx, network, "")
@@ -256,6 +372,26 @@ func (s *HiveSummaryRepo) GetPreviousSummaryResult(ctx context.Context, network
 
 // GetPreviousSummaryResultWithSuite retrieves the previous summary result with suite filter.
 func (s *HiveSummaryRepo) GetPreviousSummaryResultWithSuite(ctx context.Context, network, suite string) (*hive.SummaryResult, error) {
+	// Get the most recent result, this will be compared against the current summary before it's stored.
+	results, err := s.GetRecentSummaryResultsWithSuite(ctx, network, suite, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(results) == 0 {
+		return nil, ErrNoPreviousSummary
+	}
+
+	return results[0], nil
+}
+
+// GetRecentSummaryResultsWithSuite retrieves up to limit of the most recent summary results with
+// suite filter, newest first.
+func (s *HiveSummaryRepo) GetRecentSummaryResultsWithSuite(
+	ctx context.Context,
+	network, suite string,
+	limit int,
+) ([]*hive.SummaryResult, error) {
 	defer s.trackDuration("get", "hive_summary_result")()
 
 	// List all summary results for this network
@@ -276,14 +412,11 @@ func (s *HiveSummaryRepo) GetPreviousSummaryResultWithSuite(ctx context.Context,
 		return nil, fmt.Errorf("failed to list summary results: %w", err)
 	}
 
-	if len(output.Contents) == 0 {
-		return nil, fmt.Errorf("no previous summary results found")
-	}
-
 	// Map to store date -> key for sorting.
 	var (
-		dateKeys = make(map[string]string)
-		dates    = make([]string, 0)
+		dateKeys  = make(map[string]string)
+		dateTimes = make(map[string]time.Time)
+		dates     = make([]string, 0)
 	)
 
 	// Extract dates from filenames.
@@ -301,49 +434,60 @@ func (s *HiveSummaryRepo) GetPreviousSummaryResultWithSuite(ctx context.Context,
 		}
 
 		date := strings.TrimSuffix(filename, ".json")
-		if _, parseErr := time.Parse("2006-01-02", date); parseErr != nil {
+
+		parsed, ok := s.dates.parse(date)
+		if !ok {
 			continue
 		}
 
 		dateKeys[date] = key
+		dateTimes[date] = parsed
 
 		dates = append(dates, date)
 	}
 
-	if len(dates) == 0 {
-		return nil, fmt.Errorf("no valid summary results found")
+	// Sort dates in descending order (newest first), not every format sorts as a string.
+	sort.SliceStable(dates, func(i, j int) bool {
+		return dateTimes[dates[i]].After(dateTimes[dates[j]])
+	})
+
+	s.log.WithField("dates", dates).Debug("Found summary result dates")
+
+	if limit > 0 && len(dates) > limit {
+		dates = dates[:limit]
 	}
 
-	// Sort dates in descending order (newest first)
-	sort.Strings(dates)
-	sort.Sort(sort.Reverse(sort.StringSlice(dates)))
+	results := make([]*hive.SummaryResult, 0, len(dates))
 
-	s.log.WithField("dates", dates).Debug("Found summary result dates")
+	for _, date := range dates {
+		result, getErr := s.getSummaryResult(ctx, dateKeys[date])
+		if getErr != nil {
+			s.observeOperation("get", "hive_summary_result", getErr)
 
-	// Get the most recent result (index 0 after sorting)
-	// This will be compared against the current summary before it's stored
-	previousDate := dates[0]
-	previousKey := dateKeys[previousDate]
+			return nil, fmt.Errorf("failed to get summary result for %s: %w", date, getErr)
+		}
 
-	s.log.WithFields(logrus.Fields{
-		"previousDate": previousDate,
-	}).Debug("Found previous summary result")
+		results = append(results, result)
+	}
+
+	s.observeOperation("get", "hive_summary_result", nil)
 
-	// Get the previous result
-	getOutput, err := s.store.GetObject(ctx, &s3.GetObjectInput{
+	return results, nil
+}
+
+func (s *HiveSummaryRepo) getSummaryResult(ctx context.Context, key string) (*hive.SummaryResult, error) {
+	output, err := s.store.GetObject(ctx, &s3.GetObjectInput{
 		Bucket: aws.String(s.bucket),
-		Key:    aws.String(previousKey),
+		Key:    aws.String(key),
 	})
 	if err != nil {
-		s.observeOperation("get", "hive_summary_result", err)
-
-		return nil, fmt.Errorf("failed to get previous result: %w", err)
+		return nil, fmt.Errorf("failed to get result: %w", err)
 	}
 
-	defer getOutput.Body.Close()
+	defer output.Body.Close()
 
 	var result hive.SummaryResult
-	if err := json.NewDecoder(getOutput.Body).Decode(&result); err != nil {
+	if err := json.NewDecoder(output.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode result: %w", err)
 	}
 
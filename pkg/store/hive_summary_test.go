@@ -0,0 +1,67 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSummaryDates(t *testing.T) {
+	var (
+		beforeMidnight = time.Date(2025, time.March, 15, 23, 59, 59, 0, time.UTC)
+		afterMidnight  = time.Date(2025, time.March, 16, 0, 0, 1, 0, time.UTC)
+		sydney         = time.FixedZone("AEDT", 11*60*60)
+	)
+
+	t.Run("buckets by UTC day by default", func(t *testing.T) {
+		dates, err := newSummaryDates("", "")
+		require.NoError(t, err)
+
+		assert.Equal(t, "2025-03-15", dates.key(beforeMidnight))
+		assert.Equal(t, "2025-03-16", dates.key(afterMidnight))
+
+		// The same instants in another zone land on the same UTC days.
+		assert.Equal(t, "2025-03-15", dates.key(beforeMidnight.In(sydney)))
+		assert.Equal(t, "2025-03-16", dates.key(afterMidnight.In(sydney)))
+	})
+
+	t.Run("buckets by configured timezone", func(t *testing.T) {
+		dates, err := newSummaryDates("", "Australia/Sydney")
+		require.NoError(t, err)
+
+		assert.Equal(t, "2025-03-16", dates.key(beforeMidnight))
+		assert.Equal(t, "2025-03-16", dates.key(afterMidnight))
+	})
+
+	t.Run("configured format", func(t *testing.T) {
+		dates, err := newSummaryDates("02-01-2006", "")
+		require.NoError(t, err)
+
+		assert.Equal(t, "15-03-2025", dates.key(beforeMidnight))
+
+		// Keys in the default format are still read.
+		for _, date := range []string{"15-03-2025", "2025-03-15"} {
+			parsed, ok := dates.parse(date)
+			require.True(t, ok, date)
+			assert.Equal(t, time.Date(2025, time.March, 15, 0, 0, 0, 0, time.UTC), parsed, date)
+		}
+
+		_, ok := dates.parse("latest")
+		assert.False(t, ok)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, tt := range []struct{ format, timezone string }{
+			{format: "2006/01/02"},
+			{format: "2006-01"},
+			{format: "Monday"},
+			{timezone: "Mars/Olympus_Mons"},
+		} {
+			require.Error(t, ValidateSummaryDates(tt.format, tt.timezone), tt)
+		}
+
+		require.NoError(t, ValidateSummaryDates("20060102", "Europe/Berlin"))
+	})
+}
@@ -0,0 +1,301 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/sirupsen/logrus"
+)
+
+// Incident statuses.
+const (
+	IncidentStatusOpen     = "open"
+	IncidentStatusResolved = "resolved"
+)
+
+// Incident tracks a client failing on a network, from the first check run that alerts on it until a
+// run finds it has recovered. Each network/client keeps its latest incident, a resolved incident is
+// replaced when the client next starts failing. Resolved incidents are also archived to the
+// network's incident history.
+type Incident struct {
+	ID                string    `json:"id"` // The check run that opened the incident.
+	Network           string    `json:"network"`
+	Client            string    `json:"client"`
+	Status            string    `json:"status"`
+	StartedAt         time.Time `json:"startedAt"`
+	UpdatedAt         time.Time `json:"updatedAt"`
+	ResolvedAt        time.Time `json:"resolvedAt"`
+	LastCheckID       string    `json:"lastCheckId"`   // The latest check run that found the client failing.
+	Runs              int       `json:"runs"`          // How many check runs have found the client failing.
+	RootCauseRuns     int       `json:"rootCauseRuns"` // How many of those runs flagged the client as a root cause.
+	AffectedInstances []string  `json:"affectedInstances"`
+	IsRootCause       bool      `json:"isRootCause"`
+	Escalations       int       `json:"escalations"` // How many escalation thresholds have been posted.
+	Notified          bool      `json:"notified"`    // Whether an alert was sent, so its recovery is worth posting.
+}
+
+// IsOpen returns true if the client hasn't recovered yet.
+func (i *Incident) IsOpen() bool {
+	return i.Status == IncidentStatusOpen
+}
+
+// Duration returns how long the incident has been open, or was open for if it's resolved.
+func (i *Incident) Duration(now time.Time) time.Duration {
+	if i.IsOpen() {
+		return now.Sub(i.StartedAt)
+	}
+
+	return i.ResolvedAt.Sub(i.StartedAt)
+}
+
+// IncidentsRepo implements Repository[*Incident].
+type IncidentsRepo struct {
+	BaseRepo
+}
+
+// NewIncidentsRepo creates a new IncidentsRepo.
+func NewIncidentsRepo(ctx context.Context, log *logrus.Logger, cfg *S3Config, metrics *Metrics) (*IncidentsRepo, error) {
+	baseRepo, err := NewBaseRepo(ctx, log, cfg, metrics)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create base repo: %w", err)
+	}
+
+	return &IncidentsRepo{
+		BaseRepo: baseRepo,
+	}, nil
+}
+
+// List implements Repository[*Incident].
+func (s *IncidentsRepo) List(ctx context.Context) ([]*Incident, error) {
+	defer s.trackDuration("list", "incidents")()
+
+	var (
+		input = &s3.ListObjectsV2Input{
+			Bucket: aws.String(s.bucket),
+			Prefix: aws.String(fmt.Sprintf("%s/networks/", s.prefix)),
+		}
+		records   []*Incident
+		paginator = s3.NewListObjectsV2Paginator(s.store, input)
+	)
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			s.observeOperation("list", "incidents", err)
+
+			return nil, fmt.Errorf("failed to list incidents: %w", err)
+		}
+
+		for _, obj := range page.Contents {
+			if !strings.HasSuffix(*obj.Key, ".json") || !strings.Contains(*obj.Key, "/incidents/") {
+				continue
+			}
+
+			record, err := s.getIncident(ctx, *obj.Key)
+			if err != nil {
+				s.log.Errorf("Failed to get incident %s: %v", *obj.Key, err)
+
+				continue
+			}
+
+			records = append(records, record)
+		}
+	}
+
+	s.metrics.objectsTotal.WithLabelValues("incidents").Set(float64(len(records)))
+
+	return records, nil
+}
+
+// Get retrieves the latest incident for a client on a network, or nil if it has never had one.
+func (s *IncidentsRepo) Get(ctx context.Context, network, client string) (*Incident, error) {
+	defer s.trackDuration("get", "incidents")()
+
+	record, err := s.getIncident(ctx, s.Key(&Incident{Network: network, Client: client}))
+	if err != nil {
+		var noSuchKey *types.NoSuchKey
+
+		if errors.As(err, &noSuchKey) {
+			s.observeOperation("get", "incidents", nil) // Not really an error in this case
+
+			return nil, nil
+		}
+
+		s.observeOperation("get", "incidents", err)
+
+		return nil, err
+	}
+
+	s.observeOperation("get", "incidents", nil)
+
+	return record, nil
+}
+
+// Persist implements Repository[*Incident].
+func (s *IncidentsRepo) Persist(ctx context.Context, record *Incident) error {
+	defer s.trackDuration("persist", "incidents")()
+
+	data, err := json.Marshal(record)
+	if err != nil {
+		s.observeOperation("persist", "incidents", err)
+
+		return fmt.Errorf("failed to marshal incident: %w", err)
+	}
+
+	s.metrics.objectSizeBytes.WithLabelValues("incidents").Observe(float64(len(data)))
+
+	if _, err = s.store.PutObject(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.Key(record)),
+		Body:   bytes.NewReader(data),
+	}); err != nil {
+		s.observeOperation("persist", "incidents", err)
+
+		return fmt.Errorf("failed to put incident: %w", err)
+	}
+
+	s.observeOperation("persist", "incidents", nil)
+
+	return nil
+}
+
+// Purge implements Repository[*Incident].
+func (s *IncidentsRepo) Purge(ctx context.Context, identifiers ...string) error {
+	if len(identifiers) != 2 {
+		return fmt.Errorf("expected network and client identifiers, got %d identifiers", len(identifiers))
+	}
+
+	network, client := identifiers[0], identifiers[1]
+
+	if _, err := s.store.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.Key(&Incident{Network: network, Client: client})),
+	}); err != nil {
+		return fmt.Errorf("failed to delete incident: %w", err)
+	}
+
+	return nil
+}
+
+// Key implements Repository[*Incident].
+func (s *IncidentsRepo) Key(record *Incident) string {
+	if record == nil {
+		s.log.Error("incident is nil")
+
+		return ""
+	}
+
+	return fmt.Sprintf("%s/networks/%s/incidents/%s.json", s.prefix, record.Network, record.Client)
+}
+
+// Archive stores a resolved incident in the network's incident history, filed under the day it was
+// resolved so history can be read back a day at a time.
+func (s *IncidentsRepo) Archive(ctx context.Context, incident *Incident) error {
+	defer s.trackDuration("archive", "incidents")()
+
+	data, err := json.Marshal(incident)
+	if err != nil {
+		s.observeOperation("archive", "incidents", err)
+
+		return fmt.Errorf("failed to marshal incident: %w", err)
+	}
+
+	if _, err = s.store.PutObject(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.HistoryKey(incident)),
+		Body:   bytes.NewReader(data),
+	}); err != nil {
+		s.observeOperation("archive", "incidents", err)
+
+		return fmt.Errorf("failed to archive incident: %w", err)
+	}
+
+	s.observeOperation("archive", "incidents", nil)
+
+	return nil
+}
+
+// ListHistory returns the incidents on a network resolved on or after since, reading one day of
+// history at a time. Callers should bound since, each day is a separate listing.
+func (s *IncidentsRepo) ListHistory(ctx context.Context, network string, since time.Time) ([]*Incident, error) {
+	defer s.trackDuration("list_history", "incidents")()
+
+	var (
+		incidents []*Incident
+		today     = time.Now().UTC().Truncate(24 * time.Hour)
+	)
+
+	for day := since.UTC().Truncate(24 * time.Hour); !day.After(today); day = day.AddDate(0, 0, 1) {
+		paginator := s3.NewListObjectsV2Paginator(s.store, &s3.ListObjectsV2Input{
+			Bucket: aws.String(s.bucket),
+			Prefix: aws.String(fmt.Sprintf("%s/networks/%s/incident-history/%s/", s.prefix, network, day.Format(time.DateOnly))),
+		})
+
+		for paginator.HasMorePages() {
+			page, err := paginator.NextPage(ctx)
+			if err != nil {
+				s.observeOperation("list_history", "incidents", err)
+
+				return nil, fmt.Errorf("failed to list incident history: %w", err)
+			}
+
+			for _, obj := range page.Contents {
+				incident, err := s.getIncident(ctx, *obj.Key)
+				if err != nil {
+					s.log.Errorf("Failed to get archived incident %s: %v", *obj.Key, err)
+
+					continue
+				}
+
+				if incident.ResolvedAt.Before(since) {
+					continue
+				}
+
+				incidents = append(incidents, incident)
+			}
+		}
+	}
+
+	s.observeOperation("list_history", "incidents", nil)
+
+	return incidents, nil
+}
+
+// HistoryKey returns the key a resolved incident is archived under.
+func (s *IncidentsRepo) HistoryKey(incident *Incident) string {
+	return fmt.Sprintf(
+		"%s/networks/%s/incident-history/%s/%s-%s.json",
+		s.prefix,
+		incident.Network,
+		incident.ResolvedAt.UTC().Format(time.DateOnly),
+		incident.Client,
+		incident.ID,
+	)
+}
+
+func (s *IncidentsRepo) getIncident(ctx context.Context, key string) (*Incident, error) {
+	output, err := s.store.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get incident: %w", err)
+	}
+
+	defer output.Body.Close()
+
+	var record Incident
+	if err := json.NewDecoder(output.Body).Decode(&record); err != nil {
+		return nil, fmt.Errorf("failed to decode incident: %w", err)
+	}
+
+	return &record, nil
+}
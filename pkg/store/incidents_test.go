@@ -0,0 +1,138 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIncidentsRepo(t *testing.T) {
+	ctx := context.Background()
+	helper := newTestHelper(t)
+	helper.setup(ctx)
+	defer helper.teardown(ctx)
+
+	t.Run("Get_No_Incident", func(t *testing.T) {
+		setupTest(t)
+		repo, err := NewIncidentsRepo(ctx, helper.log, helper.cfg, NewMetrics("test"))
+		require.NoError(t, err)
+
+		record, err := repo.Get(ctx, "test-net", "lighthouse")
+		require.NoError(t, err)
+		assert.Nil(t, record)
+	})
+
+	t.Run("Persist_And_Get", func(t *testing.T) {
+		setupTest(t)
+		repo, err := NewIncidentsRepo(ctx, helper.log, helper.cfg, NewMetrics("test"))
+		require.NoError(t, err)
+
+		now := time.Now().UTC().Truncate(time.Second)
+		record := &Incident{
+			ID:                "test-check",
+			Network:           "test-net",
+			Client:            "lighthouse",
+			Status:            IncidentStatusOpen,
+			StartedAt:         now,
+			UpdatedAt:         now,
+			LastCheckID:       "test-check",
+			Runs:              1,
+			AffectedInstances: []string{"lighthouse-geth-1"},
+			IsRootCause:       true,
+		}
+
+		require.NoError(t, repo.Persist(ctx, record))
+
+		got, err := repo.Get(ctx, "test-net", "lighthouse")
+		require.NoError(t, err)
+		require.NotNil(t, got)
+		assert.Equal(t, record.ID, got.ID)
+		assert.True(t, got.IsOpen())
+		assert.Equal(t, record.AffectedInstances, got.AffectedInstances)
+		assert.True(t, record.StartedAt.Equal(got.StartedAt))
+
+		records, err := repo.List(ctx)
+		require.NoError(t, err)
+		assert.Len(t, records, 1)
+	})
+
+	t.Run("Purge", func(t *testing.T) {
+		setupTest(t)
+		repo, err := NewIncidentsRepo(ctx, helper.log, helper.cfg, NewMetrics("test"))
+		require.NoError(t, err)
+
+		require.NoError(t, repo.Purge(ctx, "test-net", "lighthouse"))
+
+		records, err := repo.List(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, records)
+	})
+
+	t.Run("Key_Generation", func(t *testing.T) {
+		setupTest(t)
+		repo, err := NewIncidentsRepo(ctx, helper.log, helper.cfg, NewMetrics("test"))
+		require.NoError(t, err)
+
+		key := repo.Key(&Incident{Network: "test-net", Client: "lighthouse"})
+		assert.Equal(t, "test/networks/test-net/incidents/lighthouse.json", key)
+	})
+}
+
+func TestIncident_Duration(t *testing.T) {
+	start := time.Date(2025, 1, 2, 7, 0, 0, 0, time.UTC)
+	incident := &Incident{Status: IncidentStatusOpen, StartedAt: start}
+
+	assert.Equal(t, 2*time.Hour, incident.Duration(start.Add(2*time.Hour)))
+
+	incident.Status = IncidentStatusResolved
+	incident.ResolvedAt = start.Add(30 * time.Minute)
+
+	assert.False(t, incident.IsOpen())
+	assert.Equal(t, 30*time.Minute, incident.Duration(start.Add(2*time.Hour)))
+}
+
+func TestIncidentsRepo_History(t *testing.T) {
+	ctx := context.Background()
+	helper := newTestHelper(t)
+	helper.setup(ctx)
+	defer helper.teardown(ctx)
+
+	setupTest(t)
+	repo, err := NewIncidentsRepo(ctx, helper.log, helper.cfg, NewMetrics("test"))
+	require.NoError(t, err)
+
+	now := time.Now().UTC()
+
+	for idx, resolvedAt := range []time.Time{now, now.AddDate(0, 0, -3), now.AddDate(0, 0, -40)} {
+		require.NoError(t, repo.Archive(ctx, &Incident{
+			ID:            fmt.Sprintf("check-%d", idx),
+			Network:       "test-net",
+			Client:        "lighthouse",
+			Status:        IncidentStatusResolved,
+			StartedAt:     resolvedAt.Add(-time.Hour),
+			ResolvedAt:    resolvedAt,
+			RootCauseRuns: 2,
+		}))
+	}
+
+	incidents, err := repo.ListHistory(ctx, "test-net", now.AddDate(0, 0, -7))
+	require.NoError(t, err)
+	assert.Len(t, incidents, 2)
+
+	// History isn't mistaken for current incidents.
+	current, err := repo.List(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, current)
+
+	key := repo.HistoryKey(&Incident{
+		ID:         "check-1",
+		Network:    "test-net",
+		Client:     "lighthouse",
+		ResolvedAt: time.Date(2025, 1, 2, 7, 0, 0, 0, time.UTC),
+	})
+	assert.Equal(t, "test/networks/test-net/incident-history/2025-01-02/lighthouse-check-1.json", key)
+}
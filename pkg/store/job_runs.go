@@ -0,0 +1,182 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/sirupsen/logrus"
+)
+
+// JobRun records when a scheduled job last completed successfully, so runs missed while the bot
+// was down can be caught up on startup.
+type JobRun struct {
+	Job         string    `json:"job"`
+	LastSuccess time.Time `json:"lastSuccess"`
+}
+
+// JobRunsRepo implements Repository[*JobRun].
+type JobRunsRepo struct {
+	BaseRepo
+}
+
+// NewJobRunsRepo creates a new JobRunsRepo.
+func NewJobRunsRepo(ctx context.Context, log *logrus.Logger, cfg *S3Config, metrics *Metrics) (*JobRunsRepo, error) {
+	baseRepo, err := NewBaseRepo(ctx, log, cfg, metrics)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create base repo: %w", err)
+	}
+
+	return &JobRunsRepo{
+		BaseRepo: baseRepo,
+	}, nil
+}
+
+// List implements Repository[*JobRun].
+func (s *JobRunsRepo) List(ctx context.Context) ([]*JobRun, error) {
+	defer s.trackDuration("list", "job_runs")()
+
+	var (
+		input = &s3.ListObjectsV2Input{
+			Bucket: aws.String(s.bucket),
+			Prefix: aws.String(fmt.Sprintf("%s/jobs/", s.prefix)),
+		}
+		records   []*JobRun
+		paginator = s3.NewListObjectsV2Paginator(s.store, input)
+	)
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			s.observeOperation("list", "job_runs", err)
+
+			return nil, fmt.Errorf("failed to list job runs: %w", err)
+		}
+
+		for _, obj := range page.Contents {
+			if !strings.HasSuffix(*obj.Key, ".json") {
+				continue
+			}
+
+			record, err := s.getJobRun(ctx, *obj.Key)
+			if err != nil {
+				s.log.Errorf("Failed to get job run %s: %v", *obj.Key, err)
+
+				continue
+			}
+
+			records = append(records, record)
+		}
+	}
+
+	s.metrics.objectsTotal.WithLabelValues("job_runs").Set(float64(len(records)))
+
+	return records, nil
+}
+
+// Get retrieves when a job last completed successfully, or nil if it never has been recorded.
+func (s *JobRunsRepo) Get(ctx context.Context, job string) (*JobRun, error) {
+	defer s.trackDuration("get", "job_runs")()
+
+	record, err := s.getJobRun(ctx, s.Key(&JobRun{Job: job}))
+	if err != nil {
+		var noSuchKey *types.NoSuchKey
+
+		if errors.As(err, &noSuchKey) {
+			s.observeOperation("get", "job_runs", nil) // Not really an error in this case
+
+			return nil, nil
+		}
+
+		s.observeOperation("get", "job_runs", err)
+
+		return nil, err
+	}
+
+	s.observeOperation("get", "job_runs", nil)
+
+	return record, nil
+}
+
+// Persist implements Repository[*JobRun].
+func (s *JobRunsRepo) Persist(ctx context.Context, record *JobRun) error {
+	defer s.trackDuration("persist", "job_runs")()
+
+	data, err := json.Marshal(record)
+	if err != nil {
+		s.observeOperation("persist", "job_runs", err)
+
+		return fmt.Errorf("failed to marshal job run: %w", err)
+	}
+
+	s.metrics.objectSizeBytes.WithLabelValues("job_runs").Observe(float64(len(data)))
+
+	if _, err = s.store.PutObject(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.Key(record)),
+		Body:   bytes.NewReader(data),
+	}); err != nil {
+		s.observeOperation("persist", "job_runs", err)
+
+		return fmt.Errorf("failed to put job run: %w", err)
+	}
+
+	s.observeOperation("persist", "job_runs", nil)
+
+	return nil
+}
+
+// Purge implements Repository[*JobRun].
+func (s *JobRunsRepo) Purge(ctx context.Context, identifiers ...string) error {
+	if len(identifiers) != 1 {
+		return fmt.Errorf("expected job identifier, got %d identifiers", len(identifiers))
+	}
+
+	if _, err := s.store.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.Key(&JobRun{Job: identifiers[0]})),
+	}); err != nil {
+		return fmt.Errorf("failed to delete job run: %w", err)
+	}
+
+	return nil
+}
+
+// Key implements Repository[*JobRun].
+func (s *JobRunsRepo) Key(record *JobRun) string {
+	if record == nil {
+		s.log.Error("job run is nil")
+
+		return ""
+	}
+
+	// Job names are often store keys themselves, so escape them into a single path segment.
+	return fmt.Sprintf("%s/jobs/%s.json", s.prefix, url.PathEscape(record.Job))
+}
+
+func (s *JobRunsRepo) getJobRun(ctx context.Context, key string) (*JobRun, error) {
+	output, err := s.store.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get job run: %w", err)
+	}
+
+	defer output.Body.Close()
+
+	var record JobRun
+	if err := json.NewDecoder(output.Body).Decode(&record); err != nil {
+		return nil, fmt.Errorf("failed to decode job run: %w", err)
+	}
+
+	return &record, nil
+}
@@ -0,0 +1,74 @@
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobRunsRepo(t *testing.T) {
+	ctx := context.Background()
+	helper := newTestHelper(t)
+	helper.setup(ctx)
+	defer helper.teardown(ctx)
+
+	t.Run("Get_Never_Run", func(t *testing.T) {
+		setupTest(t)
+		repo, err := NewJobRunsRepo(ctx, helper.log, helper.cfg, NewMetrics("test"))
+		require.NoError(t, err)
+
+		record, err := repo.Get(ctx, "hive-summary-test-net")
+		require.NoError(t, err)
+		assert.Nil(t, record)
+	})
+
+	t.Run("Persist_And_Get", func(t *testing.T) {
+		setupTest(t)
+		repo, err := NewJobRunsRepo(ctx, helper.log, helper.cfg, NewMetrics("test"))
+		require.NoError(t, err)
+
+		record := &JobRun{
+			Job:         "test/networks/test-net/monitor/lighthouse.json",
+			LastSuccess: time.Now().UTC().Truncate(time.Second),
+		}
+
+		require.NoError(t, repo.Persist(ctx, record))
+
+		got, err := repo.Get(ctx, record.Job)
+		require.NoError(t, err)
+		require.NotNil(t, got)
+		assert.Equal(t, record.Job, got.Job)
+		assert.True(t, record.LastSuccess.Equal(got.LastSuccess))
+
+		records, err := repo.List(ctx)
+		require.NoError(t, err)
+		assert.Len(t, records, 1)
+	})
+
+	t.Run("Purge", func(t *testing.T) {
+		setupTest(t)
+		repo, err := NewJobRunsRepo(ctx, helper.log, helper.cfg, NewMetrics("test"))
+		require.NoError(t, err)
+
+		require.NoError(t, repo.Purge(ctx, "test/networks/test-net/monitor/lighthouse.json"))
+
+		records, err := repo.List(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, records)
+	})
+
+	t.Run("Key_Generation", func(t *testing.T) {
+		setupTest(t)
+		repo, err := NewJobRunsRepo(ctx, helper.log, helper.cfg, NewMetrics("test"))
+		require.NoError(t, err)
+
+		assert.Equal(t, "test/jobs/hive-summary-test-net.json", repo.Key(&JobRun{Job: "hive-summary-test-net"}))
+		assert.Equal(t,
+			"test/jobs/test%2Fnetworks%2Ftest-net%2Fmonitor%2Flighthouse.json.json",
+			repo.Key(&JobRun{Job: "test/networks/test-net/monitor/lighthouse.json"}),
+		)
+	})
+}
@@ -3,11 +3,13 @@ package store
 import "github.com/prometheus/client_golang/prometheus"
 
 type Metrics struct {
-	operationsTotal   *prometheus.CounterVec
-	operationErrors   *prometheus.CounterVec
-	operationDuration *prometheus.HistogramVec
-	objectsTotal      *prometheus.GaugeVec
-	objectSizeBytes   *prometheus.HistogramVec
+	operationsTotal      *prometheus.CounterVec
+	operationErrors      *prometheus.CounterVec
+	operationDuration    *prometheus.HistogramVec
+	objectsTotal         *prometheus.GaugeVec
+	objectSizeBytes      *prometheus.HistogramVec
+	migrationsTotal      *prometheus.CounterVec
+	summaryStoreFailures *prometheus.CounterVec
 }
 
 func NewMetrics(namespace string) *Metrics {
@@ -48,6 +50,20 @@ func NewMetrics(namespace string) *Metrics {
 			Help:      "Size of objects in storage",
 			Buckets:   []float64{1024, 10 * 1024, 100 * 1024, 1024 * 1024, 10 * 1024 * 1024},
 		}, []string{"repository"}),
+
+		migrationsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "store",
+			Name:      "migrations_total",
+			Help:      "Total number of records upgraded to a newer schema version on read",
+		}, []string{"repository"}),
+
+		summaryStoreFailures: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "store",
+			Name:      "hive_summary_store_failures_total",
+			Help:      "Total number of Hive summary results that failed to store after retrying, leaving a gap in history",
+		}, []string{"network", "suite"}),
 	}
 
 	prometheus.MustRegister(
@@ -56,6 +72,8 @@ func NewMetrics(namespace string) *Metrics {
 		m.operationDuration,
 		m.objectsTotal,
 		m.objectSizeBytes,
+		m.migrationsTotal,
+		m.summaryStoreFailures,
 	)
 
 	return m
@@ -0,0 +1,99 @@
+package store
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/ethpandaops/panda-pulse/pkg/hive"
+)
+
+const (
+	// MonitorAlertSchemaVersion is the current schema version of persisted monitor alerts.
+	MonitorAlertSchemaVersion = 1
+	// HiveSummaryAlertSchemaVersion is the current schema version of persisted Hive summary alerts.
+	HiveSummaryAlertSchemaVersion = 1
+	// DefaultMonitorSchedule is the schedule monitor alerts run on when none is set.
+	DefaultMonitorSchedule = "0 7 * * *"
+	// DefaultHiveSummarySchedule is the schedule Hive summary alerts run on when none is set.
+	DefaultHiveSummarySchedule = "0 1 * * 1-5" // Weekdays at 1am UTC
+)
+
+// migration upgrades a record from the schema version before it to the version it's registered under.
+type migration[T any] func(item T)
+
+// monitorAlertMigrations are keyed by the schema version they upgrade a monitor alert to.
+var monitorAlertMigrations = map[int]migration[*MonitorAlert]{
+	// Version 1 introduced schema versioning. Records written before it may lack a schedule,
+	// which was previously only defaulted when scheduling.
+	1: func(alert *MonitorAlert) {
+		if alert.Schedule == "" {
+			alert.Schedule = DefaultMonitorSchedule
+		}
+
+		if alert.UpdatedAt.IsZero() {
+			alert.UpdatedAt = alert.CreatedAt
+		}
+	},
+}
+
+// hiveSummaryAlertMigrations are keyed by the schema version they upgrade a Hive summary alert to.
+var hiveSummaryAlertMigrations = map[int]migration[*hive.HiveSummaryAlert]{
+	// Version 1 introduced schema versioning. Records written before it may lack a schedule.
+	1: func(alert *hive.HiveSummaryAlert) {
+		if alert.Schedule == "" {
+			alert.Schedule = DefaultHiveSummarySchedule
+		}
+
+		if alert.UpdatedAt.IsZero() {
+			alert.UpdatedAt = alert.CreatedAt
+		}
+	},
+}
+
+// migrate applies every migration after the given version, up to and including target, in order.
+func migrate[T any](item T, version, target int, migrations map[int]migration[T]) {
+	for v := version + 1; v <= target; v++ {
+		if m, ok := migrations[v]; ok {
+			m(item)
+		}
+	}
+}
+
+// decodeMonitorAlert decodes a persisted monitor alert, upgrading it to the current schema
+// version. It reports whether the record was migrated, so it can be rewritten.
+func decodeMonitorAlert(r io.Reader) (*MonitorAlert, bool, error) {
+	var alert MonitorAlert
+	if err := json.NewDecoder(r).Decode(&alert); err != nil {
+		return nil, false, fmt.Errorf("failed to decode alert: %w", err)
+	}
+
+	if alert.SchemaVersion >= MonitorAlertSchemaVersion {
+		return &alert, false, nil
+	}
+
+	migrate(&alert, alert.SchemaVersion, MonitorAlertSchemaVersion, monitorAlertMigrations)
+
+	alert.SchemaVersion = MonitorAlertSchemaVersion
+
+	return &alert, true, nil
+}
+
+// decodeHiveSummaryAlert decodes a persisted Hive summary alert, upgrading it to the current
+// schema version. It reports whether the record was migrated, so it can be rewritten.
+func decodeHiveSummaryAlert(r io.Reader) (*hive.HiveSummaryAlert, bool, error) {
+	var alert hive.HiveSummaryAlert
+	if err := json.NewDecoder(r).Decode(&alert); err != nil {
+		return nil, false, fmt.Errorf("failed to decode alert: %w", err)
+	}
+
+	if alert.SchemaVersion >= HiveSummaryAlertSchemaVersion {
+		return &alert, false, nil
+	}
+
+	migrate(&alert, alert.SchemaVersion, HiveSummaryAlertSchemaVersion, hiveSummaryAlertMigrations)
+
+	alert.SchemaVersion = HiveSummaryAlertSchemaVersion
+
+	return &alert, true, nil
+}
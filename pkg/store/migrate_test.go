@@ -0,0 +1,100 @@
+package store
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethpandaops/panda-pulse/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeMonitorAlert(t *testing.T) {
+	createdAt := time.Date(2025, 1, 2, 7, 0, 0, 0, time.UTC)
+
+	t.Run("unversioned record is migrated", func(t *testing.T) {
+		data := `{
+			"network": "fusaka-devnet-1",
+			"client": "lighthouse",
+			"checkId": "",
+			"enabled": true,
+			"discordChannel": "123",
+			"discordGuildId": "456",
+			"interval": 3600000000000,
+			"clientType": "consensus",
+			"createdAt": "2025-01-02T07:00:00Z",
+			"updatedAt": "0001-01-01T00:00:00Z"
+		}`
+
+		alert, migrated, err := decodeMonitorAlert(strings.NewReader(data))
+		require.NoError(t, err)
+		assert.True(t, migrated)
+		assert.Equal(t, MonitorAlertSchemaVersion, alert.SchemaVersion)
+		assert.Equal(t, DefaultMonitorSchedule, alert.Schedule)
+		assert.Equal(t, createdAt, alert.UpdatedAt)
+		assert.Equal(t, "fusaka-devnet-1", alert.Network)
+		assert.Equal(t, clients.ClientTypeCL, alert.ClientType)
+		assert.Equal(t, time.Hour, alert.Interval)
+	})
+
+	t.Run("unversioned record keeps existing values", func(t *testing.T) {
+		data := `{
+			"network": "fusaka-devnet-1",
+			"client": "lighthouse",
+			"schedule": "0 9 * * *",
+			"createdAt": "2025-01-02T07:00:00Z",
+			"updatedAt": "2025-01-03T07:00:00Z"
+		}`
+
+		alert, migrated, err := decodeMonitorAlert(strings.NewReader(data))
+		require.NoError(t, err)
+		assert.True(t, migrated)
+		assert.Equal(t, "0 9 * * *", alert.Schedule)
+		assert.Equal(t, createdAt.Add(24*time.Hour), alert.UpdatedAt)
+	})
+
+	t.Run("current record is not migrated", func(t *testing.T) {
+		data := `{"schemaVersion": 1, "network": "fusaka-devnet-1", "client": "lighthouse"}`
+
+		alert, migrated, err := decodeMonitorAlert(strings.NewReader(data))
+		require.NoError(t, err)
+		assert.False(t, migrated)
+		assert.Empty(t, alert.Schedule)
+	})
+
+	t.Run("invalid json", func(t *testing.T) {
+		_, _, err := decodeMonitorAlert(strings.NewReader(`{`))
+		require.Error(t, err)
+	})
+}
+
+func TestDecodeHiveSummaryAlert(t *testing.T) {
+	t.Run("unversioned record is migrated", func(t *testing.T) {
+		data := `{
+			"network": "fusaka-devnet-1",
+			"discordChannel": "123",
+			"discordGuildId": "456",
+			"enabled": true,
+			"createdAt": "2025-01-02T07:00:00Z"
+		}`
+
+		alert, migrated, err := decodeHiveSummaryAlert(strings.NewReader(data))
+		require.NoError(t, err)
+		assert.True(t, migrated)
+		assert.Equal(t, HiveSummaryAlertSchemaVersion, alert.SchemaVersion)
+		assert.Equal(t, DefaultHiveSummarySchedule, alert.Schedule)
+		assert.Equal(t, alert.CreatedAt, alert.UpdatedAt)
+		assert.Empty(t, alert.Suite)
+	})
+
+	t.Run("current record is not migrated", func(t *testing.T) {
+		data := `{"schemaVersion": 1, "network": "fusaka-devnet-1", "suite": "eest/consume-engine", "schedule": "0 2 * * *"}`
+
+		alert, migrated, err := decodeHiveSummaryAlert(strings.NewReader(data))
+		require.NoError(t, err)
+		assert.False(t, migrated)
+		assert.Equal(t, "0 2 * * *", alert.Schedule)
+		assert.Equal(t, "eest/consume-engine", alert.Suite)
+	})
+}
@@ -4,12 +4,14 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/ethpandaops/panda-pulse/pkg/clients"
 	"github.com/sirupsen/logrus"
 )
@@ -21,6 +23,7 @@ type MonitorRepo struct {
 
 // MonitorAlert represents a monitor alert.
 type MonitorAlert struct {
+	SchemaVersion  int                `json:"schemaVersion"`
 	Network        string             `json:"network"`
 	Client         string             `json:"client"`
 	CheckID        string             `json:"checkId"`
@@ -32,6 +35,49 @@ type MonitorAlert struct {
 	ClientType     clients.ClientType `json:"clientType"`
 	CreatedAt      time.Time          `json:"createdAt"`
 	UpdatedAt      time.Time          `json:"updatedAt"`
+
+	// MinAffectedInstances is how many instances must be failing before a notification is sent,
+	// zero or one notifies on any.
+	MinAffectedInstances int `json:"minAffectedInstances,omitempty"`
+
+	// DisableHiveScreenshot stops a Hive screenshot being attached to the alert's threads, the Hive
+	// button is kept.
+	DisableHiveScreenshot bool `json:"disableHiveScreenshot,omitempty"`
+
+	// MentionTeam mentions the client team's roles in the alert's threads, alongside any explicit
+	// mentions for the client.
+	MentionTeam bool `json:"mentionTeam,omitempty"`
+
+	// RemediationScript attaches a script to the alert's threads running the remediation command on
+	// every affected instance.
+	RemediationScript bool `json:"remediationScript,omitempty"`
+
+	// Compact posts the alert as a single message summarising the affected instances, without a
+	// thread breaking them down.
+	Compact bool `json:"compact,omitempty"`
+
+	// RecoveryChannel is the channel all-clear notifications are posted to when the client or network
+	// recovers, keeping them out of the alerts channel. Empty posts them to DiscordChannel.
+	RecoveryChannel string `json:"recoveryChannel,omitempty"`
+
+	// RegisteredBy is the Discord user ID of whoever registered the alert. Alerts registered before
+	// it was recorded leave it empty.
+	RegisteredBy string `json:"registeredBy,omitempty"`
+
+	// Acknowledged, Snoozed and Muted record the actions taken from the alert's notification, see
+	// AlertAction.
+	Acknowledged *AlertAction `json:"acknowledged,omitempty"`
+	Snoozed      *AlertAction `json:"snoozed,omitempty"`
+	Muted        *AlertAction `json:"muted,omitempty"`
+}
+
+// GetRecoveryChannel returns the channel all-clear notifications for the alert are posted to.
+func (a *MonitorAlert) GetRecoveryChannel() string {
+	if a.RecoveryChannel != "" {
+		return a.RecoveryChannel
+	}
+
+	return a.DiscordChannel
 }
 
 // NewMonitorRepo creates a new MonitorRepo.
@@ -88,10 +134,36 @@ func (s *MonitorRepo) List(ctx context.Context) ([]*MonitorAlert, error) {
 	return alerts, nil
 }
 
+// Get retrieves the alert for a client on a network, or nil if it isn't registered.
+func (s *MonitorRepo) Get(ctx context.Context, network, client string) (*MonitorAlert, error) {
+	defer s.trackDuration("get", "monitor")()
+
+	alert, err := s.getAlert(ctx, s.Key(&MonitorAlert{Network: network, Client: client}))
+	if err != nil {
+		var noSuchKey *types.NoSuchKey
+
+		if errors.As(err, &noSuchKey) {
+			s.observeOperation("get", "monitor", nil) // Not really an error in this case
+
+			return nil, nil
+		}
+
+		s.observeOperation("get", "monitor", err)
+
+		return nil, err
+	}
+
+	s.observeOperation("get", "monitor", nil)
+
+	return alert, nil
+}
+
 // Persist implements Repository[*MonitorAlert].
 func (s *MonitorRepo) Persist(ctx context.Context, alert *MonitorAlert) error {
 	defer s.trackDuration("persist", "monitor")()
 
+	alert.SchemaVersion = MonitorAlertSchemaVersion
+
 	data, err := json.Marshal(alert)
 	if err != nil {
 		s.observeOperation("persist", "monitor", err)
@@ -156,10 +228,16 @@ func (s *MonitorRepo) getAlert(ctx context.Context, key string) (*MonitorAlert,
 
 	defer output.Body.Close()
 
-	var alert MonitorAlert
-	if err := json.NewDecoder(output.Body).Decode(&alert); err != nil {
-		return nil, fmt.Errorf("failed to decode alert: %w", err)
+	alert, migrated, err := decodeMonitorAlert(output.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if migrated {
+		s.persistMigrated(ctx, key, "monitor", func() error {
+			return s.Persist(ctx, alert)
+		})
 	}
 
-	return &alert, nil
+	return alert, nil
 }
@@ -0,0 +1,184 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/sirupsen/logrus"
+)
+
+// LastNotification records when a client on a network was last notified about, used to enforce a
+// cooldown between notifications.
+type LastNotification struct {
+	Network    string    `json:"network"`
+	Client     string    `json:"client"`
+	CheckID    string    `json:"checkId"` // The check run that was notified about.
+	NotifiedAt time.Time `json:"notifiedAt"`
+}
+
+// NotificationsRepo implements Repository[*LastNotification].
+type NotificationsRepo struct {
+	BaseRepo
+}
+
+// NewNotificationsRepo creates a new NotificationsRepo.
+func NewNotificationsRepo(ctx context.Context, log *logrus.Logger, cfg *S3Config, metrics *Metrics) (*NotificationsRepo, error) {
+	baseRepo, err := NewBaseRepo(ctx, log, cfg, metrics)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create base repo: %w", err)
+	}
+
+	return &NotificationsRepo{
+		BaseRepo: baseRepo,
+	}, nil
+}
+
+// List implements Repository[*LastNotification].
+func (s *NotificationsRepo) List(ctx context.Context) ([]*LastNotification, error) {
+	defer s.trackDuration("list", "notifications")()
+
+	var (
+		input = &s3.ListObjectsV2Input{
+			Bucket: aws.String(s.bucket),
+			Prefix: aws.String(fmt.Sprintf("%s/networks/", s.prefix)),
+		}
+		records   []*LastNotification
+		paginator = s3.NewListObjectsV2Paginator(s.store, input)
+	)
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			s.observeOperation("list", "notifications", err)
+
+			return nil, fmt.Errorf("failed to list notifications: %w", err)
+		}
+
+		for _, obj := range page.Contents {
+			if !strings.HasSuffix(*obj.Key, ".json") || !strings.Contains(*obj.Key, "/notifications/") {
+				continue
+			}
+
+			record, err := s.getNotification(ctx, *obj.Key)
+			if err != nil {
+				s.log.Errorf("Failed to get notification %s: %v", *obj.Key, err)
+
+				continue
+			}
+
+			records = append(records, record)
+		}
+	}
+
+	s.metrics.objectsTotal.WithLabelValues("notifications").Set(float64(len(records)))
+
+	return records, nil
+}
+
+// Get retrieves when a client on a network was last notified about, or nil if it never has been.
+func (s *NotificationsRepo) Get(ctx context.Context, network, client string) (*LastNotification, error) {
+	defer s.trackDuration("get", "notifications")()
+
+	record, err := s.getNotification(ctx, s.Key(&LastNotification{Network: network, Client: client}))
+	if err != nil {
+		var noSuchKey *types.NoSuchKey
+
+		if errors.As(err, &noSuchKey) {
+			s.observeOperation("get", "notifications", nil) // Not really an error in this case
+
+			return nil, nil
+		}
+
+		s.observeOperation("get", "notifications", err)
+
+		return nil, err
+	}
+
+	s.observeOperation("get", "notifications", nil)
+
+	return record, nil
+}
+
+// Persist implements Repository[*LastNotification].
+func (s *NotificationsRepo) Persist(ctx context.Context, record *LastNotification) error {
+	defer s.trackDuration("persist", "notifications")()
+
+	data, err := json.Marshal(record)
+	if err != nil {
+		s.observeOperation("persist", "notifications", err)
+
+		return fmt.Errorf("failed to marshal notification: %w", err)
+	}
+
+	s.metrics.objectSizeBytes.WithLabelValues("notifications").Observe(float64(len(data)))
+
+	if _, err = s.store.PutObject(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.Key(record)),
+		Body:   bytes.NewReader(data),
+	}); err != nil {
+		s.observeOperation("persist", "notifications", err)
+
+		return fmt.Errorf("failed to put notification: %w", err)
+	}
+
+	s.observeOperation("persist", "notifications", nil)
+
+	return nil
+}
+
+// Purge implements Repository[*LastNotification].
+func (s *NotificationsRepo) Purge(ctx context.Context, identifiers ...string) error {
+	if len(identifiers) != 2 {
+		return fmt.Errorf("expected network and client identifiers, got %d identifiers", len(identifiers))
+	}
+
+	network, client := identifiers[0], identifiers[1]
+
+	if _, err := s.store.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.Key(&LastNotification{Network: network, Client: client})),
+	}); err != nil {
+		return fmt.Errorf("failed to delete notification: %w", err)
+	}
+
+	return nil
+}
+
+// Key implements Repository[*LastNotification].
+func (s *NotificationsRepo) Key(record *LastNotification) string {
+	if record == nil {
+		s.log.Error("notification is nil")
+
+		return ""
+	}
+
+	return fmt.Sprintf("%s/networks/%s/notifications/%s.json", s.prefix, record.Network, record.Client)
+}
+
+func (s *NotificationsRepo) getNotification(ctx context.Context, key string) (*LastNotification, error) {
+	output, err := s.store.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get notification: %w", err)
+	}
+
+	defer output.Body.Close()
+
+	var record LastNotification
+	if err := json.NewDecoder(output.Body).Decode(&record); err != nil {
+		return nil, fmt.Errorf("failed to decode notification: %w", err)
+	}
+
+	return &record, nil
+}
@@ -0,0 +1,69 @@
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationsRepo(t *testing.T) {
+	ctx := context.Background()
+	helper := newTestHelper(t)
+	helper.setup(ctx)
+	defer helper.teardown(ctx)
+
+	t.Run("Get_Never_Notified", func(t *testing.T) {
+		setupTest(t)
+		repo, err := NewNotificationsRepo(ctx, helper.log, helper.cfg, NewMetrics("test"))
+		require.NoError(t, err)
+
+		record, err := repo.Get(ctx, "test-net", "lighthouse")
+		require.NoError(t, err)
+		assert.Nil(t, record)
+	})
+
+	t.Run("Persist_And_Get", func(t *testing.T) {
+		setupTest(t)
+		repo, err := NewNotificationsRepo(ctx, helper.log, helper.cfg, NewMetrics("test"))
+		require.NoError(t, err)
+
+		record := &LastNotification{
+			Network:    "test-net",
+			Client:     "lighthouse",
+			CheckID:    "test-check",
+			NotifiedAt: time.Now().UTC().Truncate(time.Second),
+		}
+
+		require.NoError(t, repo.Persist(ctx, record))
+
+		got, err := repo.Get(ctx, "test-net", "lighthouse")
+		require.NoError(t, err)
+		require.NotNil(t, got)
+		assert.Equal(t, record.CheckID, got.CheckID)
+		assert.True(t, record.NotifiedAt.Equal(got.NotifiedAt))
+	})
+
+	t.Run("Purge", func(t *testing.T) {
+		setupTest(t)
+		repo, err := NewNotificationsRepo(ctx, helper.log, helper.cfg, NewMetrics("test"))
+		require.NoError(t, err)
+
+		require.NoError(t, repo.Purge(ctx, "test-net", "lighthouse"))
+
+		records, err := repo.List(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, records)
+	})
+
+	t.Run("Key_Generation", func(t *testing.T) {
+		setupTest(t)
+		repo, err := NewNotificationsRepo(ctx, helper.log, helper.cfg, NewMetrics("test"))
+		require.NoError(t, err)
+
+		key := repo.Key(&LastNotification{Network: "test-net", Client: "lighthouse"})
+		assert.Equal(t, "test/networks/test-net/notifications/lighthouse.json", key)
+	})
+}
@@ -2,6 +2,7 @@ package store
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -18,7 +19,40 @@ var (
 	DefaultBucketPrefix = "ethrand"
 )
 
-// Repository defines a generic interface for S3-backed storage.
+// Storage backends.
+const (
+	BackendS3 = "s3"
+	BackendFS = "fs"
+)
+
+// ObjectStore is the subset of the S3 API the repositories are built on, implemented by the S3
+// client and FSStore.
+type ObjectStore interface {
+	s3.ListObjectsV2APIClient
+	GetObject(ctx context.Context, params *s3.GetObjectInput, optFns ...func(*s3.Options)) (*s3.GetObjectOutput, error)
+	PutObject(ctx context.Context, params *s3.PutObjectInput, optFns ...func(*s3.Options)) (*s3.PutObjectOutput, error)
+	DeleteObject(ctx context.Context, params *s3.DeleteObjectInput, optFns ...func(*s3.Options)) (*s3.DeleteObjectOutput, error)
+	ListBuckets(ctx context.Context, params *s3.ListBucketsInput, optFns ...func(*s3.Options)) (*s3.ListBucketsOutput, error)
+	HeadBucket(ctx context.Context, params *s3.HeadBucketInput, optFns ...func(*s3.Options)) (*s3.HeadBucketOutput, error)
+}
+
+// ValidateBackend checks the storage backend is known and has what it needs.
+func ValidateBackend(backend, directory string) error {
+	switch backend {
+	case "", BackendS3:
+		return nil
+	case BackendFS:
+		if directory == "" {
+			return errors.New("the fs backend needs a directory")
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("unknown backend %q, expected %s or %s", backend, BackendS3, BackendFS)
+	}
+}
+
+// Repository defines a generic interface for object storage backed repositories.
 type Repository[T any] interface {
 	// List returns all items of type T.
 	List(ctx context.Context) ([]T, error)
@@ -30,13 +64,14 @@ type Repository[T any] interface {
 	Key(item T) string
 }
 
-// BaseRepo contains common S3 functionality for all repositories.
+// BaseRepo contains common storage functionality for all repositories.
 type BaseRepo struct {
-	store   *s3.Client
-	bucket  string
-	prefix  string
-	log     *logrus.Logger
-	metrics *Metrics
+	store           ObjectStore
+	bucket          string
+	prefix          string
+	log             *logrus.Logger
+	metrics         *Metrics
+	rewriteMigrated bool
 }
 
 // S3Config contains the configuration for the S3 client.
@@ -47,10 +82,37 @@ type S3Config struct {
 	Prefix          string
 	EndpointURL     string // Optional. If empty, uses default SDK endpoints.
 	Region          string // Optional. Defaults to us-east-1.
+	RewriteMigrated bool   // Optional. Rewrite records upgraded to a newer schema version on read.
+
+	SummaryDateFormat string // Optional. Go layout Hive summary results are keyed by. Defaults to DefaultSummaryDateFormat.
+	SummaryTimezone   string // Optional. Timezone Hive summary results are bucketed into days in. Defaults to UTC.
+
+	Backend   string // Optional. BackendS3 or BackendFS. Defaults to BackendS3.
+	Directory string // Required by BackendFS. Directory objects are kept under, at their S3 key paths.
 }
 
-// NewBaseRepo creates a new base repository with common S3 functionality.
+// NewBaseRepo creates a new base repository over the configured storage backend.
 func NewBaseRepo(ctx context.Context, log *logrus.Logger, cfg *S3Config, metrics *Metrics) (BaseRepo, error) {
+	if err := ValidateBackend(cfg.Backend, cfg.Directory); err != nil {
+		return BaseRepo{}, err
+	}
+
+	if cfg.Backend == BackendFS {
+		store, err := NewFSStore(cfg.Directory)
+		if err != nil {
+			return BaseRepo{}, fmt.Errorf("failed to create fs store: %w", err)
+		}
+
+		return BaseRepo{
+			store:           store,
+			bucket:          cfg.Bucket,
+			prefix:          cfg.Prefix,
+			log:             log,
+			metrics:         metrics,
+			rewriteMigrated: cfg.RewriteMigrated,
+		}, nil
+	}
+
 	opts := []func(*config.LoadOptions) error{
 		config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(
 			cfg.AccessKeyID,
@@ -79,15 +141,16 @@ func NewBaseRepo(ctx context.Context, log *logrus.Logger, cfg *S3Config, metrics
 	}
 
 	return BaseRepo{
-		store:   s3.NewFromConfig(awsCfg, cfgOpts...),
-		bucket:  cfg.Bucket,
-		prefix:  cfg.Prefix,
-		log:     log,
-		metrics: metrics,
+		store:           s3.NewFromConfig(awsCfg, cfgOpts...),
+		bucket:          cfg.Bucket,
+		prefix:          cfg.Prefix,
+		log:             log,
+		metrics:         metrics,
+		rewriteMigrated: cfg.RewriteMigrated,
 	}, nil
 }
 
-// VerifyConnection verifies the S3 connection and bucket accessibility.
+// VerifyConnection verifies the storage connection and bucket accessibility.
 func (b *BaseRepo) VerifyConnection(ctx context.Context) error {
 	// Test bucket listing.
 	if _, err := b.store.ListBuckets(ctx, &s3.ListBucketsInput{}); err != nil {
@@ -104,14 +167,47 @@ func (b *BaseRepo) VerifyConnection(ctx context.Context) error {
 	b.log.WithFields(logrus.Fields{
 		"bucket": b.bucket,
 		"prefix": b.prefix,
-	}).Info("Verified S3 connection")
+	}).Info("Verified store connection")
+
+	return nil
+}
+
+// VerifyReadWrite verifies the bucket can be listed, written to and deleted from, by round-tripping
+// a throwaway object under the prefix.
+func (b *BaseRepo) VerifyReadWrite(ctx context.Context) error {
+	if _, err := b.store.ListObjectsV2(ctx, &s3.ListObjectsV2Input{
+		Bucket:  aws.String(b.bucket),
+		Prefix:  aws.String(b.prefix + "/"),
+		MaxKeys: aws.Int32(1),
+	}); err != nil {
+		return fmt.Errorf("failed to list objects: %w", err)
+	}
+
+	key := fmt.Sprintf("%s/selftest/%d.txt", b.prefix, time.Now().UnixNano())
+
+	if _, err := b.store.PutObject(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(b.bucket),
+		Key:    aws.String(key),
+		Body:   strings.NewReader("ok"),
+	}); err != nil {
+		return fmt.Errorf("failed to put object: %w", err)
+	}
+
+	if _, err := b.store.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(b.bucket),
+		Key:    aws.String(key),
+	}); err != nil {
+		return fmt.Errorf("failed to delete object %s: %w", key, err)
+	}
 
 	return nil
 }
 
-// GetS3Client returns the underlying S3 client.
+// GetS3Client returns the underlying S3 client, nil when the store isn't backed by S3.
 func (b *BaseRepo) GetS3Client() *s3.Client {
-	return b.store
+	client, _ := b.store.(*s3.Client)
+
+	return client
 }
 
 // observeOperation observes the operation and increments the metrics.
@@ -139,3 +235,24 @@ func (b *BaseRepo) trackDuration(operation, repository string) func() {
 		b.metrics.operationDuration.WithLabelValues(operation, repository).Observe(time.Since(start).Seconds())
 	}
 }
+
+// persistMigrated persists a record that was upgraded to a newer schema version on read, if enabled.
+// Failures are logged rather than returned, the upgraded record is still usable in memory.
+func (b *BaseRepo) persistMigrated(ctx context.Context, key, repository string, persist func() error) {
+	b.metrics.migrationsTotal.WithLabelValues(repository).Inc()
+
+	if !b.rewriteMigrated {
+		return
+	}
+
+	if err := persist(); err != nil {
+		b.log.WithError(err).WithField("key", key).Warn("Failed to rewrite migrated record")
+
+		return
+	}
+
+	b.log.WithFields(logrus.Fields{
+		"key":        key,
+		"repository": repository,
+	}).Info("Rewrote migrated record")
+}
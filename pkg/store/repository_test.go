@@ -4,6 +4,8 @@ import (
 	"context"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -28,6 +30,19 @@ func TestBaseRepo(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("VerifyReadWrite", func(t *testing.T) {
+		baseRepo := helper.createBaseRepo(ctx)
+		require.NoError(t, baseRepo.VerifyReadWrite(ctx))
+
+		// The probe object is cleaned up.
+		output, err := baseRepo.store.ListObjectsV2(ctx, &s3.ListObjectsV2Input{
+			Bucket: aws.String(baseRepo.bucket),
+			Prefix: aws.String(baseRepo.prefix + "/selftest/"),
+		})
+		require.NoError(t, err)
+		assert.Empty(t, output.Contents)
+	})
+
 	t.Run("GetS3Client", func(t *testing.T) {
 		baseRepo := helper.createBaseRepo(ctx)
 		client := baseRepo.GetS3Client()
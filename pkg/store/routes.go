@@ -0,0 +1,268 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/sirupsen/logrus"
+)
+
+// Route severities. A client identified as a root cause is critical, one with only
+// unexplained issues is a warning.
+const (
+	RouteSeverityCritical = "critical"
+	RouteSeverityWarning  = "warning"
+)
+
+// routeWildcard is used in place of an empty matcher when building rule IDs.
+const routeWildcard = "any"
+
+// RouteRule routes alerts matching its (network, category, client, severity) matchers to a
+// different destination channel. Empty matchers match everything.
+type RouteRule struct {
+	Network        string    `json:"network"`
+	Category       string    `json:"category,omitempty"`
+	Client         string    `json:"client,omitempty"`
+	Severity       string    `json:"severity,omitempty"`
+	DiscordChannel string    `json:"discordChannel"`
+	DiscordGuildID string    `json:"discordGuildId"`
+	CreatedAt      time.Time `json:"createdAt"`
+	UpdatedAt      time.Time `json:"updatedAt"`
+}
+
+// ID returns the identifier of the rule within its network and guild, derived from its matchers.
+func (r *RouteRule) ID() string {
+	return RouteID(r.Category, r.Client, r.Severity)
+}
+
+// Matches returns true if the rule applies to the given alert attributes.
+func (r *RouteRule) Matches(network, guildID, category, client, severity string) bool {
+	if r.Network != network || r.DiscordGuildID != guildID {
+		return false
+	}
+
+	if r.Category != "" && !strings.EqualFold(r.Category, category) {
+		return false
+	}
+
+	if r.Client != "" && r.Client != client {
+		return false
+	}
+
+	if r.Severity != "" && r.Severity != severity {
+		return false
+	}
+
+	return true
+}
+
+// specificity returns how many matchers the rule sets, more specific rules win.
+func (r *RouteRule) specificity() int {
+	n := 0
+
+	for _, v := range []string{r.Category, r.Client, r.Severity} {
+		if v != "" {
+			n++
+		}
+	}
+
+	return n
+}
+
+// RouteID builds a rule identifier from its matchers.
+func RouteID(category, client, severity string) string {
+	parts := []string{category, client, severity}
+
+	for i, p := range parts {
+		if p == "" {
+			parts[i] = routeWildcard
+		}
+	}
+
+	return strings.ToLower(strings.Join(parts, "_"))
+}
+
+// MatchRoute returns the most specific rule matching the given alert attributes, or nil
+// if no rule matches and the alert should go to its default channel.
+func MatchRoute(rules []*RouteRule, network, guildID, category, client, severity string) *RouteRule {
+	var best *RouteRule
+
+	for _, rule := range rules {
+		if !rule.Matches(network, guildID, category, client, severity) {
+			continue
+		}
+
+		if best == nil ||
+			rule.specificity() > best.specificity() ||
+			(rule.specificity() == best.specificity() && rule.ID() < best.ID()) {
+			best = rule
+		}
+	}
+
+	return best
+}
+
+// RoutesRepo implements Repository[*RouteRule].
+type RoutesRepo struct {
+	BaseRepo
+}
+
+// NewRoutesRepo creates a new RoutesRepo.
+func NewRoutesRepo(ctx context.Context, log *logrus.Logger, cfg *S3Config, metrics *Metrics) (*RoutesRepo, error) {
+	baseRepo, err := NewBaseRepo(ctx, log, cfg, metrics)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create base repo: %w", err)
+	}
+
+	return &RoutesRepo{
+		BaseRepo: baseRepo,
+	}, nil
+}
+
+// List implements Repository[*RouteRule].
+func (s *RoutesRepo) List(ctx context.Context) ([]*RouteRule, error) {
+	defer s.trackDuration("list", "routes")()
+
+	rules, err := s.list(ctx, fmt.Sprintf("%s/networks/", s.prefix))
+	if err != nil {
+		return nil, err
+	}
+
+	s.metrics.objectsTotal.WithLabelValues("routes").Set(float64(len(rules)))
+
+	return rules, nil
+}
+
+// ListNetwork returns the rules for a single network, listing just that network's routes rather
+// than everything stored for every network.
+func (s *RoutesRepo) ListNetwork(ctx context.Context, network string) ([]*RouteRule, error) {
+	defer s.trackDuration("list_network", "routes")()
+
+	return s.list(ctx, fmt.Sprintf("%s/networks/%s/routes/", s.prefix, network))
+}
+
+// list returns the rules stored under a prefix.
+func (s *RoutesRepo) list(ctx context.Context, prefix string) ([]*RouteRule, error) {
+	var (
+		input = &s3.ListObjectsV2Input{
+			Bucket: aws.String(s.bucket),
+			Prefix: aws.String(prefix),
+		}
+		rules     []*RouteRule
+		paginator = s3.NewListObjectsV2Paginator(s.store, input)
+	)
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			s.observeOperation("list", "routes", err)
+
+			return nil, fmt.Errorf("failed to list routes: %w", err)
+		}
+
+		for _, obj := range page.Contents {
+			if !strings.HasSuffix(*obj.Key, ".json") || !strings.Contains(*obj.Key, "/routes/") {
+				continue
+			}
+
+			rule, err := s.getRule(ctx, *obj.Key)
+			if err != nil {
+				s.log.Errorf("Failed to get route %s: %v", *obj.Key, err)
+
+				continue
+			}
+
+			rules = append(rules, rule)
+		}
+	}
+
+	return rules, nil
+}
+
+// Persist implements Repository[*RouteRule].
+func (s *RoutesRepo) Persist(ctx context.Context, rule *RouteRule) error {
+	defer s.trackDuration("persist", "routes")()
+
+	data, err := json.Marshal(rule)
+	if err != nil {
+		s.observeOperation("persist", "routes", err)
+
+		return fmt.Errorf("failed to marshal route: %w", err)
+	}
+
+	s.metrics.objectSizeBytes.WithLabelValues("routes").Observe(float64(len(data)))
+
+	if _, err = s.store.PutObject(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.Key(rule)),
+		Body:   bytes.NewReader(data),
+	}); err != nil {
+		s.observeOperation("persist", "routes", err)
+
+		return fmt.Errorf("failed to put route: %w", err)
+	}
+
+	s.observeOperation("persist", "routes", nil)
+
+	return nil
+}
+
+// Purge implements Repository[*RouteRule].
+func (s *RoutesRepo) Purge(ctx context.Context, identifiers ...string) error {
+	defer s.trackDuration("purge", "routes")()
+
+	if len(identifiers) != 3 {
+		return fmt.Errorf("expected network, guildID and route ID identifiers, got %d identifiers", len(identifiers))
+	}
+
+	network, guildID, id := identifiers[0], identifiers[1], identifiers[2]
+
+	if _, err := s.store.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(fmt.Sprintf("%s/networks/%s/routes/%s/%s.json", s.prefix, network, guildID, id)),
+	}); err != nil {
+		s.observeOperation("purge", "routes", err)
+
+		return fmt.Errorf("failed to delete route: %w", err)
+	}
+
+	s.observeOperation("purge", "routes", nil)
+
+	return nil
+}
+
+// Key implements Repository[*RouteRule].
+func (s *RoutesRepo) Key(rule *RouteRule) string {
+	if rule == nil {
+		s.log.Error("route is nil")
+
+		return ""
+	}
+
+	return fmt.Sprintf("%s/networks/%s/routes/%s/%s.json", s.prefix, rule.Network, rule.DiscordGuildID, rule.ID())
+}
+
+func (s *RoutesRepo) getRule(ctx context.Context, key string) (*RouteRule, error) {
+	output, err := s.store.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get route: %w", err)
+	}
+
+	defer output.Body.Close()
+
+	var rule RouteRule
+	if err := json.NewDecoder(output.Body).Decode(&rule); err != nil {
+		return nil, fmt.Errorf("failed to decode route: %w", err)
+	}
+
+	return &rule, nil
+}
@@ -0,0 +1,159 @@
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchRoute(t *testing.T) {
+	rules := []*RouteRule{
+		{Network: "test-net", DiscordGuildID: "guild", DiscordChannel: "sync"},
+		{Network: "test-net", DiscordGuildID: "guild", Category: "sync", DiscordChannel: "sync-any"},
+		{Network: "test-net", DiscordGuildID: "guild", Category: "sync", Client: "lighthouse", DiscordChannel: "sync-lighthouse"},
+		{Network: "test-net", DiscordGuildID: "guild", Severity: RouteSeverityCritical, DiscordChannel: "critical"},
+		{Network: "other-net", DiscordGuildID: "guild", Category: "general", DiscordChannel: "other"},
+	}
+
+	tests := []struct {
+		name     string
+		network  string
+		category string
+		client   string
+		severity string
+		expected string
+	}{
+		{
+			name:     "most specific rule wins",
+			network:  "test-net",
+			category: "sync",
+			client:   "lighthouse",
+			severity: RouteSeverityWarning,
+			expected: "sync-lighthouse",
+		},
+		{
+			name:     "category rule",
+			network:  "test-net",
+			category: "sync",
+			client:   "prysm",
+			severity: RouteSeverityWarning,
+			expected: "sync-any",
+		},
+		{
+			name:     "severity rule",
+			network:  "test-net",
+			category: "general",
+			client:   "prysm",
+			severity: RouteSeverityCritical,
+			expected: "critical",
+		},
+		{
+			name:     "wildcard rule",
+			network:  "test-net",
+			category: "general",
+			client:   "prysm",
+			severity: RouteSeverityWarning,
+			expected: "sync",
+		},
+		{
+			name:     "no matching rule",
+			network:  "unknown-net",
+			category: "general",
+			client:   "prysm",
+			severity: RouteSeverityWarning,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := MatchRoute(rules, tt.network, "guild", tt.category, tt.client, tt.severity)
+			if tt.expected == "" {
+				assert.Nil(t, rule)
+
+				return
+			}
+
+			require.NotNil(t, rule)
+			assert.Equal(t, tt.expected, rule.DiscordChannel)
+		})
+	}
+}
+
+func TestRouteID(t *testing.T) {
+	assert.Equal(t, "sync_any_critical", RouteID("sync", "", RouteSeverityCritical))
+	assert.Equal(t, "any_any_any", RouteID("", "", ""))
+}
+
+func TestRoutesRepo(t *testing.T) {
+	ctx := context.Background()
+	helper := newTestHelper(t)
+	helper.setup(ctx)
+	defer helper.teardown(ctx)
+
+	t.Run("Persist_And_List", func(t *testing.T) {
+		setupTest(t)
+		repo, err := NewRoutesRepo(ctx, helper.log, helper.cfg, NewMetrics("test"))
+		require.NoError(t, err)
+
+		rule := &RouteRule{
+			Network:        "test-net",
+			Category:       "sync",
+			DiscordChannel: "test-channel",
+			DiscordGuildID: "test-guild",
+			CreatedAt:      time.Now().UTC(),
+			UpdatedAt:      time.Now().UTC(),
+		}
+
+		require.NoError(t, repo.Persist(ctx, rule))
+
+		rules, err := repo.List(ctx)
+		require.NoError(t, err)
+		require.Len(t, rules, 1)
+		assert.Equal(t, rule.Category, rules[0].Category)
+		assert.Equal(t, rule.DiscordChannel, rules[0].DiscordChannel)
+	})
+
+	t.Run("ListNetwork", func(t *testing.T) {
+		setupTest(t)
+		repo, err := NewRoutesRepo(ctx, helper.log, helper.cfg, NewMetrics("test"))
+		require.NoError(t, err)
+
+		require.NoError(t, repo.Persist(ctx, &RouteRule{
+			Network:        "other-net",
+			Client:         "geth",
+			DiscordChannel: "other-channel",
+			DiscordGuildID: "test-guild",
+		}))
+
+		rules, err := repo.ListNetwork(ctx, "test-net")
+		require.NoError(t, err)
+		require.Len(t, rules, 1)
+		assert.Equal(t, "test-net", rules[0].Network)
+
+		require.NoError(t, repo.Purge(ctx, "other-net", "test-guild", RouteID("", "geth", "")))
+	})
+
+	t.Run("Purge", func(t *testing.T) {
+		setupTest(t)
+		repo, err := NewRoutesRepo(ctx, helper.log, helper.cfg, NewMetrics("test"))
+		require.NoError(t, err)
+
+		require.NoError(t, repo.Purge(ctx, "test-net", "test-guild", RouteID("sync", "", "")))
+
+		rules, err := repo.List(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, rules)
+	})
+
+	t.Run("Key_Generation", func(t *testing.T) {
+		setupTest(t)
+		repo, err := NewRoutesRepo(ctx, helper.log, helper.cfg, NewMetrics("test"))
+		require.NoError(t, err)
+
+		key := repo.Key(&RouteRule{Network: "test-net", DiscordGuildID: "test-guild", Client: "geth"})
+		assert.Equal(t, "test/networks/test-net/routes/test-guild/any_geth_any.json", key)
+	})
+}
@@ -115,7 +115,7 @@ func (h *testHelper) createBucket(ctx context.Context) {
 		h.t.Fatalf("Failed to create base repo: %v", err)
 	}
 
-	_, err = baseRepo.store.CreateBucket(ctx, &s3.CreateBucketInput{
+	_, err = baseRepo.GetS3Client().CreateBucket(ctx, &s3.CreateBucketInput{
 		Bucket: aws.String(testBucket),
 	})
 	if err != nil {
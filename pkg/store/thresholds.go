@@ -0,0 +1,184 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/sirupsen/logrus"
+)
+
+// NetworkThresholds holds a network's check threshold overrides, keyed by threshold name. Thresholds
+// without an override fall back to the defaults.
+type NetworkThresholds struct {
+	Network   string            `json:"network"`
+	Overrides map[string]string `json:"overrides"`
+	UpdatedBy string            `json:"updatedBy"` // Discord user ID of whoever last changed the overrides.
+	UpdatedAt time.Time         `json:"updatedAt"`
+}
+
+// ThresholdsRepo implements Repository[*NetworkThresholds].
+type ThresholdsRepo struct {
+	BaseRepo
+}
+
+// NewThresholdsRepo creates a new ThresholdsRepo.
+func NewThresholdsRepo(ctx context.Context, log *logrus.Logger, cfg *S3Config, metrics *Metrics) (*ThresholdsRepo, error) {
+	baseRepo, err := NewBaseRepo(ctx, log, cfg, metrics)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create base repo: %w", err)
+	}
+
+	return &ThresholdsRepo{
+		BaseRepo: baseRepo,
+	}, nil
+}
+
+// List implements Repository[*NetworkThresholds].
+func (s *ThresholdsRepo) List(ctx context.Context) ([]*NetworkThresholds, error) {
+	defer s.trackDuration("list", "thresholds")()
+
+	var (
+		input = &s3.ListObjectsV2Input{
+			Bucket: aws.String(s.bucket),
+			Prefix: aws.String(fmt.Sprintf("%s/networks/", s.prefix)),
+		}
+		records   []*NetworkThresholds
+		paginator = s3.NewListObjectsV2Paginator(s.store, input)
+	)
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			s.observeOperation("list", "thresholds", err)
+
+			return nil, fmt.Errorf("failed to list thresholds: %w", err)
+		}
+
+		for _, obj := range page.Contents {
+			if !strings.HasSuffix(*obj.Key, ".json") || !strings.Contains(*obj.Key, "/thresholds/") {
+				continue
+			}
+
+			record, err := s.getThresholds(ctx, *obj.Key)
+			if err != nil {
+				s.log.Errorf("Failed to get thresholds %s: %v", *obj.Key, err)
+
+				continue
+			}
+
+			records = append(records, record)
+		}
+	}
+
+	s.metrics.objectsTotal.WithLabelValues("thresholds").Set(float64(len(records)))
+
+	return records, nil
+}
+
+// Get retrieves a network's threshold overrides, or nil if it has none.
+func (s *ThresholdsRepo) Get(ctx context.Context, network string) (*NetworkThresholds, error) {
+	defer s.trackDuration("get", "thresholds")()
+
+	record, err := s.getThresholds(ctx, s.Key(&NetworkThresholds{Network: network}))
+	if err != nil {
+		var noSuchKey *types.NoSuchKey
+
+		if errors.As(err, &noSuchKey) {
+			s.observeOperation("get", "thresholds", nil) // Not really an error in this case
+
+			return nil, nil
+		}
+
+		s.observeOperation("get", "thresholds", err)
+
+		return nil, err
+	}
+
+	s.observeOperation("get", "thresholds", nil)
+
+	return record, nil
+}
+
+// Persist implements Repository[*NetworkThresholds].
+func (s *ThresholdsRepo) Persist(ctx context.Context, record *NetworkThresholds) error {
+	defer s.trackDuration("persist", "thresholds")()
+
+	data, err := json.Marshal(record)
+	if err != nil {
+		s.observeOperation("persist", "thresholds", err)
+
+		return fmt.Errorf("failed to marshal thresholds: %w", err)
+	}
+
+	s.metrics.objectSizeBytes.WithLabelValues("thresholds").Observe(float64(len(data)))
+
+	if _, err = s.store.PutObject(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.Key(record)),
+		Body:   bytes.NewReader(data),
+	}); err != nil {
+		s.observeOperation("persist", "thresholds", err)
+
+		return fmt.Errorf("failed to put thresholds: %w", err)
+	}
+
+	s.observeOperation("persist", "thresholds", nil)
+
+	return nil
+}
+
+// Purge implements Repository[*NetworkThresholds].
+func (s *ThresholdsRepo) Purge(ctx context.Context, identifiers ...string) error {
+	if len(identifiers) != 1 {
+		return fmt.Errorf("expected network identifier, got %d identifiers", len(identifiers))
+	}
+
+	network := identifiers[0]
+
+	if _, err := s.store.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.Key(&NetworkThresholds{Network: network})),
+	}); err != nil {
+		return fmt.Errorf("failed to delete thresholds: %w", err)
+	}
+
+	return nil
+}
+
+// Key implements Repository[*NetworkThresholds].
+func (s *ThresholdsRepo) Key(record *NetworkThresholds) string {
+	if record == nil {
+		s.log.Error("thresholds are nil")
+
+		return ""
+	}
+
+	return fmt.Sprintf("%s/networks/%s/thresholds/overrides.json", s.prefix, record.Network)
+}
+
+func (s *ThresholdsRepo) getThresholds(ctx context.Context, key string) (*NetworkThresholds, error) {
+	output, err := s.store.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get thresholds: %w", err)
+	}
+
+	defer output.Body.Close()
+
+	var record NetworkThresholds
+	if err := json.NewDecoder(output.Body).Decode(&record); err != nil {
+		return nil, fmt.Errorf("failed to decode thresholds: %w", err)
+	}
+
+	return &record, nil
+}
@@ -0,0 +1,73 @@
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestThresholdsRepo(t *testing.T) {
+	ctx := context.Background()
+	helper := newTestHelper(t)
+	helper.setup(ctx)
+	defer helper.teardown(ctx)
+
+	t.Run("Get_No_Overrides", func(t *testing.T) {
+		setupTest(t)
+		repo, err := NewThresholdsRepo(ctx, helper.log, helper.cfg, NewMetrics("test"))
+		require.NoError(t, err)
+
+		record, err := repo.Get(ctx, "test-net")
+		require.NoError(t, err)
+		assert.Nil(t, record)
+	})
+
+	t.Run("Persist_And_Get", func(t *testing.T) {
+		setupTest(t)
+		repo, err := NewThresholdsRepo(ctx, helper.log, helper.cfg, NewMetrics("test"))
+		require.NoError(t, err)
+
+		record := &NetworkThresholds{
+			Network:   "test-net",
+			Overrides: map[string]string{"block-lag": "10"},
+			UpdatedBy: "user",
+			UpdatedAt: time.Now().UTC().Truncate(time.Second),
+		}
+
+		require.NoError(t, repo.Persist(ctx, record))
+
+		got, err := repo.Get(ctx, "test-net")
+		require.NoError(t, err)
+		require.NotNil(t, got)
+		assert.Equal(t, record.Overrides, got.Overrides)
+		assert.True(t, record.UpdatedAt.Equal(got.UpdatedAt))
+
+		records, err := repo.List(ctx)
+		require.NoError(t, err)
+		assert.Len(t, records, 1)
+	})
+
+	t.Run("Purge", func(t *testing.T) {
+		setupTest(t)
+		repo, err := NewThresholdsRepo(ctx, helper.log, helper.cfg, NewMetrics("test"))
+		require.NoError(t, err)
+
+		require.NoError(t, repo.Purge(ctx, "test-net"))
+
+		records, err := repo.List(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, records)
+	})
+
+	t.Run("Key_Generation", func(t *testing.T) {
+		setupTest(t)
+		repo, err := NewThresholdsRepo(ctx, helper.log, helper.cfg, NewMetrics("test"))
+		require.NoError(t, err)
+
+		key := repo.Key(&NetworkThresholds{Network: "test-net"})
+		assert.Equal(t, "test/networks/test-net/thresholds/overrides.json", key)
+	})
+}
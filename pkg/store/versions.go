@@ -0,0 +1,184 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/sirupsen/logrus"
+)
+
+// ClientVersion is the last version of a client seen running on a network.
+type ClientVersion struct {
+	Network   string    `json:"network"`
+	Client    string    `json:"client"`
+	Version   string    `json:"version"`
+	SeenAt    time.Time `json:"seenAt"`
+	UpdatedAt time.Time `json:"updatedAt"` // When the version last changed.
+}
+
+// VersionsRepo implements Repository[*ClientVersion].
+type VersionsRepo struct {
+	BaseRepo
+}
+
+// NewVersionsRepo creates a new VersionsRepo.
+func NewVersionsRepo(ctx context.Context, log *logrus.Logger, cfg *S3Config, metrics *Metrics) (*VersionsRepo, error) {
+	baseRepo, err := NewBaseRepo(ctx, log, cfg, metrics)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create base repo: %w", err)
+	}
+
+	return &VersionsRepo{
+		BaseRepo: baseRepo,
+	}, nil
+}
+
+// List implements Repository[*ClientVersion].
+func (s *VersionsRepo) List(ctx context.Context) ([]*ClientVersion, error) {
+	defer s.trackDuration("list", "versions")()
+
+	var (
+		input = &s3.ListObjectsV2Input{
+			Bucket: aws.String(s.bucket),
+			Prefix: aws.String(fmt.Sprintf("%s/networks/", s.prefix)),
+		}
+		versions  []*ClientVersion
+		paginator = s3.NewListObjectsV2Paginator(s.store, input)
+	)
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			s.observeOperation("list", "versions", err)
+
+			return nil, fmt.Errorf("failed to list versions: %w", err)
+		}
+
+		for _, obj := range page.Contents {
+			if !strings.HasSuffix(*obj.Key, ".json") || !strings.Contains(*obj.Key, "/versions/") {
+				continue
+			}
+
+			version, err := s.getVersion(ctx, *obj.Key)
+			if err != nil {
+				s.log.Errorf("Failed to get version %s: %v", *obj.Key, err)
+
+				continue
+			}
+
+			versions = append(versions, version)
+		}
+	}
+
+	s.metrics.objectsTotal.WithLabelValues("versions").Set(float64(len(versions)))
+
+	return versions, nil
+}
+
+// Get retrieves the last seen version of a client on a network, or nil if it hasn't been seen yet.
+func (s *VersionsRepo) Get(ctx context.Context, network, client string) (*ClientVersion, error) {
+	defer s.trackDuration("get", "versions")()
+
+	version, err := s.getVersion(ctx, s.Key(&ClientVersion{Network: network, Client: client}))
+	if err != nil {
+		var noSuchKey *types.NoSuchKey
+
+		if errors.As(err, &noSuchKey) {
+			s.observeOperation("get", "versions", nil) // Not really an error in this case
+
+			return nil, nil
+		}
+
+		s.observeOperation("get", "versions", err)
+
+		return nil, err
+	}
+
+	s.observeOperation("get", "versions", nil)
+
+	return version, nil
+}
+
+// Persist implements Repository[*ClientVersion].
+func (s *VersionsRepo) Persist(ctx context.Context, version *ClientVersion) error {
+	defer s.trackDuration("persist", "versions")()
+
+	data, err := json.Marshal(version)
+	if err != nil {
+		s.observeOperation("persist", "versions", err)
+
+		return fmt.Errorf("failed to marshal version: %w", err)
+	}
+
+	s.metrics.objectSizeBytes.WithLabelValues("versions").Observe(float64(len(data)))
+
+	if _, err = s.store.PutObject(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.Key(version)),
+		Body:   bytes.NewReader(data),
+	}); err != nil {
+		s.observeOperation("persist", "versions", err)
+
+		return fmt.Errorf("failed to put version: %w", err)
+	}
+
+	s.observeOperation("persist", "versions", nil)
+
+	return nil
+}
+
+// Purge implements Repository[*ClientVersion].
+func (s *VersionsRepo) Purge(ctx context.Context, identifiers ...string) error {
+	if len(identifiers) != 2 {
+		return fmt.Errorf("expected network and client identifiers, got %d identifiers", len(identifiers))
+	}
+
+	network, client := identifiers[0], identifiers[1]
+
+	if _, err := s.store.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.Key(&ClientVersion{Network: network, Client: client})),
+	}); err != nil {
+		return fmt.Errorf("failed to delete version: %w", err)
+	}
+
+	return nil
+}
+
+// Key implements Repository[*ClientVersion].
+func (s *VersionsRepo) Key(version *ClientVersion) string {
+	if version == nil {
+		s.log.Error("version is nil")
+
+		return ""
+	}
+
+	return fmt.Sprintf("%s/networks/%s/versions/%s.json", s.prefix, version.Network, version.Client)
+}
+
+func (s *VersionsRepo) getVersion(ctx context.Context, key string) (*ClientVersion, error) {
+	output, err := s.store.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get version: %w", err)
+	}
+
+	defer output.Body.Close()
+
+	var version ClientVersion
+	if err := json.NewDecoder(output.Body).Decode(&version); err != nil {
+		return nil, fmt.Errorf("failed to decode version: %w", err)
+	}
+
+	return &version, nil
+}
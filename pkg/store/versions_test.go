@@ -0,0 +1,73 @@
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVersionsRepo(t *testing.T) {
+	ctx := context.Background()
+	helper := newTestHelper(t)
+	helper.setup(ctx)
+	defer helper.teardown(ctx)
+
+	t.Run("Get_Unseen", func(t *testing.T) {
+		setupTest(t)
+		repo, err := NewVersionsRepo(ctx, helper.log, helper.cfg, NewMetrics("test"))
+		require.NoError(t, err)
+
+		version, err := repo.Get(ctx, "test-net", "geth")
+		require.NoError(t, err)
+		assert.Nil(t, version)
+	})
+
+	t.Run("Persist_And_Get", func(t *testing.T) {
+		setupTest(t)
+		repo, err := NewVersionsRepo(ctx, helper.log, helper.cfg, NewMetrics("test"))
+		require.NoError(t, err)
+
+		version := &ClientVersion{
+			Network:   "test-net",
+			Client:    "geth",
+			Version:   "Geth/v1.15.0-unstable-7f0dd394-20250204/linux-amd64/go1.23.5",
+			SeenAt:    time.Now().UTC(),
+			UpdatedAt: time.Now().UTC(),
+		}
+
+		require.NoError(t, repo.Persist(ctx, version))
+
+		got, err := repo.Get(ctx, "test-net", "geth")
+		require.NoError(t, err)
+		require.NotNil(t, got)
+		assert.Equal(t, version.Version, got.Version)
+
+		versions, err := repo.List(ctx)
+		require.NoError(t, err)
+		assert.Len(t, versions, 1)
+	})
+
+	t.Run("Purge", func(t *testing.T) {
+		setupTest(t)
+		repo, err := NewVersionsRepo(ctx, helper.log, helper.cfg, NewMetrics("test"))
+		require.NoError(t, err)
+
+		require.NoError(t, repo.Purge(ctx, "test-net", "geth"))
+
+		versions, err := repo.List(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, versions)
+	})
+
+	t.Run("Key_Generation", func(t *testing.T) {
+		setupTest(t)
+		repo, err := NewVersionsRepo(ctx, helper.log, helper.cfg, NewMetrics("test"))
+		require.NoError(t, err)
+
+		key := repo.Key(&ClientVersion{Network: "test-net", Client: "geth"})
+		assert.Equal(t, "test/networks/test-net/versions/geth.json", key)
+	})
+}